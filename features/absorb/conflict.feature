@@ -0,0 +1,68 @@
+@messyoutput
+Feature: absorb staged changes that conflict with a descendant branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME | FILE CONTENT   |
+      | parent | local, origin | parent commit | file      | parent content |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | child | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME | FILE CONTENT  |
+      | child  | local, origin | child commit | file      | child content |
+    And the current branch is "parent"
+    And a staged file with name "file" and content "fixed parent content"
+    When I run "git-town absorb"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                                                                              |
+      | parent | git apply --index --reverse .git/git-town.patch                                                                      |
+      |        | git apply --3way .git/git-town.patch                                                                                 |
+      |        | git commit --fixup {{ sha-before-run 'parent commit' }}                                                              |
+      |        | git -c sequence.editor=true rebase --interactive --autosquash {{ sha-before-run 'parent commit' }}^ --no-update-refs |
+      |        | git push --force-with-lease                                                                                          |
+      |        | git checkout child                                                                                                   |
+      | child  | git rebase --onto parent {{ sha-before-run 'parent commit' }} --no-update-refs                                       |
+    And Git Town prints the error:
+      """
+      CONFLICT (content): Merge conflict in file
+      """
+    And the current branch is now "child"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                               |
+      | child  | git rebase --abort                                    |
+      |        | git checkout parent                                   |
+      | parent | git reset --hard {{ sha-before-run 'parent commit' }} |
+      |        | git push --force-with-lease --force-if-includes       |
+      |        | git apply --3way .git/git-town.patch                  |
+    And the current branch is now "parent"
+    And file "file" now has content "fixed parent content"
+    And the initial commits exist now
+    And the initial lineage exists now
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "file" with "resolved content"
+    And I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                   |
+      | child  | git -c core.editor=true rebase --continue |
+      |        | git push --force-with-lease               |
+      |        | git checkout parent                       |
+    And the current branch is now "parent"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | child commit  |
+      | parent | local, origin | parent commit |
+    And these committed files exist now
+      | BRANCH | NAME | CONTENT              |
+      | child  | file | resolved content     |
+      | parent | file | fixed parent content |
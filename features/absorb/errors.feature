@@ -0,0 +1,77 @@
+Feature: does not absorb changes in unsupported situations
+
+  Scenario: on the main branch
+    Given a Git repo with origin
+    And a staged file with name "file" and content "content"
+    When I run "git-town absorb"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot absorb changes into branch "main" because it is not a feature branch
+      """
+
+  Scenario: on an observed branch
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE     | LOCATIONS     |
+      | observed | observed | local, origin |
+    And the current branch is "observed"
+    And a staged file with name "file" and content "content"
+    When I run "git-town absorb"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot absorb changes into branch "observed" because it is not a feature branch
+      """
+
+  Scenario: no staged changes
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME | FILE CONTENT |
+      | feature | local, origin | feature commit | file      | content      |
+    And the current branch is "feature"
+    And an uncommitted file with name "file" and content "changed content"
+    When I run "git-town absorb"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      there are no staged changes to absorb
+      """
+
+  Scenario: staged file with unstaged changes
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME | FILE CONTENT |
+      | feature | local, origin | feature commit | file      | content      |
+    And the current branch is "feature"
+    And a staged file with name "file" and content "staged content"
+    And an uncommitted file with name "file" and content "unstaged content"
+    When I run "git-town absorb"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot absorb the staged changes because these files also have unstaged changes: file
+      """
+
+  Scenario: staged changes don't belong to a commit of the branch
+    Given a Git repo with origin
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE     | FILE NAME | FILE CONTENT |
+      | main   | local, origin | main commit | file      | content      |
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And a staged file with name "file" and content "changed content"
+    When I run "git-town absorb"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot find commits in branch "feature" or its ancestors that the staged changes belong to
+      """
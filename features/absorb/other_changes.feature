@@ -0,0 +1,59 @@
+Feature: absorb staged changes while the workspace contains other changes
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | feature | local, origin | commit 1 | file_1    | content 1    |
+      |         |               | commit 2 | file_2    | content 2    |
+    And the current branch is "feature"
+    And a staged file with name "file_1" and content "fixed content 1"
+    And a staged file with name "new_file" and content "new content"
+    And an uncommitted file
+    When I run "git-town absorb"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                                         |
+      | feature | git apply --index --reverse .git/git-town.patch                                                                 |
+      |         | git add -A                                                                                                      |
+      |         | git stash                                                                                                       |
+      |         | git apply --3way .git/git-town.patch                                                                            |
+      |         | git commit --fixup {{ sha-before-run 'commit 1' }}                                                              |
+      |         | git -c sequence.editor=true rebase --interactive --autosquash {{ sha-before-run 'commit 1' }}^ --no-update-refs |
+      |         | git push --force-with-lease                                                                                     |
+      |         | git stash pop                                                                                                   |
+    And Git Town prints:
+      """
+      The changes to file "new_file" don't belong to a single commit of this branch or its ancestors. They remain in your workspace.
+      """
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+      |         |               | commit 2 |
+    And these committed files exist now
+      | BRANCH  | NAME   | CONTENT         |
+      | feature | file_1 | fixed content 1 |
+      |         | file_2 | content 2       |
+    And file "new_file" still has content "new content"
+    And the uncommitted file still exists
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                          |
+      | feature | git add -A                                       |
+      |         | git stash                                        |
+      |         | git reset --hard {{ sha-before-run 'commit 2' }} |
+      |         | git push --force-with-lease --force-if-includes  |
+      |         | git apply --3way .git/git-town.patch             |
+      |         | git stash pop                                    |
+    And the current branch is still "feature"
+    And file "file_1" still has content "fixed content 1"
+    And file "new_file" still has content "new content"
+    And the uncommitted file still exists
+    And the initial commits exist now
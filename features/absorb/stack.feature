@@ -0,0 +1,86 @@
+Feature: absorb staged changes into commits of the current branch and its ancestors
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | child | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | child  | local, origin | commit 1 | file_1    | content 1    |
+      |        |               | commit 2 | file_2    | content 2    |
+    And the branches
+      | NAME       | TYPE    | PARENT | LOCATIONS     |
+      | grandchild | feature | child  | local, origin |
+    And the commits
+      | BRANCH     | LOCATION      | MESSAGE  | FILE NAME | FILE CONTENT |
+      | grandchild | local, origin | commit 3 | file_3    | content 3    |
+    And the current branch is "child"
+    And a staged file with name "parent_file" and content "fixed parent content"
+    And a staged file with name "file_1" and content "fixed content 1"
+    When I run "git-town absorb"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH     | COMMAND                                                                                                              |
+      | child      | git apply --index --reverse .git/git-town.patch                                                                      |
+      |            | git checkout parent                                                                                                  |
+      | parent     | git apply --3way .git/git-town.patch                                                                                 |
+      |            | git commit --fixup {{ sha-before-run 'parent commit' }}                                                              |
+      |            | git -c sequence.editor=true rebase --interactive --autosquash {{ sha-before-run 'parent commit' }}^ --no-update-refs |
+      |            | git push --force-with-lease                                                                                          |
+      |            | git checkout child                                                                                                   |
+      | child      | git apply --3way .git/git-town.patch                                                                                 |
+      |            | git commit --fixup {{ sha-before-run 'commit 1' }}                                                                   |
+      |            | git -c sequence.editor=true rebase --interactive --autosquash {{ sha-before-run 'commit 1' }}^ --no-update-refs      |
+      |            | git rebase --onto parent {{ sha-before-run 'parent commit' }} --no-update-refs                                       |
+      |            | git push --force-with-lease                                                                                          |
+      |            | git checkout grandchild                                                                                              |
+      | grandchild | git rebase --onto child {{ sha-before-run 'commit 2' }} --no-update-refs                                             |
+      |            | git push --force-with-lease                                                                                          |
+      |            | git checkout child                                                                                                   |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH     | LOCATION      | MESSAGE       |
+      | child      | local, origin | commit 1      |
+      |            |               | commit 2      |
+      | grandchild | local, origin | commit 3      |
+      | parent     | local, origin | parent commit |
+    And these committed files exist now
+      | BRANCH     | NAME        | CONTENT              |
+      | child      | file_1      | fixed content 1      |
+      |            | file_2      | content 2            |
+      |            | parent_file | fixed parent content |
+      | grandchild | file_1      | fixed content 1      |
+      |            | file_2      | content 2            |
+      |            | file_3      | content 3            |
+      |            | parent_file | fixed parent content |
+      | parent     | parent_file | fixed parent content |
+    And no uncommitted files exist now
+    And the initial lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH     | COMMAND                                               |
+      | child      | git reset --hard {{ sha-before-run 'commit 2' }}      |
+      |            | git push --force-with-lease --force-if-includes       |
+      |            | git checkout grandchild                               |
+      | grandchild | git reset --hard {{ sha-before-run 'commit 3' }}      |
+      |            | git push --force-with-lease --force-if-includes       |
+      |            | git checkout parent                                   |
+      | parent     | git reset --hard {{ sha-before-run 'parent commit' }} |
+      |            | git push --force-with-lease --force-if-includes       |
+      |            | git checkout child                                    |
+      | child      | git apply --3way .git/git-town.patch                  |
+    And the current branch is still "child"
+    And file "file_1" still has content "fixed content 1"
+    And file "parent_file" still has content "fixed parent content"
+    And the initial commits exist now
+    And the initial lineage exists now
@@ -0,0 +1,33 @@
+Feature: explain problems with the configuration
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And local Git Town setting "perennial-branch-names" is "qa"
+    And local Git Town setting "contribution-regex" is "^renovate/"
+    And local Git Town setting "feature-regex" is "^feat"
+    And Git Town parent setting for branch "orphan" is "feature"
+    And Git Town parent setting for branch "feature-2" is "gone"
+    And Git Town parent setting for branch "one" is "two"
+    And Git Town parent setting for branch "two" is "one"
+    When I run "git-town config explain"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      Problems:
+        local setting "git-town.perennial-branch-names" is deprecated, Git Town will migrate it to "git-town.perennial-branches"
+        git-town.contribution-regex "^renovate/" matches no branch
+        the lineage contains a parent for branch "feature-2", which doesn't exist
+        branch "feature-2" has the parent "gone", which doesn't exist
+        the lineage contains a parent for branch "one", which doesn't exist
+        branch "one" has the parent "two", which doesn't exist
+        the lineage contains a parent for branch "orphan", which doesn't exist
+        the lineage contains a parent for branch "two", which doesn't exist
+        branch "two" has the parent "one", which doesn't exist
+        the lineage contains a cycle: one -> two -> one
+      """
+    And local Git Town setting "perennial-branch-names" is still "qa"
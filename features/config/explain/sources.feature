@@ -0,0 +1,50 @@
+Feature: explain where the configuration comes from
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE      | LOCATIONS     |
+      | qa      | perennial | local, origin |
+      | staging | (none)    | local, origin |
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+      perennials = ["staging"]
+
+      [sync]
+      feature-strategy = "merge"
+      perennial-strategy = "merge"
+      """
+    And global Git Town setting "sync-feature-strategy" is "compress"
+    And local Git Town setting "sync-feature-strategy" is "rebase"
+    When I run "git-town config explain"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      Settings:
+      """
+    And Git Town prints:
+      """
+        perennial-branches: qa staging (local, config-file)
+      """
+    And Git Town prints:
+      """
+        push-hook: true (default)
+      """
+    And Git Town prints:
+      """
+        sync-feature-strategy: rebase (local)
+          overrides compress (global)
+          overrides merge (config-file)
+      """
+    And Git Town prints:
+      """
+        sync-perennial-strategy: merge (config-file)
+      """
+    And Git Town prints:
+      """
+      No problems found.
+      """
@@ -0,0 +1,24 @@
+@messyoutput
+Feature: abort exporting the configuration
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE      | LOCATIONS     |
+      | qa   | perennial | local, origin |
+    And the main branch is "main"
+    And local Git Town setting "hook-after-ship" is "make deploy"
+    When I run "git-town config export" and enter into the dialog:
+      | DIALOG        | KEYS       |
+      | apply changes | down enter |
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      + after-ship = "make deploy"
+      """
+    And there is still no configuration file
+    And the main branch is still "main"
+    And the perennial branches are still "qa"
+    And local Git Town setting "hook-after-ship" is still "make deploy"
@@ -0,0 +1,111 @@
+@messyoutput
+Feature: export the entire configuration into the configuration file
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME         | TYPE         | PARENT | LOCATIONS     |
+      | qa           | perennial    |        | local, origin |
+      | contribution | contribution |        | local, origin |
+      | observed     | observed     |        | local, origin |
+      | parked       | parked       | main   | local         |
+      | prototype    | prototype    | main   | local         |
+    And the main branch is "main"
+    And branch "prototype" has the sync strategy "merge"
+    And local Git Town setting "contribution-regex" is "^renovate/"
+    And local Git Town setting "default-branch-type" is "observed"
+    And local Git Town setting "feature-regex" is "^user-"
+    And local Git Town setting "hook-after-ship" is "make deploy"
+    And local Git Town setting "observed-regex" is "^dependabot/"
+    And local Git Town setting "push-hook" is "false"
+    And local Git Town setting "sync-upstream" is "false"
+    And global Git setting "alias.append" is "town append"
+    When I run "git-town config export" and enter into the dialog:
+      | DIALOG        | KEYS  |
+      | apply changes | enter |
+
+  Scenario: result
+    Then Git Town prints:
+      """
+      Changes to .git-branches.toml:
+      + # More info around this file at https://www.git-town.com/configuration-file
+      """
+    And Git Town prints:
+      """
+      + [aliases]
+      + commands = ["append"]
+      """
+    And the configuration file is now:
+      """
+      # More info around this file at https://www.git-town.com/configuration-file
+
+      [aliases]
+      commands = ["append"]
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+      perennial-regex = ""
+      contribution-regex = "^renovate/"
+      contributions = ["contribution"]
+      default-type = "observed"
+      feature-regex = "^user-"
+      observed = ["observed"]
+      observed-regex = "^dependabot/"
+      parked = ["parked"]
+      prototypes = ["prototype"]
+
+      [create]
+      new-branch-type = "feature"
+      push-new-branches = false
+
+      [hooks]
+      after-ship = "make deploy"
+
+      [hosting]
+      dev-remote = "origin"
+      # platform = ""
+      # origin-hostname = ""
+
+      [ship]
+      delete-tracking-branch = true
+      strategy = "api"
+
+      [sync]
+      feature-strategy = "merge"
+      perennial-strategy = "rebase"
+      prototype-strategy = "merge"
+      push-hook = false
+      tags = true
+      upstream = false
+
+      [sync.branches]
+      "prototype" = "merge"
+      """
+    And the main branch is now not set
+    And there are now no perennial branches
+    And branch "prototype" now has no sync strategy
+    And local Git Town setting "contribution-branches" now doesn't exist
+    And local Git Town setting "contribution-regex" now doesn't exist
+    And local Git Town setting "default-branch-type" now doesn't exist
+    And local Git Town setting "feature-regex" now doesn't exist
+    And local Git Town setting "hook-after-ship" now doesn't exist
+    And local Git Town setting "observed-branches" now doesn't exist
+    And local Git Town setting "observed-regex" now doesn't exist
+    And local Git Town setting "parked-branches" now doesn't exist
+    And local Git Town setting "prototype-branches" now doesn't exist
+    And local Git Town setting "push-hook" now doesn't exist
+    And local Git Town setting "sync-upstream" now doesn't exist
+    And global Git setting "alias.append" is still "town append"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the main branch is now "main"
+    And branch "prototype" now has the sync strategy "merge"
+    And local Git Town setting "contribution-regex" is now "^renovate/"
+    And local Git Town setting "default-branch-type" is now "observed"
+    And local Git Town setting "feature-regex" is now "^user-"
+    And local Git Town setting "hook-after-ship" is now "make deploy"
+    And local Git Town setting "observed-regex" is now "^dependabot/"
+    And local Git Town setting "push-hook" is now "false"
+    And local Git Town setting "sync-upstream" is now "false"
@@ -0,0 +1,32 @@
+@messyoutput
+Feature: importing an exported configuration restores all settings
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE      | PARENT | LOCATIONS     |
+      | qa        | perennial |        | local, origin |
+      | shared    | feature   | main   | local         |
+      | prototype | prototype | main   | local         |
+    And the main branch is "main"
+    And branch "shared" has the sync strategy "merge"
+    And local Git Town setting "feature-regex" is "^user-"
+    And local Git Town setting "hook-after-ship" is "make deploy"
+    And local Git Town setting "sync-feature-strategy" is "rebase"
+    And local Git Town setting "sync-upstream" is "false"
+    And I ran "git-town config export" and enter into the dialog:
+      | DIALOG        | KEYS  |
+      | apply changes | enter |
+    When I run "git-town config import" and enter into the dialog:
+      | DIALOG        | KEYS  |
+      | apply changes | enter |
+
+  Scenario: result
+    Then the main branch is now "main"
+    And the perennial branches are now "qa"
+    And branch "shared" now has the sync strategy "merge"
+    And local Git Town setting "feature-regex" is now "^user-"
+    And local Git Town setting "hook-after-ship" is now "make deploy"
+    And local Git Town setting "prototype-branches" is now "prototype"
+    And local Git Town setting "sync-feature-strategy" is now "rebase"
+    And local Git Town setting "sync-upstream" is now "false"
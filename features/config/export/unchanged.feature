@@ -0,0 +1,42 @@
+Feature: export a configuration that the configuration file already contains
+
+  Background:
+    Given a Git repo with origin
+    And the configuration file:
+      """
+      # More info around this file at https://www.git-town.com/configuration-file
+
+      [branches]
+      main = "main"
+      perennials = []
+      perennial-regex = ""
+
+      [create]
+      new-branch-type = "feature"
+      push-new-branches = false
+
+      [hosting]
+      dev-remote = "origin"
+      # platform = ""
+      # origin-hostname = ""
+
+      [ship]
+      delete-tracking-branch = true
+      strategy = "api"
+
+      [sync]
+      feature-strategy = "merge"
+      perennial-strategy = "rebase"
+      prototype-strategy = "merge"
+      push-hook = true
+      tags = true
+      upstream = true
+      """
+    When I run "git-town config export"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      .git-branches.toml already contains the current configuration.
+      """
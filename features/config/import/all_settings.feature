@@ -0,0 +1,72 @@
+@messyoutput
+Feature: import the configuration file into the Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | qa     | (none)  |        | local, origin |
+      | shared | feature | main   | local         |
+    And the configuration file:
+      """
+      [aliases]
+      commands = ["append", "hack"]
+
+      [branches]
+      main = "main"
+      perennials = ["qa"]
+      contribution-regex = "^renovate/"
+      default-type = "observed"
+
+      [hooks]
+      after-ship = "make deploy"
+
+      [sync]
+      push-hook = false
+      upstream = false
+
+      [sync.branches]
+      "shared" = "merge"
+      """
+    When I run "git-town config import" and enter into the dialog:
+      | DIALOG        | KEYS  |
+      | apply changes | enter |
+
+  Scenario: result
+    Then Git Town prints:
+      """
+      Changes to the Git metadata:
+      """
+    And Git Town prints:
+      """
+      + git-town.hook-after-ship = make deploy
+      """
+    And Git Town prints:
+      """
+      + git-town-branch.shared.sync-strategy = merge
+      """
+    And Git Town prints:
+      """
+      + alias.append = town append
+      """
+    And the main branch is now "main"
+    And the perennial branches are now "qa"
+    And branch "shared" now has the sync strategy "merge"
+    And local Git Town setting "contribution-regex" is now "^renovate/"
+    And local Git Town setting "default-branch-type" is now "observed"
+    And local Git Town setting "hook-after-ship" is now "make deploy"
+    And local Git Town setting "push-hook" is now "false"
+    And local Git Town setting "sync-upstream" is now "false"
+    And global Git setting "alias.append" is now "town append"
+    And global Git setting "alias.hack" is now "town hack"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then local Git Town setting "contribution-regex" now doesn't exist
+    And branch "shared" now has no sync strategy
+    And local Git Town setting "default-branch-type" now doesn't exist
+    And local Git Town setting "hook-after-ship" now doesn't exist
+    And local Git Town setting "push-hook" now doesn't exist
+    And local Git Town setting "sync-upstream" now doesn't exist
+    And global Git setting "alias.append" now doesn't exist
+    And global Git setting "alias.hack" now doesn't exist
@@ -0,0 +1,12 @@
+Feature: import without a configuration file
+
+  Background:
+    Given a Git repo with origin
+    When I run "git-town config import"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot find the configuration file ".git-branches.toml" to import
+      """
@@ -12,12 +12,14 @@ Feature: reset the configuration
     And global Git setting "alias.hack" is "town hack"
     And global Git setting "alias.sync" is "town sync"
     And global Git setting "alias.append" is "commit --amend"
+    And branch "feature" has the sync strategy "merge"
     When I run "git-town config remove"
     Then Git Town runs the commands
       | COMMAND                                |
       | git config --global --unset alias.hack |
       | git config --global --unset alias.sync |
     And Git Town is no longer configured
+    And branch "feature" now has no sync strategy
     And global Git setting "alias.append" is still "commit --amend"
 
   Scenario: no configuration
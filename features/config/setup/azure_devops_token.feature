@@ -0,0 +1,74 @@
+@messyoutput
+Feature: enter the Azure DevOps personal access token
+
+  Background:
+    Given a Git repo with origin
+
+  Scenario: auto-detected Azure DevOps platform
+    And my repo's "origin" remote is "git@ssh.dev.azure.com:v3/git-town/git-town/docs"
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                        | KEYS              | DESCRIPTION                                 |
+      | welcome                       | enter             |                                             |
+      | aliases                       | enter             |                                             |
+      | main branch                   | enter             |                                             |
+      | perennial branches            |                   | no input here since the dialog doesn't show |
+      | perennial regex               | enter             |                                             |
+      | default branch type           | enter             |                                             |
+      | feature regex                 | enter             |                                             |
+      | dev-remote                    | enter             |                                             |
+      | hosting platform: auto-detect | enter             |                                             |
+      | azure devops token            | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname               | enter             |                                             |
+      | sync-feature-strategy         | enter             |                                             |
+      | sync-perennial-strategy       | enter             |                                             |
+      | sync-prototype-strategy       | enter             |                                             |
+      | sync-upstream                 | enter             |                                             |
+      | sync-tags                     | enter             |                                             |
+      | push-new-branches             | enter             |                                             |
+      | push-hook                     | enter             |                                             |
+      | new-branch-type               | down enter        |                                             |
+      | ship-strategy                 | enter             |                                             |
+      | ship-delete-tracking-branch   | enter             |                                             |
+      | save config to Git metadata   | down enter        |                                             |
+    Then Git Town runs the commands
+      | COMMAND                                       |
+      | git config git-town.azure-devops-token 123456 |
+    And local Git Town setting "hosting-platform" still doesn't exist
+    And local Git Town setting "azure-devops-token" is now "123456"
+
+  Scenario: select Azure DevOps manually
+    When I run "git-town config setup" and enter into the dialog:
+      | DIALOG                      | KEYS              | DESCRIPTION                                 |
+      | welcome                     | enter             |                                             |
+      | aliases                     | enter             |                                             |
+      | main branch                 | enter             |                                             |
+      | perennial branches          |                   | no input here since the dialog doesn't show |
+      | perennial regex             | enter             |                                             |
+      | default branch type         | enter             |                                             |
+      | feature regex               | enter             |                                             |
+      | dev-remote                  | enter             |                                             |
+      | hosting platform            | down enter        |                                             |
+      | azure devops token          | 1 2 3 4 5 6 enter |                                             |
+      | origin hostname             | enter             |                                             |
+      | sync-feature-strategy       | enter             |                                             |
+      | sync-perennial-strategy     | enter             |                                             |
+      | sync-prototype-strategy     | enter             |                                             |
+      | sync-upstream               | enter             |                                             |
+      | sync-tags                   | enter             |                                             |
+      | push-new-branches           | enter             |                                             |
+      | push-hook                   | enter             |                                             |
+      | new-branch-type             | enter             |                                             |
+      | ship-strategy               | enter             |                                             |
+      | ship-delete-tracking-branch | enter             |                                             |
+      | save config to Git metadata | down enter        |                                             |
+    Then Git Town runs the commands
+      | COMMAND                                          |
+      | git config git-town.azure-devops-token 123456    |
+      | git config git-town.hosting-platform azuredevops |
+    And local Git Town setting "hosting-platform" is now "azuredevops"
+    And local Git Town setting "azure-devops-token" is now "123456"
+
+  Scenario: undo
+    When I run "git-town undo"
+    And local Git Town setting "hosting-platform" now doesn't exist
+    And local Git Town setting "azure-devops-token" now doesn't exist
@@ -38,29 +38,29 @@ Feature: enter the Gitea API token
 
   Scenario: select Gitea manually
     When I run "git-town config setup" and enter into the dialog:
-      | DIALOG                      | KEYS                 | DESCRIPTION                                 |
-      | welcome                     | enter                |                                             |
-      | aliases                     | enter                |                                             |
-      | main branch                 | enter                |                                             |
-      | perennial branches          |                      | no input here since the dialog doesn't show |
-      | perennial regex             | enter                |                                             |
-      | default branch type         | enter                |                                             |
-      | feature regex               | enter                |                                             |
-      | dev-remote                  | enter                |                                             |
-      | hosting platform            | down down down enter |                                             |
-      | gitea token                 | 1 2 3 4 5 6 enter    |                                             |
-      | origin hostname             | enter                |                                             |
-      | sync-feature-strategy       | enter                |                                             |
-      | sync-perennial-strategy     | enter                |                                             |
-      | sync-prototype-strategy     | enter                |                                             |
-      | sync-upstream               | enter                |                                             |
-      | sync-tags                   | enter                |                                             |
-      | push-new-branches           | enter                |                                             |
-      | push-hook                   | enter                |                                             |
-      | new-branch-type             | enter                |                                             |
-      | ship-strategy               | enter                |                                             |
-      | ship-delete-tracking-branch | enter                |                                             |
-      | save config to Git metadata | down enter           |                                             |
+      | DIALOG                      | KEYS                      | DESCRIPTION                                 |
+      | welcome                     | enter                     |                                             |
+      | aliases                     | enter                     |                                             |
+      | main branch                 | enter                     |                                             |
+      | perennial branches          |                           | no input here since the dialog doesn't show |
+      | perennial regex             | enter                     |                                             |
+      | default branch type         | enter                     |                                             |
+      | feature regex               | enter                     |                                             |
+      | dev-remote                  | enter                     |                                             |
+      | hosting platform            | down down down down enter |                                             |
+      | gitea token                 | 1 2 3 4 5 6 enter         |                                             |
+      | origin hostname             | enter                     |                                             |
+      | sync-feature-strategy       | enter                     |                                             |
+      | sync-perennial-strategy     | enter                     |                                             |
+      | sync-prototype-strategy     | enter                     |                                             |
+      | sync-upstream               | enter                     |                                             |
+      | sync-tags                   | enter                     |                                             |
+      | push-new-branches           | enter                     |                                             |
+      | push-hook                   | enter                     |                                             |
+      | new-branch-type             | enter                     |                                             |
+      | ship-strategy               | enter                     |                                             |
+      | ship-delete-tracking-branch | enter                     |                                             |
+      | save config to Git metadata | down enter                |                                             |
     Then Git Town runs the commands
       | COMMAND                                    |
       | git config git-town.gitea-token 123456     |
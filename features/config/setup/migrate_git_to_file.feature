@@ -55,8 +55,8 @@ Feature: migrate existing configuration in Git metadata to a config file
     And local Git Town setting "sync-upstream" now doesn't exist
     And local Git Town setting "sync-tags" now doesn't exist
     And local Git Town setting "perennial-regex" now doesn't exist
-    And local Git Town setting "feature-regex" is still "user-.*"
-    And local Git Town setting "default-branch-type" is still "observed"
+    And local Git Town setting "feature-regex" now doesn't exist
+    And local Git Town setting "default-branch-type" now doesn't exist
     And local Git Town setting "push-new-branches" now doesn't exist
     And local Git Town setting "push-hook" now doesn't exist
     And local Git Town setting "new-branch-type" now doesn't exist
@@ -70,6 +70,8 @@ Feature: migrate existing configuration in Git metadata to a config file
       main = "main"
       perennials = ["qa"]
       perennial-regex = "release-.*"
+      default-type = "observed"
+      feature-regex = "user-.*"
 
       [create]
       new-branch-type = "parked"
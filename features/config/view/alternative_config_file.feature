@@ -16,6 +16,7 @@ Feature: show the configuration when using an alternative config file
         contribution regex: (not set)
         default branch type: feature
         feature regex: (not set)
+        infer parents: no
         main branch: main
         observed branches: (none)
         observed regex: (not set)
@@ -30,15 +31,22 @@ Feature: show the configuration when using an alternative config file
         new branch type: feature
         push new branches: no
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: (not set)
         hostname: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: yes
+        squash commit template: (not set)
         strategy: api
 
       Sync:
@@ -46,6 +54,7 @@ Feature: show the configuration when using an alternative config file
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync-prototype strategy: merge
+        sync metadata: no
         sync tags: yes
         sync with upstream: yes
       """
@@ -0,0 +1,52 @@
+Feature: show the sync strategies of individual branches
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS |
+      | shared | feature | main   | local     |
+    And branch "shared" has the sync strategy "merge"
+    And the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches."personal/*"]
+      sync-strategy = "rebase"
+      """
+
+  Scenario: text output
+    When I run "git-town config"
+    Then Git Town prints:
+      """
+      Sync:
+        run pre-push hook: yes
+        sync-feature strategy: merge
+        sync-perennial strategy: rebase
+        sync-prototype strategy: merge
+        sync metadata: no
+        sync tags: yes
+        sync with upstream: yes
+
+      Branch Sync Strategies:
+        shared: merge
+        personal/*: rebase
+      """
+
+  Scenario: JSON output
+    When I run "git-town config --format=json"
+    Then Git Town prints:
+      """
+        "branch-sync-strategies": [
+          {
+            "branch": "shared",
+            "source": "local",
+            "sync-strategy": "merge"
+          },
+          {
+            "branch": "personal/*",
+            "source": "config-file",
+            "sync-strategy": "rebase"
+          }
+        ],
+      """
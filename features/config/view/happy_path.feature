@@ -23,6 +23,7 @@ Feature: show the configuration
         contribution regex: ^renovate/
         default branch type: observed
         feature regex: ^user-.*$
+        infer parents: no
         main branch: main
         observed branches: observed-1, observed-2
         observed regex: ^dependabot/
@@ -37,15 +38,22 @@ Feature: show the configuration
         new branch type: feature
         push new branches: no
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: (not set)
         hostname: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: yes
+        squash commit template: (not set)
         strategy: squash-merge
 
       Sync:
@@ -53,6 +61,7 @@ Feature: show the configuration
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync-prototype strategy: merge
+        sync metadata: no
         sync tags: yes
         sync with upstream: yes
       """
@@ -95,6 +104,7 @@ Feature: show the configuration
         contribution regex: ^renovate/
         default branch type: observed
         feature regex: ^user-.*$
+        infer parents: no
         main branch: main
         observed branches: (none)
         observed regex: ^dependabot/
@@ -109,15 +119,22 @@ Feature: show the configuration
         new branch type: feature
         push new branches: yes
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: github
         hostname: github.com
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: yes
+        squash commit template: (not set)
         strategy: squash-merge
 
       Sync:
@@ -125,6 +142,7 @@ Feature: show the configuration
         sync-feature strategy: rebase
         sync-perennial strategy: merge
         sync-prototype strategy: compress
+        sync metadata: no
         sync tags: no
         sync with upstream: yes
       """
@@ -185,6 +203,7 @@ Feature: show the configuration
         contribution regex: ^git-contribution-regex
         default branch type: observed
         feature regex: git-feature-.*
+        infer parents: no
         main branch: git-main
         observed branches: observed-1, observed-2
         observed regex: ^git-observed-regex
@@ -199,15 +218,22 @@ Feature: show the configuration
         new branch type: feature
         push new branches: no
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: github
         hostname: github.com
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: no
+        squash commit template: (not set)
         strategy: squash-merge
 
       Sync:
@@ -215,6 +241,7 @@ Feature: show the configuration
         sync-feature strategy: merge
         sync-perennial strategy: merge
         sync-prototype strategy: compress
+        sync metadata: no
         sync tags: no
         sync with upstream: no
       """
@@ -235,6 +262,7 @@ Feature: show the configuration
         contribution regex: (not set)
         default branch type: feature
         feature regex: (not set)
+        infer parents: no
         main branch: main
         observed branches: (none)
         observed regex: (not set)
@@ -249,15 +277,22 @@ Feature: show the configuration
         new branch type: feature
         push new branches: no
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: (not set)
         hostname: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: yes
+        squash commit template: (not set)
         strategy: api
 
       Sync:
@@ -265,6 +300,7 @@ Feature: show the configuration
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync-prototype strategy: merge
+        sync metadata: no
         sync tags: yes
         sync with upstream: yes
 
@@ -288,6 +324,7 @@ Feature: show the configuration
         contribution regex: (not set)
         default branch type: feature
         feature regex: (not set)
+        infer parents: no
         main branch: (not set)
         observed branches: (none)
         observed regex: (not set)
@@ -302,15 +339,22 @@ Feature: show the configuration
         new branch type: feature
         push new branches: no
 
+      Hooks:
+        after create branch: (not set)
+        after ship: (not set)
+        before sync branch: (not set)
+
       Hosting:
         hosting platform: (not set)
         hostname: (not set)
         GitHub token: (not set)
         GitLab token: (not set)
         Gitea token: (not set)
+        Azure DevOps token: (not set)
 
       Ship:
         delete the tracking branch: yes
+        squash commit template: (not set)
         strategy: api
 
       Sync:
@@ -318,6 +362,7 @@ Feature: show the configuration
         sync-feature strategy: merge
         sync-perennial strategy: rebase
         sync-prototype strategy: merge
+        sync metadata: no
         sync tags: yes
         sync with upstream: yes
       """
@@ -0,0 +1,179 @@
+Feature: show the configuration as JSON
+
+  Background:
+    Given a Git repo with origin
+
+  Scenario: configured in local and global Git metadata and the config file
+    Given the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS |
+      | feature | feature | main   | local     |
+    And Git Town setting "perennial-branches" is "qa"
+    And Git Town setting "trust-config-file-hooks" is "true"
+    And global Git Town setting "sync-tags" is "false"
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [hooks]
+      after-ship = "make notify"
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town config --format=json"
+    Then Git Town prints:
+      """
+        "lineage": [
+          {
+            "branch": "feature",
+            "parent": "main",
+            "source": "local"
+          }
+        ],
+        "settings": {
+          "azure-devops-token": {
+            "source": "default",
+            "value": null
+          },
+          "contribution-branches": {
+            "source": "default",
+            "value": []
+          },
+          "contribution-regex": {
+            "source": "default",
+            "value": null
+          },
+          "default-branch-type": {
+            "source": "default",
+            "value": "feature"
+          },
+          "dev-remote": {
+            "source": "default",
+            "value": "origin"
+          },
+          "feature-regex": {
+            "source": "default",
+            "value": null
+          },
+          "gitea-token": {
+            "source": "default",
+            "value": null
+          },
+          "github-token": {
+            "source": "default",
+            "value": null
+          },
+          "gitlab-token": {
+            "source": "default",
+            "value": null
+          },
+          "hook-after-create-branch": {
+            "source": "default",
+            "value": null
+          },
+          "hook-after-ship": {
+            "source": "config-file",
+            "value": "make notify"
+          },
+          "hook-before-sync-branch": {
+            "source": "default",
+            "value": null
+          },
+          "hosting-origin-hostname": {
+            "source": "default",
+            "value": null
+          },
+          "hosting-platform": {
+            "source": "default",
+            "value": null
+          },
+          "infer-parents": {
+            "source": "default",
+            "value": false
+          },
+          "main-branch": {
+            "source": "local",
+            "value": "main"
+          },
+          "new-branch-type": {
+            "source": "default",
+            "value": "feature"
+          },
+          "observed-branches": {
+            "source": "default",
+            "value": []
+          },
+          "observed-regex": {
+            "source": "default",
+            "value": null
+          },
+          "offline": {
+            "source": "default",
+            "value": false
+          },
+          "parked-branches": {
+            "source": "default",
+            "value": []
+          },
+          "perennial-branches": {
+            "source": "local",
+            "value": [
+              "qa"
+            ]
+          },
+          "perennial-regex": {
+            "source": "default",
+            "value": null
+          },
+          "prototype-branches": {
+            "source": "default",
+            "value": []
+          },
+          "push-hook": {
+            "source": "default",
+            "value": true
+          },
+          "push-new-branches": {
+            "source": "default",
+            "value": false
+          },
+          "ship-delete-tracking-branch": {
+            "source": "default",
+            "value": true
+          },
+          "ship-squash-commit-template": {
+            "source": "default",
+            "value": null
+          },
+          "ship-strategy": {
+            "source": "default",
+            "value": "api"
+          },
+          "sync-feature-strategy": {
+            "source": "config-file",
+            "value": "rebase"
+          },
+          "sync-metadata": {
+            "source": "default",
+            "value": false
+          },
+          "sync-perennial-strategy": {
+            "source": "default",
+            "value": "rebase"
+          },
+          "sync-prototype-strategy": {
+            "source": "default",
+            "value": "rebase"
+          },
+          "sync-tags": {
+            "source": "global",
+            "value": false
+          },
+          "sync-upstream": {
+            "source": "default",
+            "value": true
+          }
+        }
+      }
+      """
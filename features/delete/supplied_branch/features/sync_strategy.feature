@@ -0,0 +1,38 @@
+Feature: delete a branch that has its own sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME | TYPE    | PARENT | LOCATIONS     |
+      | good | feature | main   | local, origin |
+      | dead | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE         |
+      | dead   | local, origin | dead-end commit |
+      | good   | local, origin | good commit     |
+    And branch "dead" has the sync strategy "merge"
+    And the current branch is "good"
+    When I run "git-town delete dead"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | good   | git fetch --prune --tags |
+      |        | git push origin :dead    |
+      |        | git branch -D dead       |
+    And the current branch is still "good"
+    And branch "dead" now has no sync strategy
+    And the branches are now
+      | REPOSITORY    | BRANCHES   |
+      | local, origin | main, good |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                     |
+      | good   | git branch dead {{ sha 'dead-end commit' }} |
+      |        | git push -u origin dead                     |
+    And the current branch is still "good"
+    And branch "dead" now has the sync strategy "merge"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
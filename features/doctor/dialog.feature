@@ -0,0 +1,36 @@
+@messyoutput
+Feature: fix problems after confirming them in a dialog
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And local Git Town setting "contribution-branches" is "gone"
+    And a runstate file "other-repo.json" that Git Town cannot load
+
+  Scenario: apply the changes
+    When I run "git-town doctor" and enter into the dialog:
+      | DIALOG        | KEYS  |
+      | apply changes | enter |
+    Then Git Town prints:
+      """
+      git-town.contribution-branches contains the branch "gone", which doesn't exist
+      """
+    And Git Town prints:
+      """
+      Fixed all problems.
+      """
+    And local Git Town setting "contribution-branches" now doesn't exist
+    And the runstate file "other-repo.json" now doesn't exist
+
+  Scenario: keep the current configuration
+    When I run "git-town doctor" and enter into the dialog:
+      | DIALOG        | KEYS       |
+      | apply changes | down enter |
+    Then Git Town prints:
+      """
+      git-town.contribution-branches contains the branch "gone", which doesn't exist
+      """
+    And local Git Town setting "contribution-branches" is still "gone"
+    And the runstate file "other-repo.json" still exists
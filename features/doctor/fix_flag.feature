@@ -0,0 +1,39 @@
+Feature: fix all problems without asking
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE      | PARENT | LOCATIONS     |
+      | qa      | perennial |        | local, origin |
+      | feature | feature   | main   | local, origin |
+    And local Git Town setting "observed-branches" is "qa"
+    And local Git Town setting "parked-branches" is "feature gone"
+    And Git Town parent setting for branch "deleted" is "main"
+    And a runstate file "other-repo.json" that Git Town cannot load
+    When I run "git-town doctor --fix"
+
+  Scenario: result
+    Then Git Town prints:
+      """
+      Problems:
+        the lineage contains a parent for branch "deleted", which doesn't exist
+        git-town.parked-branches contains the branch "gone", which doesn't exist
+        branch "qa" is both a perennial and an observed branch
+        cannot load the runstate file "
+      """
+    And Git Town prints:
+      """
+      Fixed all problems.
+      """
+    And local Git Town setting "observed-branches" now doesn't exist
+    And local Git Town setting "parked-branches" is now "feature"
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+    And the runstate file "other-repo.json" now doesn't exist
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then local Git Town setting "observed-branches" is now "qa"
+    And local Git Town setting "parked-branches" is now "feature gone"
+    And the initial lineage exists now
@@ -0,0 +1,15 @@
+Feature: repository without problems
+
+  Scenario:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE     | PARENT | LOCATIONS     |
+      | feature | feature  | main   | local, origin |
+      | other   | observed |        | local, origin |
+    When I run "git-town doctor"
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      No problems found.
+      """
+    And the initial lineage exists now
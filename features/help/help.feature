@@ -12,12 +12,14 @@ Feature: help for commands
 
     Examples:
       | COMMAND     |
+      | absorb      |
       | append      |
       | completions |
       | config      |
       | diff-parent |
       | hack        |
       | help        |
+      | move-commit |
       | delete      |
       | offline     |
       | prepend     |
@@ -26,6 +28,8 @@ Feature: help for commands
       | repo        |
       | set-parent  |
       | ship        |
+      | split       |
+      | stack       |
       | sync        |
 
   Scenario Outline: outside a Git repository
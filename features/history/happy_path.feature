@@ -0,0 +1,32 @@
+Feature: display the most recent Git Town commands
+
+  Scenario: several commands
+    Given a Git repo with origin
+    And the current branch is "main"
+    And I ran "git-town hack alpha"
+    And I ran "git-town append beta"
+    When I run "git-town history"
+    Then Git Town prints something like:
+      """
+      ^1  append  \(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d, alpha, beta, main\)\n2  hack  \(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d, alpha, main\)$
+      """
+
+  Scenario: undone commands disappear from the history
+    Given a Git repo with origin
+    And the current branch is "main"
+    And I ran "git-town hack alpha"
+    And I ran "git-town append beta"
+    And I ran "git-town undo"
+    When I run "git-town history"
+    Then Git Town prints something like:
+      """
+      ^1  hack  \(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d, alpha, main\)$
+      """
+
+  Scenario: no commands
+    Given a Git repo with origin
+    When I run "git-town history"
+    Then Git Town prints:
+      """
+      The history contains no Git Town commands.
+      """
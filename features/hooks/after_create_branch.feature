@@ -0,0 +1,60 @@
+Feature: run a hook after creating a branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the current branch is "existing"
+    And Git Town setting "hook-after-create-branch" is "echo created $1"
+
+  Scenario: hack
+    When I run "git-town hack new"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | existing | git fetch --prune --tags                        |
+      |          | git checkout main                               |
+      | main     | git rebase origin/main --no-update-refs         |
+      |          | git checkout -b new                             |
+      | <none>   | sh -c "echo created $1" after-create-branch new |
+    And Git Town prints:
+      """
+      created new
+      """
+    And the current branch is now "new"
+
+  Scenario: append
+    When I run "git-town append new"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | existing | git fetch --prune --tags                        |
+      |          | git checkout main                               |
+      | main     | git rebase origin/main --no-update-refs         |
+      |          | git checkout existing                           |
+      | existing | git merge --no-edit --ff main                   |
+      |          | git merge --no-edit --ff origin/existing        |
+      |          | git checkout -b new                             |
+      | <none>   | sh -c "echo created $1" after-create-branch new |
+    And Git Town prints:
+      """
+      created new
+      """
+    And the current branch is now "new"
+
+  Scenario: prepend
+    When I run "git-town prepend new"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                                         |
+      | existing | git fetch --prune --tags                        |
+      |          | git checkout main                               |
+      | main     | git rebase origin/main --no-update-refs         |
+      |          | git checkout existing                           |
+      | existing | git merge --no-edit --ff main                   |
+      |          | git merge --no-edit --ff origin/existing        |
+      |          | git checkout -b new main                        |
+      | <none>   | sh -c "echo created $1" after-create-branch new |
+    And Git Town prints:
+      """
+      created new
+      """
+    And the current branch is now "new"
@@ -0,0 +1,48 @@
+Feature: a hook that runs after creating a branch fails
+
+  Background:
+    Given a Git repo with origin
+    And the current branch is "main"
+    And Git Town setting "hook-after-create-branch" is "test -f .git/approved"
+    When I run "git-town hack new"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                               |
+      | main   | git fetch --prune --tags                              |
+      |        | git rebase origin/main --no-update-refs               |
+      |        | git checkout -b new                                   |
+      | <none> | sh -c "test -f .git/approved" after-create-branch new |
+    And Git Town prints the error:
+      """
+      the "after-create-branch" hook failed: exit status 1
+      """
+    And Git Town prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      """
+    And the current branch is now "new"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND           |
+      | new    | git checkout main |
+      | main   | git branch -D new |
+    And the current branch is now "main"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: fix the problem and continue
+    Given file ".git/approved" with content
+      """
+      """
+    When I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                               |
+      |        | sh -c "test -f .git/approved" after-create-branch new |
+    And the current branch is still "new"
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | new    | main   |
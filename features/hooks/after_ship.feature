@@ -0,0 +1,47 @@
+Feature: run a hook after shipping a branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "squash-merge"
+    And Git Town setting "hook-after-ship" is "echo shipped $1"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                    |
+      | feature | git fetch --prune --tags                   |
+      |         | git checkout main                          |
+      | main    | git merge --squash --ff feature            |
+      |         | git commit -m done                         |
+      |         | git push                                   |
+      |         | git push origin :feature                   |
+      |         | git branch -D feature                      |
+      | <none>  | sh -c "echo shipped $1" after-ship feature |
+    And Git Town prints:
+      """
+      shipped feature
+      """
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | main   | local, origin | done    |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git revert {{ sha 'done' }}                   |
+      |        | git push                                      |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And the initial branches and lineage exist now
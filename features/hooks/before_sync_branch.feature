@@ -0,0 +1,39 @@
+Feature: run a hook before syncing each branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And Git Town setting "hook-before-sync-branch" is "echo syncing $1"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                            |
+      | feature | git fetch --prune --tags                           |
+      |         | git checkout main                                  |
+      | <none>  | sh -c "echo syncing $1" before-sync-branch main    |
+      | main    | git rebase origin/main --no-update-refs            |
+      |         | git checkout feature                               |
+      | <none>  | sh -c "echo syncing $1" before-sync-branch feature |
+      | feature | git merge --no-edit --ff main                      |
+      |         | git merge --no-edit --ff origin/feature            |
+    And Git Town prints:
+      """
+      syncing main
+      """
+    And Git Town prints:
+      """
+      syncing feature
+      """
+    And the current branch is still "feature"
+    And the initial commits exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,87 @@
+Feature: a hook that runs before syncing a branch fails
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE        |
+      | main    | origin   | main commit    |
+      | feature | local    | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "hook-before-sync-branch" is "test -f .git/approved-$1"
+    And file ".git/approved-main" with content
+      """
+      """
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                     |
+      | feature | git fetch --prune --tags                                    |
+      |         | git checkout main                                           |
+      | <none>  | sh -c "test -f .git/approved-$1" before-sync-branch main    |
+      | main    | git rebase origin/main --no-update-refs                     |
+      |         | git checkout feature                                        |
+      | <none>  | sh -c "test -f .git/approved-$1" before-sync-branch feature |
+    And Git Town prints the error:
+      """
+      the "before-sync-branch" hook failed: exit status 1
+      """
+    And Git Town prints the error:
+      """
+      To continue after having resolved conflicts, run "git town continue".
+      To go back to where you started, run "git town undo".
+      To continue by skipping the current branch, run "git town skip".
+      """
+    And the current branch is still "feature"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                     |
+      | feature | git checkout main                           |
+      | main    | git reset --hard {{ sha 'initial commit' }} |
+      |         | git checkout feature                        |
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: continue with the hook still failing
+    When I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                     |
+      |        | sh -c "test -f .git/approved-$1" before-sync-branch feature |
+    And Git Town prints the error:
+      """
+      the "before-sync-branch" hook failed: exit status 1
+      """
+    And the current branch is still "feature"
+
+  Scenario: fix the problem and continue
+    Given file ".git/approved-feature" with content
+      """
+      """
+    When I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                     |
+      |         | sh -c "test -f .git/approved-$1" before-sync-branch feature |
+      | feature | git merge --no-edit --ff main                               |
+      |         | git merge --no-edit --ff origin/feature                     |
+      |         | git push                                                    |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                          |
+      | main    | local, origin | main commit                      |
+      | feature | local, origin | feature commit                   |
+      |         |               | Merge branch 'main' into feature |
+
+  Scenario: skip
+    When I run "git-town skip"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | main    | local, origin | main commit    |
+      | feature | local         | feature commit |
@@ -0,0 +1,52 @@
+Feature: run hooks defined in the configuration file only if the user trusts them
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [hooks]
+      before-sync-branch = "echo syncing $1"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: hooks not trusted
+    When I run "git-town sync"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff main           |
+      |         | git merge --no-edit --ff origin/feature |
+    And Git Town prints:
+      """
+      The configuration file defines hooks, which Git Town doesn't run until you trust them.
+      To run them in this repository, run: git config git-town.trust-config-file-hooks true
+      """
+    And Git Town does not print "syncing feature"
+
+  Scenario: hooks trusted
+    Given Git Town setting "trust-config-file-hooks" is "true"
+    When I run "git-town sync"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                            |
+      | feature | git fetch --prune --tags                           |
+      |         | git checkout main                                  |
+      | <none>  | sh -c "echo syncing $1" before-sync-branch main    |
+      | main    | git rebase origin/main --no-update-refs            |
+      |         | git checkout feature                               |
+      | <none>  | sh -c "echo syncing $1" before-sync-branch feature |
+      | feature | git merge --no-edit --ff main                      |
+      |         | git merge --no-edit --ff origin/feature            |
+    And Git Town prints:
+      """
+      syncing feature
+      """
+    And Git Town does not print "trust-config-file-hooks"
@@ -0,0 +1,66 @@
+@messyoutput
+Feature: move a commit that conflicts with the target branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME | FILE CONTENT   |
+      | parent | local, origin | parent commit | file      | parent content |
+      | child  | local, origin | child commit  | file      | child content  |
+    And the current branch is "child"
+    When I run "git-town move-commit" and enter into the dialogs:
+      | DIALOG          | KEYS        |
+      | commits to move | space enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                             |
+      | child  | git fetch --prune --tags                            |
+      |        | git checkout parent                                 |
+      | parent | git cherry-pick {{ sha-before-run 'child commit' }} |
+    And Git Town prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in file
+      """
+    And the current branch is now "parent"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                              |
+      | parent | git cherry-pick --abort                              |
+      |        | git add -A                                           |
+      |        | git commit -m "Committing open changes to undo them" |
+      |        | git checkout child                                   |
+    And the current branch is still "child"
+    And the initial commits exist now
+    And the initial lineage exists now
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "file" with "resolved content"
+    And I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                        |
+      | parent | git -c core.editor=true cherry-pick --continue |
+      |        | git checkout child                             |
+      | child  | git revert {{ sha-before-run 'child commit' }} |
+      |        | git checkout parent                            |
+      | parent | git merge --no-edit --ff main                  |
+      |        | git merge --no-edit --ff origin/parent         |
+      |        | git push                                       |
+      |        | git checkout child                             |
+      | child  | git merge --no-edit --ff parent                |
+      |        | git merge --no-edit --ff origin/child          |
+      |        | git push                                       |
+    And the current branch is now "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                          |
+      | child  | local, origin | child commit                     |
+      |        |               | Revert "child commit"            |
+      |        |               | Merge branch 'parent' into child |
+      | parent | local, origin | parent commit                    |
+      |        |               | child commit                     |
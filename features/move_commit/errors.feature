@@ -0,0 +1,79 @@
+Feature: does not move commits out of unsupported branches
+
+  Scenario: on the main branch
+    Given a Git repo with origin
+    When I run "git-town move-commit"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | main   | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot move commits out of branch "main" because it is not a feature branch
+      """
+
+  Scenario: on an observed branch
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE     | LOCATIONS     |
+      | observed | observed | local, origin |
+    And the current branch is "observed"
+    When I run "git-town move-commit"
+    Then Git Town runs the commands
+      | BRANCH   | COMMAND                  |
+      | observed | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot move commits out of branch "observed" because it is not a feature branch
+      """
+
+  Scenario: feature branch without commits
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    When I run "git-town move-commit"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      branch "feature" has no commits to move
+      """
+
+  Scenario: feature branch of the main branch
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    When I run "git-town move-commit"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      branch "feature" has no local ancestor branch to move commits into, Git Town doesn't move commits into the main branch or perennial branches
+      """
+
+  Scenario: feature branch of a perennial branch
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE      | PARENT | LOCATIONS     |
+      | qa      | perennial |        | local, origin |
+      | feature | feature   | qa     | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    When I run "git-town move-commit"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      branch "feature" has no local ancestor branch to move commits into, Git Town doesn't move commits into the main branch or perennial branches
+      """
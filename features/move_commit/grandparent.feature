@@ -0,0 +1,70 @@
+@messyoutput
+Feature: move a commit into the grandparent branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME        | TYPE    | PARENT      | LOCATIONS     |
+      | grandparent | feature | main        | local, origin |
+      | parent      | feature | grandparent | local, origin |
+      | child       | feature | parent      | local, origin |
+    And the commits
+      | BRANCH      | LOCATION      | MESSAGE            | FILE NAME        | FILE CONTENT        |
+      | grandparent | local, origin | grandparent commit | grandparent_file | grandparent content |
+      | child       | local, origin | commit 1           | file_1           | content 1           |
+    And the current branch is "child"
+    When I run "git-town move-commit" and enter into the dialogs:
+      | DIALOG          | KEYS        |
+      | commits to move | space enter |
+      | target branch   | down enter  |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH      | COMMAND                                         |
+      | child       | git fetch --prune --tags                        |
+      |             | git checkout grandparent                        |
+      | grandparent | git cherry-pick {{ sha-before-run 'commit 1' }} |
+      |             | git checkout child                              |
+      | child       | git revert {{ sha-before-run 'commit 1' }}      |
+      |             | git checkout grandparent                        |
+      | grandparent | git merge --no-edit --ff main                   |
+      |             | git merge --no-edit --ff origin/grandparent     |
+      |             | git push                                        |
+      |             | git checkout parent                             |
+      | parent      | git merge --no-edit --ff grandparent            |
+      |             | git merge --no-edit --ff origin/parent          |
+      |             | git push                                        |
+      |             | git checkout child                              |
+      | child       | git merge --no-edit --ff parent                 |
+      |             | git merge --no-edit --ff origin/child           |
+      |             | git push                                        |
+    And Git Town prints:
+      """
+      Selected target branch: grandparent
+      """
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH      | LOCATION      | MESSAGE                          |
+      | child       | local, origin | commit 1                         |
+      |             |               | Revert "commit 1"                |
+      |             |               | Merge branch 'parent' into child |
+      | grandparent | local, origin | grandparent commit               |
+      |             |               | commit 1                         |
+    And the initial lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH      | COMMAND                                          |
+      | child       | git reset --hard {{ sha-before-run 'commit 1' }} |
+      |             | git push --force-with-lease --force-if-includes  |
+      |             | git checkout grandparent                         |
+      | grandparent | git reset --hard {{ sha 'grandparent commit' }}  |
+      |             | git push --force-with-lease --force-if-includes  |
+      |             | git checkout parent                              |
+      | parent      | git reset --hard {{ sha 'initial commit' }}      |
+      |             | git push --force-with-lease --force-if-includes  |
+      |             | git checkout child                               |
+    And the current branch is still "child"
+    And the initial commits exist now
+    And the initial lineage exists now
@@ -0,0 +1,59 @@
+@messyoutput
+Feature: move a commit into the parent branch using the merge sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+      | child  | local, origin | commit 1      | file_1      | content 1      |
+      |        |               | commit 2      | file_2      | content 2      |
+    And the current branch is "child"
+    When I run "git-town move-commit" and enter into the dialogs:
+      | DIALOG          | KEYS        |
+      | commits to move | space enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                  |
+      | child  | git fetch --prune --tags                 |
+      |        | git checkout parent                      |
+      | parent | git cherry-pick {{ sha-before-run 'commit 1' }} |
+      |        | git checkout child                       |
+      | child  | git revert {{ sha-before-run 'commit 1' }} |
+      |        | git checkout parent                      |
+      | parent | git merge --no-edit --ff main            |
+      |        | git merge --no-edit --ff origin/parent   |
+      |        | git push                                 |
+      |        | git checkout child                       |
+      | child  | git merge --no-edit --ff parent          |
+      |        | git merge --no-edit --ff origin/child    |
+      |        | git push                                 |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                          |
+      | child  | local, origin | commit 1                         |
+      |        |               | commit 2                         |
+      |        |               | Revert "commit 1"                |
+      |        |               | Merge branch 'parent' into child |
+      | parent | local, origin | parent commit                    |
+      |        |               | commit 1                         |
+    And the initial lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git reset --hard {{ sha 'commit 2' }}           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout parent                             |
+      | parent | git reset --hard {{ sha 'parent commit' }}      |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout child                              |
+    And the current branch is still "child"
+    And the initial commits exist now
+    And the initial lineage exists now
@@ -0,0 +1,56 @@
+@messyoutput
+Feature: move a commit into the parent branch using the rebase sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+      | child  | local, origin | commit 1      | file_1      | content 1      |
+      |        |               | commit 2      | file_2      | content 2      |
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    And the current branch is "child"
+    When I run "git-town move-commit" and enter into the dialogs:
+      | DIALOG          | KEYS        |
+      | commits to move | space enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                                                |
+      | child  | git fetch --prune --tags                                                               |
+      |        | git checkout parent                                                                    |
+      | parent | git cherry-pick {{ sha-before-run 'commit 1' }}                                        |
+      |        | git checkout child                                                                     |
+      | child  | git rebase --onto {{ sha-before-run 'commit 1' }}^ {{ sha-before-run 'commit 1' }}     |
+      |        | git push --force-with-lease                                                            |
+      |        | git checkout parent                                                                    |
+      | parent | git rebase main --no-update-refs                                                       |
+      |        | git push --force-with-lease --force-if-includes                                        |
+      |        | git checkout child                                                                     |
+      | child  | git rebase parent --no-update-refs                                                     |
+      |        | git push --force-with-lease --force-if-includes                                        |
+    And the current branch is still "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | child  | local, origin | commit 2      |
+      | parent | local, origin | parent commit |
+      |        |               | commit 1      |
+    And the initial lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                         |
+      | child  | git reset --hard {{ sha 'commit 2' }}           |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout parent                             |
+      | parent | git reset --hard {{ sha 'parent commit' }}      |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git checkout child                              |
+    And the current branch is still "child"
+    And the initial commits exist now
+    And the initial lineage exists now
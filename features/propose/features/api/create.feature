@@ -0,0 +1,39 @@
+Feature: create proposals through the API of the code hosting platform
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE                    |
+      | feature | local, origin | add the feature\n\ndetails |
+    And the current branch is "feature"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    And the GitHub API is available
+    When I run "git-town propose --api --title=my_title --body=my_body"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                |
+      | feature | git fetch --prune --tags                                                               |
+      | <none>  | Looking for proposal online ... ok                                                     |
+      | feature | git checkout main                                                                      |
+      | main    | git rebase origin/main --no-update-refs                                                |
+      |         | git checkout feature                                                                   |
+      | feature | git merge --no-edit --ff main                                                          |
+      |         | git merge --no-edit --ff origin/feature                                                |
+      | <none>  | Creating proposal for branch feature ... https://github.com/git-town/git-town/pull/123 |
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                      |
+      |        | Closing proposal #123 ... ok |
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
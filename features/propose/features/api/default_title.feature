@@ -0,0 +1,33 @@
+Feature: default title of proposals created through the API
+
+  Background:
+    Given a Git repo with origin
+    And the current branch is "main"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    And the GitHub API is available
+
+  Scenario: the branch contains commits
+    Given the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE                    |
+      | feature | local, origin | add the feature\n\ndetails |
+    And the current branch is "feature"
+    When I run "git-town propose --api --draft --reviewer=alice --assignee=bob --label=enhancement"
+    Then Git Town prints:
+      """
+      Creating proposal for branch feature ... https://github.com/git-town/git-town/pull/123
+      """
+
+  Scenario: the branch contains no commits
+    Given the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | empty | feature | main   | local, origin |
+    And the current branch is "empty"
+    When I run "git-town propose --api"
+    Then Git Town prints:
+      """
+      Creating proposal for branch empty ... https://github.com/git-town/git-town/pull/123
+      """
@@ -0,0 +1,30 @@
+Feature: print the URL of an already existing proposal
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town propose --api"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | Looking for proposal online ... ok |
+    And Git Town prints:
+      """
+      branch "feature" already has a proposal: https://github.com/git-town/git-town/pull/123
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And the initial commits exist now
@@ -0,0 +1,34 @@
+Feature: options that need the API
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+
+  Scenario: API options without --api
+    Given the origin is "git@github.com:git-town/git-town.git"
+    When I run "git-town propose --draft"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      the --draft, --assignee, --label, and --reviewer flags require --api
+      """
+
+  Scenario: Bitbucket doesn't support labels
+    Given the origin is "git@bitbucket.org:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    When I run "git-town propose --api --label=enhancement"
+    Then Git Town prints the error:
+      """
+      Bitbucket doesn't support labels when creating proposals through its API
+      """
+
+  Scenario: platform without API support for creating proposals
+    Given the origin is "git@ssh.dev.azure.com:v3/git-town/git-town/docs"
+    When I run "git-town propose --api"
+    Then Git Town prints the error:
+      """
+      cannot create proposals through the API of this code hosting platform, please make sure Git Town has an API token for it
+      """
@@ -0,0 +1,49 @@
+Feature: ship via the API while offline
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT  | LOCATIONS     |
+      | feature | feature | main    | local, origin |
+      | child   | feature | feature | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+      | child   | local, origin | child commit   |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    And offline mode is enabled
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      Git Town is offline and performs the changes at the code hosting platform during the next online "git town sync".
+      That sync also removes branch "feature" from the local repository.
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+
+  Scenario: status
+    When I run "git-town status"
+    Then Git Town prints:
+      """
+      Pending actions at the code hosting platform, the next "git town sync" performs them:
+        - change the target of the proposal of branch "child" from "feature" to "main"
+        - merge the proposal of branch "feature" into "main"
+        - delete the tracking branch of "feature"
+      """
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+    When I run "git-town status"
+    Then Git Town does not print "Pending actions at the code hosting platform"
@@ -0,0 +1,42 @@
+Feature: the next online sync performs the actions that ship queued while offline
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT  | LOCATIONS     |
+      | feature | feature | main    | local, origin |
+      | child   | feature | feature | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+      | child   | local, origin | child commit   |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    And offline mode is enabled
+    And I ran "git-town ship -m done"
+    And I ran "git-town offline no"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                |
+      | feature | git fetch --prune --tags                               |
+      | <none>  | Looking for proposal online ... ok                     |
+      |         | Looking for proposal online ... ok                     |
+      |         | Updating target branch of proposal #123 to main ... ok |
+      |         | GitHub API: merging PR #123 ... ok                     |
+      | feature | git checkout main                                      |
+      | main    | git branch -D feature                                  |
+      |         | git push origin :feature                               |
+      |         | git rebase origin/main --no-update-refs                |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, child |
+
+  Scenario: status
+    When I run "git-town status"
+    Then Git Town does not print "Pending actions at the code hosting platform"
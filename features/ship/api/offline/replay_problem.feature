@@ -0,0 +1,47 @@
+Feature: keep the queued actions when the next online sync cannot perform them
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "api"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch does not exist
+    And the GitHub API is available
+    And offline mode is enabled
+    And I ran "git-town ship -m done"
+    And I ran "git-town offline no"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      | <none>  | Looking for proposal online ... ok      |
+      |         | Looking for proposal online ... ok      |
+      | feature | git checkout main                       |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff main           |
+      |         | git merge --no-edit --ff origin/feature |
+    And Git Town prints:
+      """
+      Cannot merge the proposal of branch "feature" into "main": cannot find a proposal of branch "feature" into "main"
+      Git Town tries again during the next sync.
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: status
+    When I run "git-town status"
+    Then Git Town prints:
+      """
+      Pending actions at the code hosting platform, the next "git town sync" performs them:
+        - merge the proposal of branch "feature" into "main"
+        - delete the tracking branch of "feature"
+      """
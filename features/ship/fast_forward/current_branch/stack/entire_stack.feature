@@ -0,0 +1,69 @@
+Feature: ship an entire stack using the fast-forward strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+      | gamma | feature | beta   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+      | gamma  | local, origin | gamma commit |
+    And the current branch is "gamma"
+    And Git Town setting "ship-strategy" is "fast-forward"
+    When I run "git-town ship --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                       |
+      | gamma  | git fetch --prune --tags      |
+      |        | git checkout main             |
+      | main   | git merge --ff-only alpha     |
+      |        | git push                      |
+      |        | git push origin :alpha        |
+      |        | git branch -D alpha           |
+      |        | git checkout beta             |
+      | beta   | git merge --no-edit --ff main |
+      |        | git push                      |
+      |        | git checkout main             |
+      | main   | git merge --ff-only beta      |
+      |        | git push                      |
+      |        | git push origin :beta         |
+      |        | git branch -D beta            |
+      |        | git checkout gamma            |
+      | gamma  | git merge --no-edit --ff main |
+      |        | git push                      |
+      |        | git checkout main             |
+      | main   | git merge --ff-only gamma     |
+      |        | git push                      |
+      |        | git push origin :gamma        |
+      |        | git branch -D gamma           |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                        |
+      | main   | local, origin | gamma commit                   |
+      |        |               | beta commit                    |
+      |        |               | alpha commit                   |
+      |        |               | Merge branch 'main' into beta  |
+      |        |               | Merge branch 'main' into gamma |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                   |
+      | main   | git branch alpha {{ sha 'alpha commit' }} |
+      |        | git push -u origin alpha                  |
+      |        | git branch beta {{ sha 'beta commit' }}   |
+      |        | git push -u origin beta                   |
+      |        | git branch gamma {{ sha 'gamma commit' }} |
+      |        | git push -u origin gamma                  |
+      |        | git checkout gamma                        |
+    And the current branch is now "gamma"
+    And the initial branches and lineage exist now
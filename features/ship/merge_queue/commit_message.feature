@@ -0,0 +1,33 @@
+Feature: cannot provide a commit message when shipping via the merge queue
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "merge-queue"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      shipping with the merge-queue strategy does not use the given commit message
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the initial branches and lineage exist now
+    And the initial commits exist now
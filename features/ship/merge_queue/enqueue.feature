@@ -0,0 +1,37 @@
+Feature: ship a branch via the merge queue
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "merge-queue"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                        |
+      | feature | git fetch --prune --tags                       |
+      | <none>  | Looking for proposal online ... ok             |
+      |         | Adding proposal #123 to the merge queue ... ok |
+    And Git Town prints:
+      """
+      Proposal #123 is now in the merge queue. Once the merge queue has merged it, run "git town sync" to remove branch "feature".
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,39 @@
+Feature: the merge queue rejects the proposal while Git Town waits for it
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "merge-queue"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    And the merge queue removes the proposal without merging it
+    When I run "git-town ship --wait"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                  |
+      | feature | git fetch --prune --tags                                 |
+      | <none>  | Looking for proposal online ... ok                       |
+      |         | Adding proposal #123 to the merge queue ... ok           |
+      |         | Checking merge queue status of proposal #123 ... removed |
+    And Git Town prints the error:
+      """
+      the merge queue removed proposal #123 without merging it
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
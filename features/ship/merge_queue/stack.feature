@@ -0,0 +1,35 @@
+Feature: cannot ship an entire stack via the merge queue
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+      | beta   | local, origin | beta commit  |
+    And the current branch is "beta"
+    And Git Town setting "ship-strategy" is "merge-queue"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town ship --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | beta   | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot ship an entire stack via the merge queue, please ship one branch at a time
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs no commands
+    And the initial branches and lineage exist now
+    And the initial commits exist now
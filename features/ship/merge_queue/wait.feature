@@ -0,0 +1,44 @@
+Feature: ship a branch via the merge queue and wait until it got merged
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "merge-queue"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town ship --wait"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                 |
+      | feature | git fetch --prune --tags                                |
+      | <none>  | Looking for proposal online ... ok                      |
+      |         | Adding proposal #123 to the merge queue ... ok          |
+      |         | Checking merge queue status of proposal #123 ... merged |
+      | feature | git checkout main                                       |
+      | main    | git push origin :feature                                |
+      |         | git branch -D feature                                   |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And this lineage exists now
+      | BRANCH | PARENT |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And the initial branches and lineage exist now
+    And the initial commits exist now
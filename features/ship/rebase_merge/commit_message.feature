@@ -0,0 +1,25 @@
+Feature: the rebase-merge strategy does not use a commit message
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "rebase-merge"
+    When I run "git-town ship -m done"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                  |
+      | feature | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      shipping with the rebase-merge strategy does not use the given commit message
+      """
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
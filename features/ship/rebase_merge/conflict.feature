@@ -0,0 +1,56 @@
+Feature: handle conflicts between the shipped branch and its parent when using the rebase-merge strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local, origin | conflicting main commit    | conflicting_file | main content    |
+      | feature | local, origin | conflicting feature commit | conflicting_file | feature content |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "rebase-merge"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                          |
+      | feature | git fetch --prune --tags         |
+      |         | git rebase main --no-update-refs |
+    And Git Town prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And a rebase is now in progress
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND            |
+      | feature | git rebase --abort |
+    And the current branch is still "feature"
+    And no rebase is now in progress
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file"
+    And I run "git-town continue" and close the editor
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                   |
+      | feature | git -c core.editor=true rebase --continue |
+      |         | git checkout main                         |
+      | main    | git merge --ff-only feature               |
+      |         | git push                                  |
+      |         | git push origin :feature                  |
+      |         | git branch -D feature                     |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                    |
+      | main   | local, origin | conflicting main commit    |
+      |        |               | conflicting feature commit |
+    And no lineage exists now
@@ -0,0 +1,58 @@
+Feature: ship the current feature branch using the rebase-merge strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE         | FILE NAME    | FILE CONTENT |
+      | main    | local, origin | main commit     | main_file    | main         |
+      | feature | local, origin | feature commit1 | feature_file | one          |
+      |         |               | feature commit2 | feature_file | two          |
+    And the current branch is "feature"
+    And Git Town setting "ship-strategy" is "rebase-merge"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                          |
+      | feature | git fetch --prune --tags         |
+      |         | git rebase main --no-update-refs |
+      |         | git checkout main                |
+      | main    | git merge --ff-only feature      |
+      |         | git push                         |
+      |         | git push origin :feature         |
+      |         | git branch -D feature            |
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE         |
+      | main   | local, origin | main commit     |
+      |        |               | feature commit1 |
+      |        |               | feature commit2 |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                   |
+      | main   | git revert {{ sha-on-branch 'main' 'feature commit2' }}   |
+      |        | git revert {{ sha-on-branch 'main' 'feature commit1' }}   |
+      |        | git push                                                  |
+      |        | git branch feature {{ sha-before-run 'feature commit2' }} |
+      |        | git push -u origin feature                                |
+      |        | git checkout feature                                      |
+    And the current branch is now "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                  |
+      | main    | local, origin | main commit              |
+      |         |               | feature commit1          |
+      |         |               | feature commit2          |
+      |         |               | Revert "feature commit2" |
+      |         |               | Revert "feature commit1" |
+      | feature | local, origin | feature commit1          |
+      |         |               | feature commit2          |
+    And the initial branches and lineage exist now
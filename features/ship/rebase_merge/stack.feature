@@ -0,0 +1,65 @@
+Feature: ship an entire stack using the rebase-merge strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | main   | local, origin | main commit   | main_file   | main content   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | child | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And the current branch is "child"
+    And Git Town setting "ship-strategy" is "rebase-merge"
+    When I run "git-town ship --stack"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                          |
+      | child  | git fetch --prune --tags         |
+      |        | git checkout parent              |
+      | parent | git rebase main --no-update-refs |
+      |        | git checkout main                |
+      | main   | git merge --ff-only parent       |
+      |        | git push                         |
+      |        | git push origin :parent          |
+      |        | git branch -D parent             |
+      |        | git checkout child               |
+      | child  | git merge --no-edit --ff main    |
+      |        | git push                         |
+      |        | git rebase main --no-update-refs |
+      |        | git checkout main                |
+      | main   | git merge --ff-only child        |
+      |        | git push                         |
+      |        | git push origin :child           |
+      |        | git branch -D child              |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | main commit   |
+      |        |               | parent commit |
+      |        |               | child commit  |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is now "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                |
+      | main   | local, origin | main commit            |
+      |        |               | parent commit          |
+      |        |               | child commit           |
+      |        |               | Revert "child commit"  |
+      |        |               | Revert "parent commit" |
+      | child  | local, origin | child commit           |
+      | parent | local, origin | parent commit          |
+    And the initial branches and lineage exist now
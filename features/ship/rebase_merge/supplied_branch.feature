@@ -0,0 +1,57 @@
+Feature: ship the supplied feature branch using the rebase-merge strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+      | other   | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    |
+      | feature | local, origin | feature commit | feature_file |
+      | other   | local, origin | other commit   | other_file   |
+    And the current branch is "other"
+    And Git Town setting "ship-strategy" is "rebase-merge"
+    When I run "git-town ship feature"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                          |
+      | other   | git fetch --prune --tags         |
+      |         | git checkout feature             |
+      | feature | git rebase main --no-update-refs |
+      |         | git checkout main                |
+      | main    | git merge --ff-only feature      |
+      |         | git push                         |
+      |         | git push origin :feature         |
+      |         | git checkout other               |
+      | other   | git branch -D feature            |
+    And the current branch is now "other"
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, other |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE        |
+      | main   | local, origin | feature commit |
+      | other  | local, origin | other commit   |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | other  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | other  | git checkout main                             |
+      | main   | git revert {{ sha 'feature commit' }}         |
+      |        | git push                                      |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout other                            |
+    And the current branch is now "other"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                 |
+      | main   | local, origin | feature commit          |
+      |        |               | Revert "feature commit" |
+      | other  | local, origin | other commit            |
+    And the initial branches and lineage exist now
@@ -0,0 +1,44 @@
+@messyoutput
+@skipWindows
+Feature: prefill the squash commit message with the commits and co-authors of the branch
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [ship]
+      strategy = "squash-merge"
+      squash-commit-template = """
+      {{.Branch}}
+
+      {{range .Commits}}- {{.}}
+      {{end}}
+      {{range .CoAuthors}}Co-authored-by: {{.}}
+      {{end}}"""
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE            | AUTHOR                            |
+      | feature | local, origin | developer commit 1 | developer <developer@example.com> |
+      |         |               | coworker commit    | coworker <coworker@example.com>   |
+    When I run "git-town ship" and enter into the dialog:
+      | DIALOG                              | KEYS  |
+      | choose author for the squash commit | enter |
+
+  Scenario: result
+    Then these commits exist now
+      | BRANCH | LOCATION      | MESSAGE | AUTHOR                          |
+      | main   | local, origin | feature | coworker <coworker@example.com> |
+    And the currently checked out commit has the message:
+      """
+      feature
+
+      - developer commit 1
+      - coworker commit
+
+      Co-authored-by: developer <developer@example.com>
+      """
+    And no lineage exists now
@@ -0,0 +1,33 @@
+Feature: a commit message provided via the CLI overrides the squash commit template
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [ship]
+      strategy = "squash-merge"
+      squash-commit-template = "shipped {{.Branch}}"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    When I run "git-town ship -m 'feature done'"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                         |
+      | feature | git fetch --prune --tags        |
+      |         | git checkout main               |
+      | main    | git merge --squash --ff feature |
+      |         | git commit -m "feature done"    |
+      |         | git push                        |
+      |         | git push origin :feature        |
+      |         | git branch -D feature           |
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | feature done |
+    And no lineage exists now
@@ -0,0 +1,23 @@
+Feature: invalid squash commit template
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "squash-merge"
+    And Git Town setting "ship-squash-commit-template" is "{{.Title"
+    When I run "git-town ship"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      invalid squash commit template in git-town.ship-squash-commit-template
+      """
+    And the initial branches and lineage exist now
+    And the initial commits exist now
@@ -0,0 +1,53 @@
+Feature: prefill the squash commit message with data from the proposal
+
+  Background:
+    Given a Git repo with origin
+    And the committed configuration file:
+      """
+      [ship]
+      strategy = "squash-merge"
+      squash-commit-template = "{{.Title}} (#{{.Number}})"
+      """
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    When I run "git-town ship" and close the editor
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                            |
+      | feature | git fetch --prune --tags           |
+      | <none>  | Looking for proposal online ... ok |
+      | feature | git checkout main                  |
+      | main    | git merge --squash --ff feature    |
+      |         | git commit                         |
+      |         | git push                           |
+      |         | git push origin :feature           |
+      |         | git branch -D feature              |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE      |
+      | main   | local, origin | title (#123) |
+    And the currently checked out commit has the message:
+      """
+      title (#123)
+      """
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git revert {{ sha 'title (#123)' }}           |
+      |        | git push                                      |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And the initial branches and lineage exist now
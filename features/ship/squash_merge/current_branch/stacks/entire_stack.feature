@@ -0,0 +1,61 @@
+Feature: ship an entire stack using the squash-merge strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | child | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And the current branch is "child"
+    And Git Town setting "ship-strategy" is "squash-merge"
+    When I run "git-town ship --stack" and enter "done" for the commit message
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                        |
+      | child  | git fetch --prune --tags       |
+      |        | git checkout main              |
+      | main   | git merge --squash --ff parent |
+      |        | git commit                     |
+      |        | git push                       |
+      |        | git push origin :parent        |
+      |        | git branch -D parent           |
+      |        | git checkout child             |
+      | child  | git merge --no-edit --ff main  |
+      |        | git push                       |
+      |        | git checkout main              |
+      | main   | git merge --squash --ff child  |
+      |        | git commit                     |
+      |        | git push                       |
+      |        | git push origin :child         |
+      |        | git branch -D child            |
+    And the current branch is now "main"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE |
+      | main   | local, origin | done    |
+      |        |               | done    |
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then the current branch is now "child"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE       |
+      | main   | local, origin | done          |
+      |        |               | done          |
+      |        |               | Revert "done" |
+      |        |               | Revert "done" |
+      | child  | local, origin | child commit  |
+      | parent | local, origin | parent commit |
+    And the initial branches and lineage exist now
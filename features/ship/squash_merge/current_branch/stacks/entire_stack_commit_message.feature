@@ -0,0 +1,27 @@
+Feature: does not ship an entire stack with a single commit message
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+      | child  | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | parent | local, origin | parent commit |
+      | child  | local, origin | child commit  |
+    And the current branch is "child"
+    And Git Town setting "ship-strategy" is "squash-merge"
+    When I run "git-town ship --stack -m done"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                  |
+      | child  | git fetch --prune --tags |
+    And Git Town prints the error:
+      """
+      cannot use a single commit message when shipping an entire stack
+      """
+    And the current branch is still "child"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
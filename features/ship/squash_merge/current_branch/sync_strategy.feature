@@ -0,0 +1,42 @@
+@skipWindows
+Feature: ship a branch that has its own sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        |
+      | feature | local, origin | feature commit |
+    And Git Town setting "ship-strategy" is "squash-merge"
+    And branch "feature" has the sync strategy "merge"
+    When I run "git-town ship" and enter "feature done" for the commit message
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                         |
+      | feature | git fetch --prune --tags        |
+      |         | git checkout main               |
+      | main    | git merge --squash --ff feature |
+      |         | git commit                      |
+      |         | git push                        |
+      |         | git push origin :feature        |
+      |         | git branch -D feature           |
+    And the current branch is now "main"
+    And branch "feature" now has no sync strategy
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                       |
+      | main   | git revert {{ sha 'feature done' }}           |
+      |        | git push                                      |
+      |        | git branch feature {{ sha 'feature commit' }} |
+      |        | git push -u origin feature                    |
+      |        | git checkout feature                          |
+    And the current branch is now "feature"
+    And branch "feature" now has the sync strategy "merge"
+    And the initial branches and lineage exist now
@@ -0,0 +1,64 @@
+Feature: invalid arguments for splitting a branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE     |
+      | main    | local, origin | main commit |
+      | feature | local, origin | commit 1    |
+      |         |               | commit 2    |
+    And the current branch is "feature"
+
+  Scenario: no commit given
+    When I run "git-town split parent"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      please provide the last commit of the new parent branch via --at
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: commit is not in the branch
+    When I run "git-town split parent --at {{ sha 'main commit' }}"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      is not part of branch "feature"
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: last commit of the branch
+    When I run "git-town split parent --at {{ sha 'commit 2' }}"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot split branch "feature" at its last commit because that would leave it empty
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: branch already exists
+    When I run "git-town split main --at {{ sha 'commit 1' }}"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      there is already a branch "main"
+      """
+    And the current branch is still "feature"
+    And the initial branches and lineage exist now
+
+  Scenario: on the main branch
+    Given the current branch is "main"
+    When I run "git-town split parent --at {{ sha 'main commit' }}"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      cannot split branch "main" because it is not a feature branch
+      """
+    And the current branch is still "main"
+    And the initial branches and lineage exist now
@@ -0,0 +1,41 @@
+@smoke
+Feature: split a feature branch into two stacked branches
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 1 |
+      |         |               | commit 2 |
+      |         |               | commit 3 |
+    And the current branch is "feature"
+    When I run "git-town split parent --at {{ sha 'commit 2' }}"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git branch parent {{ sha 'commit 2' }} |
+    And the current branch is still "feature"
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE  |
+      | feature | local, origin | commit 3 |
+      |         | origin        | commit 1 |
+      |         |               | commit 2 |
+      | parent  | local         | commit 1 |
+      |         |               | commit 2 |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | parent |
+      | parent  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND              |
+      | feature | git branch -D parent |
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial lineage exists now
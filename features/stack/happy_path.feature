@@ -0,0 +1,39 @@
+Feature: display the branch hierarchy with the status of each branch
+
+  Scenario: stacked branches in various states
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE      | PARENT | LOCATIONS     |
+      | alpha     | feature   | main   | local, origin |
+      | beta      | feature   | alpha  | local, origin |
+      | gamma     | prototype | alpha  | local         |
+      | observed  | observed  |        | local, origin |
+      | qa        | perennial |        | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       |
+      | alpha  | local, origin | alpha commit  |
+      | beta   | local         | beta commit 1 |
+      | beta   | local         | beta commit 2 |
+      | beta   | origin        | beta commit 3 |
+      | qa     | origin        | qa commit     |
+    And I ran "git fetch"
+    And the current branch is "beta"
+    When I run "git-town stack"
+    Then Git Town prints:
+      """
+      main  (main, up to date)
+        alpha  (feature, up to date, 1 commit)
+          beta  (feature, 2 ahead, 1 behind, 2 commits)
+          gamma  (prototype, local only, 0 commits)
+      observed  (observed, up to date)
+      qa  (perennial, 1 behind)
+      """
+    And the current branch is still "beta"
+
+  Scenario: no lineage
+    Given a Git repo with origin
+    When I run "git-town stack"
+    Then Git Town prints:
+      """
+      main  (main, up to date)
+      """
@@ -0,0 +1,58 @@
+Feature: display the status of the current/last Git Town command as JSON
+
+  Background:
+    Given a Git repo with origin
+
+  Scenario: Git Town command ran successfully
+    Given I ran "git-town sync"
+    When I run "git-town status --format=json"
+    Then Git Town prints:
+      """
+      {
+        "runstate": {
+          "canContinue": false,
+          "canSkip": false,
+          "canUndo": true,
+          "command": "sync",
+          "finished": true
+        }
+      }
+      """
+
+  Scenario: Git Town command in progress
+    Given the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | conflicting_file | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And I run "git-town sync"
+    When I run "git-town status --format=json"
+    Then Git Town prints something like:
+      """
+      "canContinue": true,
+          "canSkip": true,
+          "canUndo": true,
+          "command": "sync",
+          "endBranch": "feature",
+          "endTime": ".*",
+          "finished": false
+      """
+
+  Scenario: no runstate exists
+    When I run "git-town status --format=json"
+    Then Git Town prints:
+      """
+      {
+        "runstate": null
+      }
+      """
+
+  Scenario: unknown format
+    When I run "git-town status --format=xml"
+    Then Git Town prints the error:
+      """
+      unknown output format: "xml"
+      """
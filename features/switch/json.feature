@@ -0,0 +1,51 @@
+Feature: display the branches as JSON
+
+  Scenario: stacked branches
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE      | PARENT | LOCATIONS     |
+      | alpha     | feature   | main   | local, origin |
+      | beta      | feature   | alpha  | local         |
+      | prototype | prototype | main   | local         |
+      | qa        | perennial |        | local, origin |
+    And the current branch is "beta"
+    When I run "git-town switch --format=json"
+    Then Git Town prints:
+      """
+      [
+        {
+          "branch": "main",
+          "current": false,
+          "syncStatus": "up to date",
+          "type": "main"
+        },
+        {
+          "branch": "alpha",
+          "current": false,
+          "parent": "main",
+          "syncStatus": "up to date",
+          "type": "feature"
+        },
+        {
+          "branch": "beta",
+          "current": true,
+          "parent": "alpha",
+          "syncStatus": "local only",
+          "type": "feature"
+        },
+        {
+          "branch": "prototype",
+          "current": false,
+          "parent": "main",
+          "syncStatus": "local only",
+          "type": "prototype"
+        },
+        {
+          "branch": "qa",
+          "current": false,
+          "syncStatus": "up to date",
+          "type": "perennial"
+        }
+      ]
+      """
+    And the current branch is still "beta"
@@ -0,0 +1,62 @@
+Feature: sync all branches including a branch that is active in another worktree
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE             |
+      | main   | origin   | main commit         |
+      | alpha  | local    | local alpha commit  |
+      |        | origin   | origin alpha commit |
+      | beta   | local    | local beta commit   |
+      |        | origin   | origin beta commit  |
+    And branch "beta" is active in another worktree
+    And the current branch is "alpha"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                 |
+      | alpha  | git fetch --prune --tags                |
+      |        | git checkout main                       |
+      | main   | git rebase origin/main --no-update-refs |
+      |        | git checkout alpha                      |
+      | alpha  | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/alpha   |
+      |        | git push                                |
+      | beta   | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/beta    |
+      |        | git push                                |
+      | alpha  | git push --tags                         |
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION                | MESSAGE                                                |
+      | main   | local, origin, worktree | main commit                                            |
+      | alpha  | local, origin           | local alpha commit                                     |
+      |        |                         | Merge branch 'main' into alpha                         |
+      |        |                         | origin alpha commit                                    |
+      |        |                         | Merge remote-tracking branch 'origin/alpha' into alpha |
+      | beta   | origin, worktree        | local beta commit                                      |
+      |        |                         | Merge branch 'main' into beta                          |
+      |        |                         | origin beta commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/beta' into beta   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                                            |
+      | alpha  | git reset --hard {{ sha 'local alpha commit' }}                                    |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin alpha commit' }}:alpha |
+      | beta   | git reset --hard {{ sha 'local beta commit' }}                                     |
+      | alpha  | git push --force-with-lease origin {{ sha-in-origin 'origin beta commit' }}:beta   |
+      |        | git checkout main                                                                  |
+      | main   | git reset --hard {{ sha 'initial commit' }}                                        |
+      |        | git checkout alpha                                                                 |
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
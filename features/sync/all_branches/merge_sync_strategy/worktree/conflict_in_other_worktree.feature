@@ -0,0 +1,85 @@
+Feature: sync all branches while a branch in another worktree has a merge conflict
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE                   | FILE NAME        | FILE CONTENT   |
+      | alpha  | local    | alpha commit              | alpha_file       | alpha content  |
+      | beta   | local    | conflicting local commit  | conflicting_file | local content  |
+      |        | origin   | conflicting origin commit | conflicting_file | origin content |
+    And branch "beta" is active in another worktree
+    And the current branch is "alpha"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                 |
+      | alpha  | git fetch --prune --tags                |
+      |        | git checkout main                       |
+      | main   | git rebase origin/main --no-update-refs |
+      |        | git checkout alpha                      |
+      | alpha  | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/alpha   |
+      |        | git push                                |
+      | beta   | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/beta    |
+    And Git Town prints the error:
+      """
+      CONFLICT (add/add): Merge conflict in conflicting_file
+      """
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                             |
+      | beta   | git merge --abort                                                   |
+      | alpha  | git push --force-with-lease origin {{ sha 'initial commit' }}:alpha |
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
+
+  Scenario: skip
+    When I run "git-town skip"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND           |
+      | beta   | git merge --abort |
+      | alpha  | git push --tags   |
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION      | MESSAGE                   |
+      | alpha  | local, origin | alpha commit              |
+      | beta   | origin        | conflicting origin commit |
+      |        | worktree      | conflicting local commit  |
+
+  Scenario: continue with unresolved conflict
+    When I run "git-town continue"
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      you must resolve the conflicts before continuing
+      """
+
+  Scenario: resolve and continue
+    When I resolve the conflict in "conflicting_file" in the other worktree
+    And I run "git-town continue"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND              |
+      | beta   | git commit --no-edit |
+      |        | git push             |
+      | alpha  | git push --tags      |
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION         | MESSAGE                                              |
+      | alpha  | local, origin    | alpha commit                                         |
+      | beta   | origin, worktree | conflicting local commit                             |
+      |        |                  | conflicting origin commit                            |
+      |        |                  | Merge remote-tracking branch 'origin/beta' into beta |
@@ -0,0 +1,59 @@
+Feature: sync all branches while the worktree of a branch has uncommitted changes
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION | MESSAGE             |
+      | main   | origin   | main commit         |
+      | alpha  | local    | local alpha commit  |
+      |        | origin   | origin alpha commit |
+      | beta   | local    | local beta commit   |
+      |        | origin   | origin beta commit  |
+    And branch "beta" is active in another worktree
+    And an uncommitted file in the other worktree
+    And the current branch is "alpha"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                 |
+      | alpha  | git fetch --prune --tags                |
+      |        | git checkout main                       |
+      | main   | git rebase origin/main --no-update-refs |
+      |        | git checkout alpha                      |
+      | alpha  | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/alpha   |
+      |        | git push                                |
+      |        | git push --tags                         |
+    And Git Town prints:
+      """
+      Did not sync branch "beta" because its worktree at
+      """
+    And the current branch is still "alpha"
+    And the current branch in the other worktree is still "beta"
+    And these commits exist now
+      | BRANCH | LOCATION                | MESSAGE                                                |
+      | main   | local, origin, worktree | main commit                                            |
+      | alpha  | local, origin           | local alpha commit                                     |
+      |        |                         | Merge branch 'main' into alpha                         |
+      |        |                         | origin alpha commit                                    |
+      |        |                         | Merge remote-tracking branch 'origin/alpha' into alpha |
+      | beta   | origin                  | origin beta commit                                     |
+      |        | worktree                | local beta commit                                      |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                                            |
+      | alpha  | git reset --hard {{ sha 'local alpha commit' }}                                    |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin alpha commit' }}:alpha |
+      |        | git checkout main                                                                  |
+      | main   | git reset --hard {{ sha 'initial commit' }}                                        |
+      |        | git checkout alpha                                                                 |
+    And the current branch is still "alpha"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
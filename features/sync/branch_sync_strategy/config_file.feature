@@ -0,0 +1,63 @@
+Feature: sync feature branches using the sync strategy configured for their name pattern in the config file
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME        | TYPE    | PARENT | LOCATIONS     |
+      | shared/docs | feature | main   | local, origin |
+    And the commits
+      | BRANCH      | LOCATION | MESSAGE            |
+      | main        | origin   | main commit        |
+      | shared/docs | local    | local docs commit  |
+      |             | origin   | origin docs commit |
+    And the current branch is "shared/docs"
+    And the configuration file:
+      """
+      [branches]
+      main = "main"
+
+      [branches."shared/*"]
+      sync-strategy = "merge"
+
+      [sync]
+      feature-strategy = "rebase"
+      """
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH      | COMMAND                                     |
+      | shared/docs | git fetch --prune --tags                    |
+      |             | git add -A                                  |
+      |             | git stash                                   |
+      |             | git checkout main                           |
+      | main        | git rebase origin/main --no-update-refs     |
+      |             | git checkout shared/docs                    |
+      | shared/docs | git merge --no-edit --ff main               |
+      |             | git merge --no-edit --ff origin/shared/docs |
+      |             | git push                                    |
+      |             | git stash pop                               |
+    And all branches are now synchronized
+    And these commits exist now
+      | BRANCH      | LOCATION      | MESSAGE                                                            |
+      | main        | local, origin | main commit                                                        |
+      | shared/docs | local, origin | local docs commit                                                  |
+      |             |               | Merge branch 'main' into shared/docs                               |
+      |             |               | origin docs commit                                                 |
+      |             |               | Merge remote-tracking branch 'origin/shared/docs' into shared/docs |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH      | COMMAND                                                                                 |
+      | shared/docs | git add -A                                                                              |
+      |             | git stash                                                                               |
+      |             | git reset --hard {{ sha 'local docs commit' }}                                          |
+      |             | git push --force-with-lease origin {{ sha-in-origin 'origin docs commit' }}:shared/docs |
+      |             | git checkout main                                                                       |
+      | main        | git reset --hard {{ sha 'initial commit' }}                                             |
+      |             | git checkout shared/docs                                                                |
+      | shared/docs | git stash pop                                                                           |
+    And the current branch is still "shared/docs"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,65 @@
+Feature: sync feature branches using the sync strategy configured for them in the Git metadata
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | shared  | feature | main   | local, origin |
+      | private | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               |
+      | main    | origin   | main commit           |
+      | shared  | local    | local shared commit   |
+      |         | origin   | origin shared commit  |
+      | private | local    | local private commit  |
+      |         | origin   | origin private commit |
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    And branch "shared" has the sync strategy "merge"
+    And the current branch is "shared"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                         |
+      | shared  | git fetch --prune --tags                        |
+      |         | git checkout main                               |
+      | main    | git rebase origin/main --no-update-refs         |
+      |         | git checkout private                            |
+      | private | git rebase main --no-update-refs                |
+      |         | git push --force-with-lease --force-if-includes |
+      |         | git rebase origin/private --no-update-refs      |
+      |         | git push --force-with-lease --force-if-includes |
+      |         | git checkout shared                             |
+      | shared  | git merge --no-edit --ff main                   |
+      |         | git merge --no-edit --ff origin/shared          |
+      |         | git push                                        |
+      |         | git push --tags                                 |
+    And all branches are now synchronized
+    And these commits exist now
+      | BRANCH  | LOCATION      | MESSAGE                                                  |
+      | main    | local, origin | main commit                                              |
+      | private | local, origin | origin private commit                                    |
+      |         |               | main commit                                              |
+      |         |               | local private commit                                     |
+      | shared  | local, origin | local shared commit                                      |
+      |         |               | Merge branch 'main' into shared                          |
+      |         |               | origin shared commit                                     |
+      |         |               | Merge remote-tracking branch 'origin/shared' into shared |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                           |
+      | shared  | git checkout private                                                                              |
+      | private | git reset --hard {{ sha-before-run 'local private commit' }}                                      |
+      |         | git push --force-with-lease origin {{ sha-in-origin-before-run 'origin private commit' }}:private |
+      |         | git checkout shared                                                                               |
+      | shared  | git reset --hard {{ sha 'local shared commit' }}                                                  |
+      |         | git push --force-with-lease origin {{ sha-in-origin 'origin shared commit' }}:shared              |
+      |         | git checkout main                                                                                 |
+      | main    | git reset --hard {{ sha 'initial commit' }}                                                       |
+      |         | git checkout shared                                                                               |
+    And the current branch is still "shared"
+    And branch "shared" still has the sync strategy "merge"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,34 @@
+Feature: predict which branches would run into merge conflicts when syncing
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+      | gamma | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | main   | local         | main commit  | file       | main content  |
+      | alpha  | local, origin | alpha commit | file       | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+      | gamma  | local, origin | gamma commit | gamma_file | gamma content |
+    And the current branch is "alpha"
+    When I run "git-town sync --all --check"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      syncing would cause merge conflicts
+      """
+    And Git Town prints the error:
+      """
+      Branch "alpha": conflicts with "main" in file
+      Branch "beta": not checked because its parent branch "alpha" has conflicts
+      Branch "gamma": no conflicts
+      """
+    And the current branch is still "alpha"
+    And no merge is in progress
+    And the initial commits exist now
+    And the initial branches and lineage exist now
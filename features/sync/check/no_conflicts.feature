@@ -0,0 +1,26 @@
+Feature: predict that syncing causes no merge conflicts
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | main   | local         | main commit  | main_file  | main content  |
+      | alpha  | local, origin | alpha commit | alpha_file | alpha content |
+      | beta   | local, origin | beta commit  | beta_file  | beta content  |
+    And the current branch is "beta"
+    When I run "git-town sync --all --check"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints:
+      """
+      Branch "alpha": no conflicts
+      Branch "beta": no conflicts
+      """
+    And the current branch is still "beta"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,29 @@
+Feature: predict merge conflicts when syncing with the rebase sync strategy
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE          | FILE NAME | FILE CONTENT     |
+      | main    | local         | main commit      | file      | main content     |
+      | feature | local, origin | feature commit 1 | file      | feature content  |
+      |         | local, origin | feature commit 2 | file      | feature content2 |
+    And the current branch is "feature"
+    And Git Town setting "sync-feature-strategy" is "rebase"
+    When I run "git-town sync --check"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      syncing would cause merge conflicts
+      """
+    And Git Town prints the error:
+      """
+      Branch "feature": conflicts with "main" in file
+      """
+    And no rebase is now in progress
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,27 @@
+Feature: predict merge conflicts between a branch and its tracking branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE               | FILE NAME | FILE CONTENT   |
+      | feature | local    | local feature commit  | file      | local content  |
+      |         | origin   | origin feature commit | file      | origin content |
+    And the current branch is "feature"
+    And I ran "git fetch"
+    When I run "git-town sync --check"
+
+  Scenario: result
+    Then Git Town runs no commands
+    And Git Town prints the error:
+      """
+      syncing would cause merge conflicts
+      """
+    And Git Town prints the error:
+      """
+      Branch "feature": conflicts with "origin/feature" in file
+      """
+    And the initial commits exist now
+    And the initial branches and lineage exist now
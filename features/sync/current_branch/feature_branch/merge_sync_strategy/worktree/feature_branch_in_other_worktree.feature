@@ -19,19 +19,20 @@ Feature: Sync a feature branch that is in another worktree than the main branch
     Then Git Town runs the commands
       | BRANCH  | COMMAND                                 |
       | feature | git fetch --prune --tags                |
-      |         | git merge --no-edit --ff origin/main    |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git push                                |
+      | feature | git merge --no-edit --ff origin/main    |
       |         | git merge --no-edit --ff origin/feature |
       |         | git push                                |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
       | BRANCH  | LOCATION         | MESSAGE                                                    |
-      | main    | local            | local main commit                                          |
-      |         | origin           | origin main commit                                         |
+      | main    | local, origin    | origin main commit                                         |
+      |         |                  | local main commit                                          |
       | feature | origin, worktree | local feature commit                                       |
       |         |                  | Merge remote-tracking branch 'origin/main' into feature    |
       |         |                  | origin feature commit                                      |
       |         |                  | Merge remote-tracking branch 'origin/feature' into feature |
-      |         | worktree         | origin main commit                                         |
 
   Scenario: undo
     When I run "git-town undo" in the other worktree
@@ -41,9 +42,9 @@ Feature: Sync a feature branch that is in another worktree than the main branch
       |         | git push --force-with-lease origin {{ sha-in-origin 'origin feature commit' }}:feature |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
-      | BRANCH  | LOCATION | MESSAGE               |
-      | main    | local    | local main commit     |
-      |         | origin   | origin main commit    |
-      | feature | origin   | origin feature commit |
-      |         | worktree | local feature commit  |
+      | BRANCH  | LOCATION      | MESSAGE               |
+      | main    | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      | feature | origin        | origin feature commit |
+      |         | worktree      | local feature commit  |
     And the initial branches and lineage exist now
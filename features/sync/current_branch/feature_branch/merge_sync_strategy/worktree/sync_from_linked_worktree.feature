@@ -25,27 +25,33 @@ Feature: sync a branch whose parent is active in another worktree
       |        | git checkout main                       |
       | main   | git rebase origin/main --no-update-refs |
       |        | git push                                |
-      |        | git checkout child                      |
+      | parent | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/parent  |
+      |        | git push                                |
+      | main   | git checkout child                      |
       | child  | git merge --no-edit --ff origin/parent  |
       |        | git merge --no-edit --ff origin/child   |
       |        | git push                                |
     And the current branch is still "parent"
     And these commits exist now
-      | BRANCH | LOCATION                | MESSAGE                                                 |
-      | main   | local, origin, worktree | origin main commit                                      |
-      |        |                         | local main commit                                       |
-      | child  | origin, worktree        | local child commit                                      |
-      |        |                         | Merge remote-tracking branch 'origin/parent' into child |
-      |        |                         | origin child commit                                     |
-      |        |                         | Merge remote-tracking branch 'origin/child' into child  |
-      |        | worktree                | origin parent commit                                    |
-      | parent | local                   | local parent commit                                     |
-      |        | origin                  | origin parent commit                                    |
+      | BRANCH | LOCATION                | MESSAGE                                                  |
+      | main   | local, origin, worktree | origin main commit                                       |
+      |        |                         | local main commit                                        |
+      | child  | origin, worktree        | local child commit                                       |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into child  |
+      |        |                         | origin child commit                                      |
+      |        |                         | Merge remote-tracking branch 'origin/child' into child   |
+      | parent | local, origin           | local parent commit                                      |
+      |        |                         | Merge branch 'main' into parent                          |
+      |        |                         | origin parent commit                                     |
+      |        |                         | Merge remote-tracking branch 'origin/parent' into parent |
     When I run "git-town undo" in the other worktree
     Then Git Town runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha 'local child commit' }}                                    |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                              |
+      | child  | git reset --hard {{ sha 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child   |
+      | parent | git reset --hard {{ sha 'local parent commit' }}                                     |
+      | child  | git push --force-with-lease origin {{ sha-in-origin 'origin parent commit' }}:parent |
     And the current branch is still "parent"
     And the current branch in the other worktree is still "child"
     And these commits exist now
@@ -17,7 +17,8 @@ Feature: sync a branch in a "linked worktree" that has a merge conflict
     Then Git Town runs the commands
       | BRANCH  | COMMAND                                 |
       | feature | git fetch --prune --tags                |
-      |         | git rebase origin/main --no-update-refs |
+      | main    | git rebase origin/main --no-update-refs |
+      | feature | git rebase origin/main --no-update-refs |
     And Git Town prints the error:
       """
       To continue after having resolved conflicts, run "git town continue".
@@ -28,8 +29,9 @@ Feature: sync a branch in a "linked worktree" that has a merge conflict
   Scenario: undo
     When I run "git-town undo" in the other worktree
     Then Git Town runs the commands
-      | BRANCH  | COMMAND            |
-      | feature | git rebase --abort |
+      | BRANCH  | COMMAND                                     |
+      | feature | git rebase --abort                          |
+      | main    | git reset --hard {{ sha 'initial commit' }} |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
       | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
@@ -53,6 +55,5 @@ Feature: sync a branch in a "linked worktree" that has a merge conflict
       | feature | git push --force-with-lease --force-if-includes |
     And these commits exist now
       | BRANCH  | LOCATION         | MESSAGE                 | FILE NAME        | FILE CONTENT     |
-      | main    | origin           | conflicting main commit | conflicting_file | main content     |
+      | main    | local, origin    | conflicting main commit | conflicting_file | main content     |
       | feature | origin, worktree | resolved commit         | conflicting_file | resolved content |
-      |         | worktree         | conflicting main commit | conflicting_file | main content     |
@@ -19,17 +19,20 @@ Feature: Sync a feature branch that is in another worktree than the main branch
     Then Git Town runs the commands
       | BRANCH  | COMMAND                                         |
       | feature | git fetch --prune --tags                        |
-      |         | git rebase origin/main --no-update-refs         |
+      | main    | git rebase origin/main --no-update-refs         |
+      |         | git push                                        |
+      | feature | git rebase origin/main --no-update-refs         |
       |         | git push --force-with-lease --force-if-includes |
       |         | git rebase origin/feature --no-update-refs      |
       |         | git push --force-with-lease --force-if-includes |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
       | BRANCH  | LOCATION         | MESSAGE               |
-      | main    | local            | local main commit     |
-      |         | origin           | origin main commit    |
+      | main    | local, origin    | origin main commit    |
+      |         |                  | local main commit     |
       | feature | origin, worktree | origin feature commit |
       |         |                  | origin main commit    |
+      |         |                  | local main commit     |
       |         |                  | local feature commit  |
 
   Scenario: undo
@@ -40,9 +43,9 @@ Feature: Sync a feature branch that is in another worktree than the main branch
       |         | git push --force-with-lease origin {{ sha-in-origin 'origin feature commit' }}:feature |
     And the current branch in the other worktree is still "feature"
     And these commits exist now
-      | BRANCH  | LOCATION | MESSAGE               |
-      | main    | local    | local main commit     |
-      |         | origin   | origin main commit    |
-      | feature | origin   | origin feature commit |
-      |         | worktree | local feature commit  |
+      | BRANCH  | LOCATION      | MESSAGE               |
+      | main    | local, origin | origin main commit    |
+      |         |               | local main commit     |
+      | feature | origin        | origin feature commit |
+      |         | worktree      | local feature commit  |
     And the initial branches and lineage exist now
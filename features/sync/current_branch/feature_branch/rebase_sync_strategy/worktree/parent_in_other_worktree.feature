@@ -26,7 +26,11 @@ Feature: sync a branch whose parent is active in another worktree
       |        | git checkout main                               |
       | main   | git rebase origin/main --no-update-refs         |
       |        | git push                                        |
-      |        | git checkout child                              |
+      | parent | git rebase main --no-update-refs                |
+      |        | git push --force-with-lease --force-if-includes |
+      |        | git rebase origin/parent --no-update-refs       |
+      |        | git push --force-with-lease --force-if-includes |
+      | main   | git checkout child                              |
       | child  | git rebase origin/parent --no-update-refs       |
       |        | git push --force-with-lease --force-if-includes |
       |        | git rebase origin/child --no-update-refs        |
@@ -38,16 +42,23 @@ Feature: sync a branch whose parent is active in another worktree
       |        |                         | local main commit    |
       | child  | local, origin           | origin child commit  |
       |        |                         | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
       |        |                         | local child commit   |
-      | parent | origin                  | origin parent commit |
-      |        | worktree                | local parent commit  |
+      | parent | origin, worktree        | origin parent commit |
+      |        |                         | origin main commit   |
+      |        |                         | local main commit    |
+      |        |                         | local parent commit  |
 
   Scenario: undo
     When I run "git-town undo"
     Then Git Town runs the commands
-      | BRANCH | COMMAND                                                                            |
-      | child  | git reset --hard {{ sha-before-run 'local child commit' }}                         |
-      |        | git push --force-with-lease origin {{ sha-in-origin 'origin child commit' }}:child |
+      | BRANCH | COMMAND                                                                                         |
+      | child  | git reset --hard {{ sha-before-run 'local child commit' }}                                      |
+      |        | git push --force-with-lease origin {{ sha-in-origin-before-run 'origin child commit' }}:child   |
+      | parent | git reset --hard {{ sha-before-run 'local parent commit' }}                                     |
+      | child  | git push --force-with-lease origin {{ sha-in-origin-before-run 'origin parent commit' }}:parent |
     And the current branch is still "child"
     And these commits exist now
       | BRANCH | LOCATION                | MESSAGE              |
@@ -0,0 +1,28 @@
+Feature: apply the inferred parent of a branch with unknown parent when configured
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the current branch is "alpha"
+    And I ran "git checkout -b beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | beta   | local    | beta commit |
+    And the current branch is "beta"
+    And Git Town setting "infer-parents" is "true"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town prints:
+      """
+      Inferred parent branch for "beta": alpha
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
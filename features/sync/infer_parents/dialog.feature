@@ -0,0 +1,39 @@
+@messyoutput
+Feature: preselect the inferred parent of a branch with unknown parent in the dialog
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      |
+      | alpha  | local, origin | alpha commit |
+    And the current branch is "alpha"
+    And I ran "git checkout -b beta"
+    And the commits
+      | BRANCH | LOCATION | MESSAGE     |
+      | beta   | local    | beta commit |
+    And the current branch is "beta"
+
+  Scenario: accept the inferred parent
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                | KEYS  |
+      | parent branch of beta | enter |
+    Then Git Town prints:
+      """
+      Selected parent branch for "beta": alpha
+      """
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | alpha  |
+
+  Scenario: select a different parent
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG                | KEYS     |
+      | parent branch of beta | up enter |
+    Then this lineage exists now
+      | BRANCH | PARENT |
+      | alpha  | main   |
+      | beta   | main   |
@@ -0,0 +1,43 @@
+Feature: the local branch metadata wins over conflicting shared metadata
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+      | other   | feature | main   | local, origin |
+      | third   | feature | main   | local, origin |
+    And the current branch is "feature"
+    And Git Town setting "sync-metadata" is "true"
+    And I ran "git-town sync"
+    And a coworker clones the repository
+    And the coworker runs "git config git-town.sync-metadata true"
+    And the coworker runs "git-town sync"
+    And the coworker sets the parent branch of "feature" as "other"
+    And the coworker runs "git-town sync"
+    And I ran "git config git-town-branch.feature.parent third"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                                                                                            |
+      | feature | git fetch --prune --tags                                                                                                                                           |
+      |         | git fetch origin +refs/git-town/metadata*:refs/git-town/remotes/origin/metadata*                                                                                   |
+      |         | git checkout main                                                                                                                                                  |
+      | main    | git rebase origin/main --no-update-refs                                                                                                                            |
+      |         | git checkout third                                                                                                                                                 |
+      | third   | git merge --no-edit --ff main                                                                                                                                      |
+      |         | git merge --no-edit --ff origin/third                                                                                                                              |
+      |         | git checkout feature                                                                                                                                               |
+      | feature | git merge --no-edit --ff third                                                                                                                                     |
+      |         | git merge --no-edit --ff origin/feature                                                                                                                            |
+      |         | git push --force-with-lease=refs/git-town/metadata:6b1305f90c0a9cc89a885c6b330d8b635705b60f origin 7176899f0486c4bf4960711fa7ac8f3f0484ae60:refs/git-town/metadata |
+    And Git Town prints:
+      """
+      Branch "feature" has the parent "third" locally and "other" in the shared metadata, keeping the local value.
+      """
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | third  |
+      | other   | main   |
+      | third   | main   |
@@ -0,0 +1,18 @@
+Feature: don't share the branch metadata in offline mode
+
+  Scenario: offline
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And Git Town setting "sync-metadata" is "true"
+    And offline mode is enabled
+    When I run "git-town sync"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git checkout main                       |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff main           |
+      |         | git merge --no-edit --ff origin/feature |
@@ -0,0 +1,36 @@
+Feature: receive the branch metadata that a coworker has shared
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the current branch is "feature"
+    And a coworker clones the repository
+    And the coworker pushes a new "child" branch with these commits
+      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child commit | child_file | child content |
+    And the coworker sets the parent branch of "child" as "main"
+    And the coworker runs "git config git-town.sync-metadata true"
+    And the coworker runs "git config git-town.prototype-branches child"
+    And the coworker runs "git-town sync"
+    And Git Town setting "sync-metadata" is "true"
+    When I run "git-town sync --all"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                                                                                            |
+      | feature | git fetch --prune --tags                                                                                                                                           |
+      |         | git fetch origin +refs/git-town/metadata*:refs/git-town/remotes/origin/metadata*                                                                                   |
+      |         | git checkout main                                                                                                                                                  |
+      | main    | git rebase origin/main --no-update-refs                                                                                                                            |
+      |         | git checkout feature                                                                                                                                               |
+      | feature | git merge --no-edit --ff main                                                                                                                                      |
+      |         | git merge --no-edit --ff origin/feature                                                                                                                            |
+      |         | git push --tags                                                                                                                                                    |
+      |         | git push --force-with-lease=refs/git-town/metadata:6911de3e299ac3bdd29b322b7077f2b47027ac64 origin 477909e4ddfc93d3be5f13acb63cdb4b50f9c25f:refs/git-town/metadata |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | child   | main   |
+      | feature | main   |
+    And the prototype branches are now "child"
@@ -0,0 +1,62 @@
+Feature: share the branch metadata with other people
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT  | LOCATIONS     |
+      | feature | feature | main    | local, origin |
+      | child   | feature | feature | local, origin |
+      | parked  | parked  | main    | local, origin |
+    And the current branch is "child"
+    And Git Town setting "sync-metadata" is "true"
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                                                                    |
+      | child   | git fetch --prune --tags                                                                                                   |
+      |         | git fetch origin +refs/git-town/metadata*:refs/git-town/remotes/origin/metadata*                                           |
+      |         | git checkout main                                                                                                          |
+      | main    | git rebase origin/main --no-update-refs                                                                                    |
+      |         | git checkout feature                                                                                                       |
+      | feature | git merge --no-edit --ff main                                                                                              |
+      |         | git merge --no-edit --ff origin/feature                                                                                    |
+      |         | git checkout child                                                                                                         |
+      | child   | git merge --no-edit --ff feature                                                                                           |
+      |         | git merge --no-edit --ff origin/child                                                                                      |
+      |         | git push --force-with-lease=refs/git-town/metadata: origin 86d32ba4b05b7cab994c9f5d90465cc3673abe63:refs/git-town/metadata |
+    And a coworker clones the repository
+    And the coworker runs "git fetch origin refs/git-town/metadata"
+    And the coworker runs "git cat-file -p FETCH_HEAD"
+    Then Git Town prints:
+      """
+      {
+        "branches": {
+          "child": {
+            "parent": "feature"
+          },
+          "feature": {
+            "parent": "main"
+          },
+          "parked": {
+            "parent": "main",
+            "type": "parked"
+          }
+        }
+      }
+      """
+
+  Scenario: sync again without changes
+    When I run "git-town sync"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                                          |
+      | child   | git fetch --prune --tags                                                         |
+      |         | git fetch origin +refs/git-town/metadata*:refs/git-town/remotes/origin/metadata* |
+      |         | git checkout main                                                                |
+      | main    | git rebase origin/main --no-update-refs                                          |
+      |         | git checkout feature                                                             |
+      | feature | git merge --no-edit --ff main                                                    |
+      |         | git merge --no-edit --ff origin/feature                                          |
+      |         | git checkout child                                                               |
+      | child   | git merge --no-edit --ff feature                                                 |
+      |         | git merge --no-edit --ff origin/child                                            |
@@ -0,0 +1,38 @@
+Feature: update the stack section in the proposals of a stack
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | alpha | feature | main   | local, origin |
+      | beta  | feature | alpha  | local, origin |
+    And the current branch is "beta"
+    And the origin is "git@github.com:git-town/git-town.git"
+    And a proposal for this branch exists at "https://github.com/git-town/git-town/pull/123"
+    And the GitHub API is available
+    When I run "git-town sync"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                           |
+      | beta   | git fetch --prune --tags                          |
+      | <none> | Looking for proposals of 2 branches online ... ok |
+      | beta   | git checkout main                                 |
+      | main   | git rebase origin/main --no-update-refs           |
+      |        | git checkout alpha                                |
+      | alpha  | git merge --no-edit --ff main                     |
+      |        | git merge --no-edit --ff origin/alpha             |
+      |        | git checkout beta                                 |
+      | beta   | git merge --no-edit --ff alpha                    |
+      |        | git merge --no-edit --ff origin/beta              |
+      | <none> | Updating body of proposal #123 ... ok             |
+      |        | Updating body of proposal #123 ... ok             |
+    And the initial branches and lineage exist now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                               |
+      |        | Updating body of proposal #123 ... ok |
+      |        | Updating body of proposal #123 ... ok |
+    And the initial branches and lineage exist now
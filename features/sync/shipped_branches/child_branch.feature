@@ -0,0 +1,60 @@
+@messyoutput
+Feature: sync a branch whose parent somebody squash-merged without deleting its tracking branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME   | TYPE    | PARENT | LOCATIONS     |
+      | parent | feature | main   | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE       | FILE NAME   | FILE CONTENT   |
+      | parent | local, origin | parent commit | parent_file | parent content |
+    And the branches
+      | NAME  | TYPE    | PARENT | LOCATIONS     |
+      | child | feature | parent | local, origin |
+    And the commits
+      | BRANCH | LOCATION      | MESSAGE      | FILE NAME  | FILE CONTENT  |
+      | child  | local, origin | child commit | child_file | child content |
+    And origin ships the "parent" branch using the "squash-merge" ship-strategy without deleting it
+    And the current branch is "child"
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG           | KEYS  |
+      | shipped branches | enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                 |
+      | child  | git fetch --prune --tags                |
+      |        | git checkout main                       |
+      | main   | git rebase origin/main --no-update-refs |
+      |        | git push origin :parent                 |
+      |        | git branch -D parent                    |
+      |        | git checkout child                      |
+      | child  | git merge --no-edit --ff main           |
+      |        | git merge --no-edit --ff origin/child   |
+      |        | git push                                |
+    And Git Town prints:
+      """
+      deleted branch "parent"
+      """
+    And the current branch is still "child"
+    And the branches are now
+      | REPOSITORY    | BRANCHES    |
+      | local, origin | main, child |
+    And this lineage exists now
+      | BRANCH | PARENT |
+      | child  | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                |
+      | child  | git reset --hard {{ sha-before-run 'child commit' }}   |
+      |        | git push --force-with-lease --force-if-includes        |
+      |        | git branch parent {{ sha-before-run 'parent commit' }} |
+      |        | git push -u origin parent                              |
+      |        | git checkout main                                      |
+      | main   | git reset --hard {{ sha 'initial commit' }}            |
+      |        | git checkout child                                     |
+    And the current branch is still "child"
+    And the initial branches and lineage exist now
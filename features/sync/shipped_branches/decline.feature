@@ -0,0 +1,51 @@
+@messyoutput
+Feature: keep a shipped branch that still has its tracking branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE        | FILE NAME    | FILE CONTENT    |
+      | feature | local, origin | feature commit | feature_file | feature content |
+    And origin ships the "feature" branch using the "squash-merge" ship-strategy without deleting it
+    And the current branch is "feature"
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG           | KEYS        |
+      | shipped branches | space enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git checkout feature                    |
+      | feature | git merge --no-edit --ff main           |
+      |         | git merge --no-edit --ff origin/feature |
+      |         | git push                                |
+    And Git Town prints:
+      """
+      Delete shipped branches: (none)
+      """
+    And the current branch is still "feature"
+    And the branches are now
+      | REPOSITORY    | BRANCHES      |
+      | local, origin | main, feature |
+    And this lineage exists now
+      | BRANCH  | PARENT |
+      | feature | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                                |
+      | feature | git reset --hard {{ sha-before-run 'feature commit' }} |
+      |         | git push --force-with-lease --force-if-includes        |
+      |         | git checkout main                                      |
+      | main    | git reset --hard {{ sha 'initial commit' }}            |
+      |         | git checkout feature                                   |
+    And the current branch is still "feature"
+    And the initial commits exist now
+    And the initial branches and lineage exist now
@@ -0,0 +1,46 @@
+@messyoutput
+Feature: sync a branch that somebody rebase-merged without deleting its tracking branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION      | MESSAGE          | FILE NAME | FILE CONTENT |
+      | feature | local, origin | feature commit 1 | file_1    | content 1    |
+      |         |               | feature commit 2 | file_2    | content 2    |
+    And origin ships the "feature" branch using the "rebase-merge" ship-strategy without deleting it
+    And the current branch is "feature"
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG           | KEYS  |
+      | shipped branches | enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH  | COMMAND                                 |
+      | feature | git fetch --prune --tags                |
+      |         | git checkout main                       |
+      | main    | git rebase origin/main --no-update-refs |
+      |         | git push origin :feature                |
+      |         | git branch -D feature                   |
+    And Git Town prints:
+      """
+      deleted branch "feature"
+      """
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES |
+      | local, origin | main     |
+    And no lineage exists now
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                    |
+      | main   | git branch feature {{ sha-before-run 'feature commit 2' }} |
+      |        | git push -u origin feature                                 |
+      |        | git reset --hard {{ sha 'initial commit' }}                |
+      |        | git checkout feature                                       |
+    And the current branch is now "feature"
+    And the initial branches and lineage exist now
@@ -0,0 +1,49 @@
+@messyoutput
+Feature: sync a branch that somebody squash-merged without deleting its tracking branch
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME      | TYPE    | PARENT | LOCATIONS     |
+      | feature-1 | feature | main   | local, origin |
+      | feature-2 | feature | main   | local, origin |
+    And the commits
+      | BRANCH    | LOCATION      | MESSAGE          | FILE NAME      | FILE CONTENT      |
+      | feature-1 | local, origin | feature-1 commit | feature-1-file | feature 1 content |
+      | feature-2 | local, origin | feature-2 commit | feature-2-file | feature 2 content |
+    And origin ships the "feature-1" branch using the "squash-merge" ship-strategy without deleting it
+    And the current branch is "feature-1"
+    When I run "git-town sync" and enter into the dialog:
+      | DIALOG           | KEYS  |
+      | shipped branches | enter |
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH    | COMMAND                                 |
+      | feature-1 | git fetch --prune --tags                |
+      |           | git checkout main                       |
+      | main      | git rebase origin/main --no-update-refs |
+      |           | git push origin :feature-1              |
+      |           | git branch -D feature-1                 |
+    And Git Town prints:
+      """
+      deleted branch "feature-1"
+      """
+    And the current branch is now "main"
+    And the branches are now
+      | REPOSITORY    | BRANCHES        |
+      | local, origin | main, feature-2 |
+    And this lineage exists now
+      | BRANCH    | PARENT |
+      | feature-2 | main   |
+
+  Scenario: undo
+    When I run "git-town undo"
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                                                      |
+      | main   | git branch feature-1 {{ sha-before-run 'feature-1 commit' }} |
+      |        | git push -u origin feature-1                                 |
+      |        | git reset --hard {{ sha 'initial commit' }}                  |
+      |        | git checkout feature-1                                       |
+    And the current branch is now "feature-1"
+    And the initial branches and lineage exist now
@@ -0,0 +1,36 @@
+Feature: undo several commands at once
+
+  Background:
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the current branch is "existing"
+    And I ran "git-town hack alpha"
+    And I ran "git-town append beta"
+    And I ran "git-town append gamma"
+    When I run "git-town undo --to 2"
+
+  Scenario: result
+    Then Git Town runs the commands
+      | BRANCH | COMMAND                |
+      | gamma  | git checkout beta      |
+      | beta   | git branch -D gamma    |
+      |        | git push origin :beta  |
+      |        | git checkout alpha     |
+      | alpha  | git branch -D beta     |
+      |        | git push origin :alpha |
+    And the current branch is now "alpha"
+    And the branches are now
+      | REPOSITORY | BRANCHES              |
+      | local      | main, alpha, existing |
+      | origin     | main, existing        |
+    And this lineage exists now
+      | BRANCH   | PARENT |
+      | alpha    | main   |
+      | existing | main   |
+
+  Scenario: undo the remaining command
+    When I run "git-town undo --to 1"
+    Then the current branch is now "existing"
+    And the initial branches and lineage exist now
@@ -0,0 +1,51 @@
+Feature: refuse to undo several commands when that isn't possible
+
+  Scenario: more commands than the history contains
+    Given a Git repo with origin
+    And the current branch is "main"
+    And I ran "git-town hack alpha"
+    When I run "git-town undo --to 2"
+    Then Git Town prints the error:
+      """
+      cannot undo 2 commands because the history contains only 1 commands
+      """
+    And the current branch is still "alpha"
+
+  Scenario: branches changed outside of Git Town between the commands
+    Given a Git repo with origin
+    And the branches
+      | NAME     | TYPE    | PARENT | LOCATIONS     |
+      | existing | feature | main   | local, origin |
+    And the current branch is "existing"
+    And I ran "git-town sync"
+    And the commits
+      | BRANCH   | LOCATION | MESSAGE       |
+      | existing | local    | local commit  |
+      | existing | origin   | origin commit |
+    And I ran "git fetch"
+    And I ran "git-town hack alpha"
+    When I run "git-town undo --to 2"
+    Then Git Town prints the error:
+      """
+      cannot undo 2 commands because the branches have changed outside of Git Town between the "sync" and "hack" commands
+      """
+    And the current branch is still "alpha"
+
+  Scenario: unfinished command
+    Given a Git repo with origin
+    And the branches
+      | NAME    | TYPE    | PARENT | LOCATIONS     |
+      | feature | feature | main   | local, origin |
+    And the commits
+      | BRANCH  | LOCATION | MESSAGE                    | FILE NAME        | FILE CONTENT    |
+      | main    | local    | conflicting main commit    | conflicting_file | main content    |
+      | feature | local    | conflicting feature commit | conflicting_file | feature content |
+    And the current branch is "feature"
+    And I ran "git-town hack alpha"
+    And I ran "git checkout feature"
+    And I ran "git-town sync"
+    When I run "git-town undo --to 1"
+    Then Git Town prints the error:
+      """
+      cannot undo several commands while the "sync" command is unfinished. Please run "git town continue", "git town skip", or "git town undo" first.
+      """
@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+const (
+	azureDevOpsTokenTitle = `Azure DevOps personal access token`
+	azureDevOpsTokenHelp  = `
+Git Town can update pull requests and ship branches on Azure DevOps for you.
+To enable this, please enter an Azure DevOps personal access token
+with the "Code (Read & write)" scope.
+More info at https://www.git-town.com/preferences/azure-devops-token.
+
+If you leave this empty, Git Town will not use the Azure DevOps API.
+
+`
+)
+
+// AzureDevOpsToken lets the user enter the Azure DevOps personal access token.
+func AzureDevOpsToken(oldValue Option[configdomain.AzureDevOpsToken], inputs components.TestInput) (Option[configdomain.AzureDevOpsToken], bool, error) {
+	text, aborted, err := components.TextField(components.TextFieldArgs{
+		ExistingValue: oldValue.String(),
+		Help:          azureDevOpsTokenHelp,
+		Prompt:        "Your Azure DevOps personal access token: ",
+		TestInput:     inputs,
+		Title:         azureDevOpsTokenTitle,
+	})
+	fmt.Printf(messages.AzureDevOpsToken, components.FormattedSecret(text, aborted))
+	return configdomain.ParseAzureDevOpsToken(text), aborted, err
+}
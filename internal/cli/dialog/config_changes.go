@@ -0,0 +1,38 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v17/internal/cli/format"
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+const (
+	configChangesTitle = `Apply changes`
+	configChangesHelp  = `
+Do you want to apply the configuration changes shown above?
+
+`
+)
+
+// ConfigChanges lets the user confirm the configuration changes that were printed before.
+func ConfigChanges(inputs components.TestInput) (bool, bool, error) {
+	entries := list.Entries[bool]{
+		{
+			Data: true,
+			Text: "yes, apply these changes",
+		},
+		{
+			Data: false,
+			Text: "no, keep the current configuration",
+		},
+	}
+	selection, aborted, err := components.RadioList(entries, 0, configChangesTitle, configChangesHelp, inputs)
+	if err != nil || aborted {
+		return false, aborted, err
+	}
+	fmt.Printf(messages.ConfigChanges, components.FormattedSelection(format.Bool(selection), aborted))
+	return selection, aborted, err
+}
@@ -27,6 +27,10 @@ func HostingPlatform(existingValue Option[configdomain.HostingPlatform], inputs
 			Data: None[configdomain.HostingPlatform](),
 			Text: "auto-detect",
 		},
+		{
+			Data: Some(configdomain.HostingPlatformAzureDevOps),
+			Text: "Azure DevOps",
+		},
 		{
 			Data: Some(configdomain.HostingPlatformBitbucket),
 			Text: "BitBucket",
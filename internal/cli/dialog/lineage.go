@@ -1,17 +1,20 @@
 package dialog
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 )
 
 // Lineage validates that the given lineage contains the ancestry for all given branches.
-// Prompts missing lineage information from the user.
+// Prompts missing lineage information from the user, preselecting the inferred parent,
+// or applies the inferred parent without asking if the user has enabled this.
 // Returns the new lineage and perennial branches to add to the config storage.
 func Lineage(args LineageArgs) (additionalLineage configdomain.Lineage, additionalPerennials gitdomain.LocalBranchNames, aborted bool, err error) {
 	additionalLineage = configdomain.NewLineage()
@@ -48,10 +51,29 @@ func Lineage(args LineageArgs) (additionalLineage configdomain.Lineage, addition
 				}
 			}
 		}
+		// infer parent
+		inferredParentOpt, err := configdomain.InferParent(configdomain.InferParentArgs{
+			AheadBehind:       args.AheadBehind,
+			Branch:            branchToVerify,
+			Lineage:           args.Lineage.Merge(additionalLineage),
+			LocalBranches:     args.LocalBranches,
+			MainBranch:        args.MainBranch,
+			PerennialBranches: args.PerennialBranches,
+		})
+		if err != nil {
+			return additionalLineage, additionalPerennials, false, err
+		}
+		inferredParent, hasInferredParent := inferredParentOpt.Get()
+		if hasInferredParent && args.InferParents.IsTrue() {
+			fmt.Printf(messages.ParentInferred, branchToVerify, inferredParent)
+			additionalLineage = additionalLineage.Set(branchToVerify, inferredParent)
+			branchesToVerify = append(branchesToVerify, inferredParent)
+			continue
+		}
 		// ask for parent
 		outcome, selectedBranch, err := Parent(ParentArgs{
 			Branch:          branchToVerify,
-			DefaultChoice:   args.DefaultChoice,
+			DefaultChoice:   inferredParentOpt.GetOrElse(args.DefaultChoice),
 			DialogTestInput: args.DialogTestInputs.Next(),
 			Lineage:         args.Lineage,
 			LocalBranches:   args.LocalBranches,
@@ -74,11 +96,13 @@ func Lineage(args LineageArgs) (additionalLineage configdomain.Lineage, addition
 }
 
 type LineageArgs struct {
+	AheadBehind       func(branch, candidate gitdomain.LocalBranchName) (ahead, behind int, err error)
 	BranchesAndTypes  configdomain.BranchesAndTypes
 	BranchesToVerify  gitdomain.LocalBranchNames
 	Connector         Option[hostingdomain.Connector]
 	DefaultChoice     gitdomain.LocalBranchName
 	DialogTestInputs  components.TestInputs
+	InferParents      configdomain.InferParents
 	Lineage           configdomain.Lineage
 	LocalBranches     gitdomain.LocalBranchNames
 	MainBranch        gitdomain.LocalBranchName
@@ -0,0 +1,61 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+const (
+	moveCommitsTitle        = `Commits to move`
+	moveCommitsHelpTemplate = `
+Please select the commits of branch %q
+that you want to move into an ancestor branch.
+
+`
+	moveCommitTargetTitle        = `Target branch`
+	moveCommitTargetHelpTemplate = `
+Please select the ancestor branch of %q
+that the selected commits should move into.
+
+`
+)
+
+// MoveCommits lets the user select which of the given commits of the given branch to move.
+func MoveCommits(branch gitdomain.LocalBranchName, commits gitdomain.Commits, inputs components.TestInput) (gitdomain.Commits, bool, error) {
+	entries := make(list.Entries[gitdomain.Commit], len(commits))
+	for c, commit := range commits {
+		entries[c] = list.Entry[gitdomain.Commit]{
+			Data:     commit,
+			Disabled: false,
+			Text:     fmt.Sprintf("%s %s", commit.SHA.TruncateTo(7), commit.Message),
+		}
+	}
+	selection, aborted, err := components.CheckList(entries, []int{}, moveCommitsTitle, fmt.Sprintf(moveCommitsHelpTemplate, branch), inputs)
+	selectedCommits := gitdomain.Commits(selection)
+	selectionTexts := make([]string, len(selectedCommits))
+	for c, commit := range selectedCommits {
+		selectionTexts[c] = commit.Message.String()
+	}
+	selectionText := strings.Join(selectionTexts, ", ")
+	if selectionText == "" {
+		selectionText = "(none)"
+	}
+	fmt.Printf(messages.MoveCommitsSelection, components.FormattedSelection(selectionText, aborted))
+	return selectedCommits, aborted, err
+}
+
+// MoveCommitTarget lets the user select the ancestor of the given branch to move the commits into.
+// The given ancestors must be ordered starting with the parent branch.
+func MoveCommitTarget(branch gitdomain.LocalBranchName, ancestors gitdomain.LocalBranchNames, inputs components.TestInput) (gitdomain.LocalBranchName, bool, error) {
+	if len(ancestors) == 1 {
+		return ancestors[0], false, nil
+	}
+	selection, aborted, err := components.RadioList(list.NewEntries(ancestors...), 0, moveCommitTargetTitle, fmt.Sprintf(moveCommitTargetHelpTemplate, branch), inputs)
+	fmt.Printf(messages.MoveCommitTargetSelection, components.FormattedSelection(selection.String(), aborted))
+	return selection, aborted, err
+}
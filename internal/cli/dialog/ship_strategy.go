@@ -18,6 +18,8 @@ Options:
 
 - api: merge the proposal on your code hosting platform via the code hosting API
 - fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch
+- merge-queue: add the proposal to the merge queue of your code hosting platform via the code hosting API
+- rebase-merge: in your local repo, rebase the feature branch onto its parent branch and fast-forward the parent branch to it
 - squash-merge: in your local repo, squash-merge the feature branch into its parent branch
 
 All options update proposals of child branches and remove the shipped branch locally and remotely.
@@ -34,6 +36,14 @@ func ShipStrategy(existing configdomain.ShipStrategy, inputs components.TestInpu
 			Data: configdomain.ShipStragegyFastForward,
 			Text: `fast-forward: in your local repo, fast-forward the parent branch to point to the commits on the feature branch`,
 		},
+		{
+			Data: configdomain.ShipStrategyMergeQueue,
+			Text: `merge-queue: add the proposal to the merge queue of your code hosting platform via the code hosting API`,
+		},
+		{
+			Data: configdomain.ShipStrategyRebaseMerge,
+			Text: `rebase-merge: in your local repo, rebase the feature branch onto its parent branch and fast-forward the parent branch to it`,
+		},
 		{
 			Data: configdomain.ShipStrategySquashMerge,
 			Text: `squash-merge: in your local repo, squash-merge the feature branch into its parent branch`,
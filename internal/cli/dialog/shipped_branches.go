@@ -0,0 +1,36 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components/list"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+const (
+	shippedBranchesTitle = `Shipped branches`
+	shippedBranchesHelp  = `
+The changes of these branches already exist in their parent branch.
+Somebody probably shipped them using a squash-merge or rebase-merge.
+Please select the branches that you want to delete.
+
+`
+)
+
+// ShippedBranches lets the user select which of the given shipped branches to delete.
+func ShippedBranches(branches gitdomain.LocalBranchNames, inputs components.TestInput) (gitdomain.LocalBranchNames, bool, error) {
+	selections := make([]int, len(branches))
+	for b := range branches {
+		selections[b] = b
+	}
+	selection, aborted, err := components.CheckList(list.NewEntries(branches...), selections, shippedBranchesTitle, shippedBranchesHelp, inputs)
+	selectedBranches := gitdomain.LocalBranchNames(selection)
+	selectionText := selectedBranches.Join(", ")
+	if selectionText == "" {
+		selectionText = "(none)"
+	}
+	fmt.Printf(messages.SyncShippedBranchesDelete, components.FormattedSelection(selectionText, aborted))
+	return selectedBranches, aborted, err
+}
@@ -9,3 +9,6 @@ import (
 
 // AddFunc defines the type signature for helper functions that add a CLI flag to a Cobra command.
 type AddFunc func(*cobra.Command)
+
+// ReadStringsFlagFunc defines the type signature for helper functions that provide the values of a repeatable string CLI flag associated with a Cobra command.
+type ReadStringsFlagFunc func(*cobra.Command) ([]string, error)
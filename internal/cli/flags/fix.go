@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const fixLong = "fix"
+
+// type-safe access to the CLI arguments that enable fixing problems without asking
+func Fix(desc string) (AddFunc, ReadBoolFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(fixLong, false, desc)
+	}
+	readFlag := func(cmd *cobra.Command) (bool, error) {
+		return cmd.Flags().GetBool(fixLong)
+	}
+	return addFlag, readFlag
+}
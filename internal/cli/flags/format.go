@@ -0,0 +1,26 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const formatLong = "format"
+
+// type-safe access to the CLI arguments of type configdomain.OutputFormat
+func Format() (AddFunc, ReadFormatFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().String(formatLong, configdomain.OutputFormatText.String(), `output format, either "text" or "json"`)
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.OutputFormat, error) {
+		value, err := cmd.Flags().GetString(formatLong)
+		if err != nil {
+			return configdomain.OutputFormatText, err
+		}
+		return configdomain.ParseOutputFormat(value)
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the format flag from the args to the given Cobra command
+type ReadFormatFlagFunc func(*cobra.Command) (configdomain.OutputFormat, error)
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const inferParentsLong = "infer-parents"
+
+// type-safe access to the CLI arguments of type configdomain.InferParents
+func InferParents() (AddFunc, ReadInferParentsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(inferParentsLong, "", false, "apply the inferred parent to branches with unknown parent without asking")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.InferParents, error) {
+		value, err := cmd.Flags().GetBool(inferParentsLong)
+		return configdomain.InferParents(value), err
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the infer-parents flag from the args to the given Cobra command
+type ReadInferParentsFlagFunc func(*cobra.Command) (configdomain.InferParents, error)
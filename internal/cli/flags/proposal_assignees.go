@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const proposalAssigneesLong = "assignee"
+
+// type-safe access to the repeatable "assignee" CLI argument
+func ProposalAssignees() (AddFunc, ReadStringsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(proposalAssigneesLong, []string{}, "assign the proposal to the given user (requires --api, can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) ([]string, error) {
+		return cmd.Flags().GetStringSlice(proposalAssigneesLong)
+	}
+	return addFlag, readFlag
+}
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const proposalDraftLong = "draft"
+
+// type-safe access to the CLI arguments of type configdomain.ProposalDraft
+func ProposalDraft() (AddFunc, ReadProposalDraftFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(proposalDraftLong, "", false, "create the proposal as a draft (requires --api)")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ProposalDraft, error) {
+		value, err := cmd.Flags().GetBool(proposalDraftLong)
+		return configdomain.ProposalDraft(value), err
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the draft flag from the args to the given Cobra command
+type ReadProposalDraftFlagFunc func(*cobra.Command) (configdomain.ProposalDraft, error)
@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const proposalLabelsLong = "label"
+
+// type-safe access to the repeatable "label" CLI argument
+func ProposalLabels() (AddFunc, ReadStringsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(proposalLabelsLong, []string{}, "add the given label to the proposal (requires --api, can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) ([]string, error) {
+		return cmd.Flags().GetStringSlice(proposalLabelsLong)
+	}
+	return addFlag, readFlag
+}
@@ -0,0 +1,18 @@
+package flags
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const proposalReviewersLong = "reviewer"
+
+// type-safe access to the repeatable "reviewer" CLI argument
+func ProposalReviewers() (AddFunc, ReadStringsFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringSlice(proposalReviewersLong, []string{}, "request a review of the proposal from the given user (requires --api, can be repeated)")
+	}
+	readFlag := func(cmd *cobra.Command) ([]string, error) {
+		return cmd.Flags().GetStringSlice(proposalReviewersLong)
+	}
+	return addFlag, readFlag
+}
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const proposeAPILong = "api"
+
+// type-safe access to the CLI arguments of type configdomain.ProposeAPI
+func ProposeAPI() (AddFunc, ReadProposeAPIFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(proposeAPILong, "", false, "create the proposal through the API of your code hosting platform")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ProposeAPI, error) {
+		value, err := cmd.Flags().GetBool(proposeAPILong)
+		return configdomain.ProposeAPI(value), err
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the api flag from the args to the given Cobra command
+type ReadProposeAPIFlagFunc func(*cobra.Command) (configdomain.ProposeAPI, error)
@@ -0,0 +1,19 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+// type-safe access to the CLI arguments of type configdomain.FullStack for the "ship" command,
+// which doesn't provide a shorthand because "-s" is already taken by the "--strategy" flag
+func ShipStack() (AddFunc, ReadStackFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(stackLong, false, "ship all branches in the stack, starting with the oldest ancestor")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.FullStack, error) {
+		value, err := cmd.Flags().GetBool(stackLong)
+		return configdomain.FullStack(value), err
+	}
+	return addFlag, readFlag
+}
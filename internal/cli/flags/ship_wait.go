@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const shipWaitLong = "wait"
+
+// type-safe access to the CLI arguments of type configdomain.ShipWait
+func ShipWait() (AddFunc, ReadShipWaitFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Bool(shipWaitLong, false, "wait until the merge queue has merged the proposal")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.ShipWait, error) {
+		value, err := cmd.Flags().GetBool(shipWaitLong)
+		return configdomain.ShipWait(value), err
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the wait flag from the args to the given Cobra command
+type ReadShipWaitFlagFunc func(*cobra.Command) (configdomain.ShipWait, error)
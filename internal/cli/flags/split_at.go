@@ -0,0 +1,31 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const splitAtLong = "at" // long form of the "split at" CLI flag
+
+// type-safe access to the CLI arguments of type gitdomain.SHA for the "split" command
+func SplitAt() (AddFunc, ReadSplitAtFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().String(splitAtLong, "", "the last commit of the new parent branch")
+	}
+	readFlag := func(cmd *cobra.Command) (Option[gitdomain.SHA], error) {
+		value, err := cmd.Flags().GetString(splitAtLong)
+		if err != nil || value == "" {
+			return None[gitdomain.SHA](), err
+		}
+		sha, err := gitdomain.NewSHAErr(value)
+		if err != nil {
+			return None[gitdomain.SHA](), err
+		}
+		return Some(sha), nil
+	}
+	return addFlag, readFlag
+}
+
+// ReadSplitAtFlagFunc defines the type signature for helper functions that provide the value of the "--at" CLI flag.
+type ReadSplitAtFlagFunc func(*cobra.Command) (Option[gitdomain.SHA], error)
@@ -0,0 +1,23 @@
+package flags
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/spf13/cobra"
+)
+
+const syncCheckLong = "check"
+
+// type-safe access to the CLI arguments of type configdomain.SyncCheck
+func SyncCheck() (AddFunc, ReadSyncCheckFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolP(syncCheckLong, "", false, "only report which branches would have merge conflicts, without syncing")
+	}
+	readFlag := func(cmd *cobra.Command) (configdomain.SyncCheck, error) {
+		value, err := cmd.Flags().GetBool(syncCheckLong)
+		return configdomain.SyncCheck(value), err
+	}
+	return addFlag, readFlag
+}
+
+// the type signature for the function that reads the check flag from the args to the given Cobra command
+type ReadSyncCheckFlagFunc func(*cobra.Command) (configdomain.SyncCheck, error)
@@ -0,0 +1,26 @@
+package flags
+
+import (
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const undoToLong = "to" // long form of the "undo to" CLI flag
+
+// type-safe access to the CLI arguments for the history entry to undo to
+func UndoTo() (AddFunc, ReadUndoToFlagFunc) {
+	addFlag := func(cmd *cobra.Command) {
+		cmd.Flags().Int(undoToLong, 0, `undo all commands up to and including this entry in "git town history"`)
+	}
+	readFlag := func(cmd *cobra.Command) (Option[int], error) {
+		value, err := cmd.Flags().GetInt(undoToLong)
+		if err != nil || !cmd.Flags().Changed(undoToLong) {
+			return None[int](), err
+		}
+		return Some(value), nil
+	}
+	return addFlag, readFlag
+}
+
+// ReadUndoToFlagFunc defines the type signature for helper functions that provide the value of the "--to" CLI flag.
+type ReadUndoToFlagFunc func(*cobra.Command) (Option[int], error)
@@ -0,0 +1,41 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// Diff provides the lines that differ between the given texts,
+// with removed lines prefixed by "- " and added lines prefixed by "+ ".
+// Returns an empty string if both texts are equal.
+func Diff(before, after string) string {
+	dmp := diffmatchpatch.New()
+	beforeChars, afterChars, lines := dmp.DiffLinesToChars(terminateLine(before), terminateLine(after))
+	diffs := dmp.DiffCharsToLines(dmp.DiffMain(beforeChars, afterChars, false), lines)
+	result := strings.Builder{}
+	for _, diff := range diffs {
+		var prefix string
+		switch diff.Type {
+		case diffmatchpatch.DiffDelete:
+			prefix = "- "
+		case diffmatchpatch.DiffInsert:
+			prefix = "+ "
+		case diffmatchpatch.DiffEqual:
+			continue
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(diff.Text, "\n"), "\n") {
+			result.WriteString(prefix + line + "\n")
+		}
+	}
+	return result.String()
+}
+
+// terminateLine ensures that the given non-empty text ends with a newline,
+// so that its last line compares equal to the same line in the middle of another text.
+func terminateLine(text string) string {
+	if text == "" || strings.HasSuffix(text, "\n") {
+		return text
+	}
+	return text + "\n"
+}
@@ -0,0 +1,53 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cli/format"
+	"github.com/shoenig/test/must"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("equal texts", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("one\ntwo\n", "one\ntwo\n")
+		must.EqOp(t, "", have)
+	})
+
+	t.Run("added lines", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("one\n", "one\ntwo\nthree\n")
+		want := "+ two\n+ three\n"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("removed lines", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("one\ntwo\nthree\n", "one\nthree\n")
+		want := "- two\n"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("changed lines", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("one\ntwo\nthree\n", "one\n2\nthree\n")
+		want := "- two\n+ 2\n"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("missing trailing newline", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("one\ntwo", "one\ntwo\nthree\n")
+		want := "+ three\n"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("empty before", func(t *testing.T) {
+		t.Parallel()
+		have := format.Diff("", "one\n")
+		want := "+ one\n"
+		must.EqOp(t, want, have)
+	})
+}
@@ -0,0 +1,16 @@
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSON prints the given value as indented JSON.
+func JSON(value any) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(content))
+	return nil
+}
@@ -29,3 +29,17 @@ func (l Logger) Start(template string, data ...interface{}) {
 func (l Logger) Success(message string) {
 	l.Log(colors.BoldGreen().Styled(message))
 }
+
+// NoLogger is a logger that doesn't print anything.
+// Use it for activities that run concurrently, whose log output would interleave.
+type NoLogger struct{}
+
+func (NoLogger) Failed(string) {}
+
+func (NoLogger) Log(string) {}
+
+func (NoLogger) Ok() {}
+
+func (NoLogger) Start(string, ...interface{}) {}
+
+func (NoLogger) Success(string) {}
@@ -0,0 +1,419 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v17/internal/validate"
+	fullInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/full"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const absorbDesc = "Distribute staged changes into the commits of the stack they belong to"
+
+const absorbHelp = `
+Determines for each staged change which commit of the current branch or its ancestor branches last changed the affected lines. Commits the changes as fixups of these commits, squashes the fixups into them, and rebases all descendant branches onto the updated branches.
+
+Staged changes that don't belong to a single commit of the current branch or its ancestors remain in your workspace.`
+
+func absorbCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "absorb",
+		GroupID: "stack",
+		Args:    cobra.NoArgs,
+		Short:   absorbDesc,
+		Long:    cmdhelpers.Long(absorbDesc, absorbHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, err := readDryRunFlag(cmd)
+			if err != nil {
+				return err
+			}
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeAbsorb(dryRun, verbose)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeAbsorb(dryRun configdomain.DryRun, verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineAbsorbData(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runProgram, finalUndoProgram := absorbProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               "absorb",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		FinalUndoProgram:      finalUndoProgram,
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               data.connector,
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type absorbData struct {
+	absorbedPatch    string // the staged changes that get absorbed into commits
+	branchesSnapshot gitdomain.BranchesSnapshot
+	branchesToUpdate []absorbBranch // the branches to update, in the order of the lineage
+	config           config.ValidatedConfig
+	connector        Option[hostingdomain.Connector]
+	dialogTestInputs components.TestInputs
+	dryRun           configdomain.DryRun
+	hasOpenChanges   bool
+	hasOtherChanges  bool // whether the workspace contains changes besides the ones that get absorbed
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   Option[gitdomain.LocalBranchName]
+	stashSize        gitdomain.StashSize
+}
+
+// absorbBranch describes how absorbing the staged changes updates a branch.
+type absorbBranch struct {
+	fixups       []absorbFixup // the fixups to squash into the commits of this branch, oldest commit first
+	name         gitdomain.LocalBranchName
+	oldParentSHA Option[gitdomain.SHA] // the SHA of the parent branch before absorbing, if the parent branch gets updated
+	parent       gitdomain.LocalBranchName
+	push         bool // whether to force-push this branch to its tracking branch
+}
+
+// absorbFixup describes the staged changes that belong to a commit.
+type absorbFixup struct {
+	patch string
+	sha   gitdomain.SHA
+}
+
+func determineAbsorbData(repo execute.OpenRepoResult, dryRun configdomain.DryRun, verbose configdomain.Verbose) (data absorbData, exit bool, err error) {
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	remotes := fc.Remotes(repo.Git.Remotes(repo.Backend))
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	connector, err := hosting.NewConnector(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		Connector:          connector,
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	if !isAbsorbableBranchType(validatedConfig.BranchType(initialBranch)) {
+		return data, false, fmt.Errorf(messages.AbsorbNoFeatureBranch, initialBranch)
+	}
+	stagedChanges, err := repo.Git.StagedChanges(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	filePatches := gitdomain.ParsePatch(stagedChanges)
+	if len(filePatches) == 0 {
+		return data, false, errors.New(messages.AbsorbNoStagedChanges)
+	}
+	unstagedFiles, err := repo.Git.UnstagedFiles(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	filesWithUnstagedChanges := []string{}
+	for _, filePatch := range filePatches {
+		if slices.Contains(unstagedFiles, filePatch.Path) {
+			filesWithUnstagedChanges = append(filesWithUnstagedChanges, filePatch.Path)
+		}
+	}
+	if len(filesWithUnstagedChanges) > 0 {
+		return data, false, fmt.Errorf(messages.AbsorbUnstagedChanges, strings.Join(filesWithUnstagedChanges, ", "))
+	}
+	// the branches that can receive fixups: the current branch and its ancestors up to the first one that isn't a local feature branch
+	lineage := validatedConfig.NormalConfig.Lineage
+	targetBranches := gitdomain.LocalBranchNames{}
+	commitBranches := map[gitdomain.SHA]gitdomain.LocalBranchName{}
+	branchCommits := map[gitdomain.LocalBranchName]gitdomain.Commits{}
+	for branch := initialBranch; ; {
+		parent, hasParent := lineage.Parent(branch).Get()
+		if !hasParent || !branchesSnapshot.Branches.HasLocalBranch(branch) || !isAbsorbableBranchType(validatedConfig.BranchType(branch)) {
+			break
+		}
+		commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, branch, parent)
+		if err != nil {
+			return data, false, err
+		}
+		for _, commit := range commits {
+			commitBranches[commit.SHA] = branch
+		}
+		branchCommits[branch] = commits
+		targetBranches = append(targetBranches, branch)
+		branch = parent
+	}
+	slices.Reverse(targetBranches)
+	absorbedHunks := make([][]gitdomain.PatchHunk, len(filePatches))
+	hunksPerCommit := map[gitdomain.SHA][][]gitdomain.PatchHunk{}
+	hasSkippedChanges := false
+	for f, filePatch := range filePatches {
+		if filePatch.Binary || filePatch.NewFile {
+			repo.FinalMessages.Add(fmt.Sprintf(messages.AbsorbChangesSkippedFile, filePatch.Path))
+			hasSkippedChanges = true
+			continue
+		}
+		for _, hunk := range filePatch.Hunks {
+			sha, hasSHA, err := absorbTargetCommit(repo, filePatch.Path, hunk, commitBranches)
+			if err != nil {
+				return data, false, err
+			}
+			if !hasSHA {
+				repo.FinalMessages.Add(fmt.Sprintf(messages.AbsorbChangesSkippedHunk, filePatch.Path, hunk.OldStart))
+				hasSkippedChanges = true
+				continue
+			}
+			if _, has := hunksPerCommit[sha]; !has {
+				hunksPerCommit[sha] = make([][]gitdomain.PatchHunk, len(filePatches))
+			}
+			hunksPerCommit[sha][f] = append(hunksPerCommit[sha][f], hunk)
+			absorbedHunks[f] = append(absorbedHunks[f], hunk)
+		}
+	}
+	if len(hunksPerCommit) == 0 {
+		return data, false, fmt.Errorf(messages.AbsorbNothingToAbsorb, initialBranch)
+	}
+	fixups := map[gitdomain.LocalBranchName][]absorbFixup{}
+	topmostTarget := None[gitdomain.LocalBranchName]()
+	for _, targetBranch := range targetBranches {
+		for _, commit := range branchCommits[targetBranch] {
+			if hunks, has := hunksPerCommit[commit.SHA]; has {
+				fixups[targetBranch] = append(fixups[targetBranch], absorbFixup{
+					patch: patchText(filePatches, hunks),
+					sha:   commit.SHA,
+				})
+				if topmostTarget.IsNone() {
+					topmostTarget = Some(targetBranch)
+				}
+			}
+		}
+	}
+	isOnline := remotes.HasDev(validatedConfig.NormalConfig.DevRemote) && validatedConfig.NormalConfig.IsOnline()
+	branchesToUpdate := []absorbBranch{}
+	updatedBranches := gitdomain.LocalBranchNames{}
+	for _, branch := range append(gitdomain.LocalBranchNames{topmostTarget.GetOrPanic()}, lineage.Descendants(topmostTarget.GetOrPanic())...) {
+		branchInfo, hasBranchInfo := branchesSnapshot.Branches.FindByLocalName(branch).Get()
+		parent, hasParent := lineage.Parent(branch).Get()
+		branchType := validatedConfig.BranchType(branch)
+		if !hasBranchInfo || !hasParent || !isAbsorbableBranchType(branchType) {
+			continue
+		}
+		oldParentSHA := None[gitdomain.SHA]()
+		if len(updatedBranches) > 0 {
+			// only descendants of updated branches need to get rebased
+			if !updatedBranches.Contains(parent) {
+				continue
+			}
+			if parentInfo, hasParentInfo := branchesSnapshot.Branches.FindByLocalName(parent).Get(); hasParentInfo {
+				oldParentSHA = parentInfo.LocalSHA
+			}
+		}
+		branchesToUpdate = append(branchesToUpdate, absorbBranch{
+			fixups:       fixups[branch],
+			name:         branch,
+			oldParentSHA: oldParentSHA,
+			parent:       parent,
+			push:         isOnline && branchInfo.HasTrackingBranch() && branchType.ShouldPush(branch == initialBranch),
+		})
+		updatedBranches = append(updatedBranches, branch)
+	}
+	absorbedPatch := patchText(filePatches, absorbedHunks)
+	return absorbData{
+		absorbedPatch:    absorbedPatch,
+		branchesSnapshot: branchesSnapshot,
+		branchesToUpdate: branchesToUpdate,
+		config:           validatedConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		hasOtherChanges:  hasSkippedChanges || len(unstagedFiles) > 0 || repoStatus.UntrackedChanges,
+		initialBranch:    initialBranch,
+		previousBranch:   previousBranch,
+		stashSize:        stashSize,
+	}, false, fc.Err
+}
+
+func absorbProgram(data absorbData) (runProgram, finalUndoProgram program.Program) {
+	prog := NewMutable(&program.Program{})
+	for _, branch := range data.branchesToUpdate {
+		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: branch.name})
+		for _, fixup := range branch.fixups {
+			prog.Value.Add(&opcodes.PatchApply{Patch: fixup.patch})
+			prog.Value.Add(&opcodes.CommitFixup{SHA: fixup.sha})
+		}
+		if len(branch.fixups) > 0 {
+			prog.Value.Add(&opcodes.RebaseAutosquash{SHA: branch.fixups[0].sha})
+		}
+		if oldParentSHA, hasOldParentSHA := branch.oldParentSHA.Get(); hasOldParentSHA {
+			prog.Value.Add(&opcodes.RebaseCommitsSince{Onto: branch.parent.BranchName(), Since: oldParentSHA})
+		}
+		if branch.push {
+			prog.Value.Add(&opcodes.PushCurrentBranchForce{ForceIfIncludes: false})
+		}
+	}
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOtherChanges,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	// remove the absorbed changes from the workspace before stashing away the remaining changes
+	prog.Value.Prepend(&opcodes.PatchRemove{Patch: data.absorbedPatch})
+	// undo restores the branches, this restores the absorbed changes as staged changes
+	undoProg := program.Program{}
+	undoProg.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	undoProg.Add(&opcodes.PatchApply{Patch: data.absorbedPatch})
+	return prog.Immutable(), undoProg
+}
+
+// absorbTargetCommit provides the commit that the given hunk of the given file belongs to.
+// This is the commit that last changed the lines affected by the hunk,
+// if all these lines were last changed by the same commit and this commit is one of the given commits.
+func absorbTargetCommit(repo execute.OpenRepoResult, file string, hunk gitdomain.PatchHunk, commitBranches map[gitdomain.SHA]gitdomain.LocalBranchName) (gitdomain.SHA, bool, error) {
+	lines := hunk.BlameLines()
+	if len(lines) == 0 {
+		return "", false, nil
+	}
+	shas, err := repo.Git.BlameLines(repo.Backend, file, lines)
+	if err != nil {
+		return "", false, err
+	}
+	if len(shas) == 0 {
+		return "", false, nil
+	}
+	sha := shas[0]
+	for _, other := range shas[1:] {
+		if other != sha {
+			return "", false, nil
+		}
+	}
+	_, isCandidate := commitBranches[sha]
+	return sha, isCandidate, nil
+}
+
+// isAbsorbableBranchType indicates whether "git town absorb" can rewrite the commits of branches with the given type.
+func isAbsorbableBranchType(branchType configdomain.BranchType) bool {
+	switch branchType {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		return true
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		return false
+	}
+	return false
+}
+
+// patchText provides the patch that contains the given hunks of the given files.
+// The given hunks contain the hunks for each of the given files, in the same order.
+func patchText(filePatches []gitdomain.FilePatch, hunks [][]gitdomain.PatchHunk) string {
+	result := strings.Builder{}
+	for f, filePatch := range filePatches {
+		if len(hunks[f]) > 0 {
+			result.WriteString(filePatch.Text(hunks[f]))
+		}
+	}
+	return result.String()
+}
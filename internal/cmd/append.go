@@ -76,12 +76,13 @@ func appendCmd() *cobra.Command {
 
 func executeAppend(arg string, detached configdomain.Detached, dryRun configdomain.DryRun, prototype configdomain.Prototype, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -245,14 +246,17 @@ func appendProgram(data appendFeatureData, finalMessages stringslice.Collector)
 	if !data.hasOpenChanges {
 		branchesToDelete := set.New[gitdomain.LocalBranchName]()
 		sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-			BranchInfos:         data.branchInfos,
-			BranchesToDelete:    NewMutable(&branchesToDelete),
-			Config:              data.config,
-			InitialBranch:       data.initialBranch,
-			PrefetchBranchInfos: data.preFetchBranchInfos,
-			Program:             prog,
-			Remotes:             data.remotes,
-			PushBranches:        true,
+			BranchInfos:              data.branchInfos,
+			BranchesToDelete:         NewMutable(&branchesToDelete),
+			Config:                   data.config,
+			InitialBranch:            data.initialBranch,
+			PrefetchBranchInfos:      data.preFetchBranchInfos,
+			Program:                  prog,
+			Remotes:                  data.remotes,
+			ShippedBranches:          sync.ShippedBranches{},
+			Worktrees:                gitdomain.Worktrees{},
+			WorktreesWithOpenChanges: gitdomain.Worktrees{},
+			PushBranches:             true,
 		})
 	}
 	prog.Value.Add(&opcodes.BranchCreateAndCheckoutExistingParent{
@@ -284,6 +288,7 @@ func appendProgram(data appendFeatureData, finalMessages stringslice.Collector)
 			prog.Value.Add(&opcodes.BranchesPrototypeAdd{Branch: data.targetBranch})
 		}
 	}
+	cmdhelpers.AddHook(prog, data.config.NormalConfig.NormalConfigData, configdomain.HookAfterCreateBranch, data.targetBranch)
 	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{Some(data.initialBranch), data.previousBranch}
 	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
 		DryRun:                   data.dryRun,
@@ -44,12 +44,13 @@ func branchCmd() *cobra.Command {
 
 func executeBranch(verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
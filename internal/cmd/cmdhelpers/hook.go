@@ -0,0 +1,21 @@
+package cmdhelpers
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// AddHook adds the opcode that runs the given hook for the given branch to the given program,
+// if the user has configured a command for this hook.
+func AddHook(prog Mutable[program.Program], config configdomain.NormalConfigData, hook configdomain.Hook, branch gitdomain.LocalBranchName) {
+	if command, hasCommand := config.HookCommand(hook).Get(); hasCommand {
+		prog.Value.Add(&opcodes.HookRun{
+			Branch:  branch,
+			Command: command,
+			Hook:    hook,
+		})
+	}
+}
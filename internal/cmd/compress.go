@@ -81,12 +81,13 @@ func compressCmd() *cobra.Command {
 
 func executeCompress(dryRun configdomain.DryRun, verbose configdomain.Verbose, message Option[gitdomain.CommitMessage], compressEntireStack configdomain.FullStack) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,215 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/format"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+const explainConfigDesc = "Explains where your Git Town configuration comes from"
+
+const explainConfigHelp = `
+Displays the effective value of each setting together with its source:
+the local Git metadata, the global Git metadata, the configuration file, or the default value.
+Also lists the values that the effective value overrides
+and problems with the configuration:
+deprecated settings, regular expressions that match no branch,
+lineage entries for branches that don't exist, and cycles in the lineage.`
+
+func explainConfigCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "explain",
+		Args:  cobra.NoArgs,
+		Short: explainConfigDesc,
+		Long:  cmdhelpers.Long(explainConfigDesc, explainConfigHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeExplainConfig(verbose)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeExplainConfig(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: false, // this command reports deprecated settings instead of migrating them
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+	printSettingSources(repo.UnvalidatedConfig, repo.ConfigSnapshot)
+	problems := configProblems(repo.UnvalidatedConfig.NormalConfig, repo.ConfigSnapshot, branchesSnapshot.Branches)
+	if len(problems) == 0 {
+		fmt.Println(messages.ConfigExplainNoProblems)
+		return nil
+	}
+	print.Header("Problems")
+	for _, problem := range problems {
+		fmt.Println("  " + problem)
+	}
+	return nil
+}
+
+// settingSource describes the value of a setting in one of the places that can define it.
+type settingSource struct {
+	source configdomain.ConfigSource
+	value  string
+}
+
+// printSettingSources prints the effective value of each setting, where it comes from, and the values it overrides.
+func printSettingSources(unvalidatedConfig config.UnvalidatedConfig, snapshot undoconfig.ConfigSnapshot) {
+	settings := newConfigJSON(unvalidatedConfig).Settings
+	fileValues := map[configdomain.Key]string{}
+	if configFile, hasConfigFile := unvalidatedConfig.NormalConfig.ConfigFile.Get(); hasConfigFile {
+		fileValues = gitConfigValues(configFile)
+	}
+	names := maps.Keys(settings)
+	slices.Sort(names)
+	print.Header("Settings")
+	for _, name := range names {
+		setting := settings[name]
+		sources := settingSources(configdomain.Key("git-town."+name), snapshot, fileValues)
+		switch {
+		case len(sources) == 0:
+			print.Entry(name, fmt.Sprintf("%s (%s)", formatSettingValue(setting.Value), configdomain.ConfigSourceDefault))
+		case isBranchList(setting.Value):
+			// Git Town combines the branch lists from all sources
+			sourceNames := make([]string, len(sources))
+			for s, source := range sources {
+				sourceNames[s] = source.source.String()
+			}
+			print.Entry(name, fmt.Sprintf("%s (%s)", formatSettingValue(setting.Value), strings.Join(sourceNames, ", ")))
+		default:
+			print.Entry(name, fmt.Sprintf("%s (%s)", sources[0].value, sources[0].source))
+			for _, overridden := range sources[1:] {
+				fmt.Printf(messages.ConfigExplainOverrides, overridden.value, overridden.source)
+			}
+		}
+	}
+	fmt.Println()
+}
+
+// settingSources provides the values of the setting with the given key, ordered by precedence.
+func settingSources(key configdomain.Key, snapshot undoconfig.ConfigSnapshot, fileValues map[configdomain.Key]string) []settingSource {
+	result := []settingSource{}
+	if value, has := snapshot.Local[key]; has {
+		result = append(result, settingSource{source: configdomain.ConfigSourceLocal, value: value})
+	}
+	if value, has := snapshot.Global[key]; has {
+		result = append(result, settingSource{source: configdomain.ConfigSourceGlobal, value: value})
+	}
+	if value, has := fileValues[key]; has {
+		result = append(result, settingSource{source: configdomain.ConfigSourceConfigFile, value: value})
+	}
+	return result
+}
+
+// configProblems provides human-readable descriptions of the problems in the given configuration.
+func configProblems(normalConfig config.NormalConfig, snapshot undoconfig.ConfigSnapshot, branches gitdomain.BranchInfos) []string {
+	result := deprecatedSettings(configdomain.ConfigScopeLocal, snapshot.Local)
+	result = append(result, deprecatedSettings(configdomain.ConfigScopeGlobal, snapshot.Global)...)
+	branchNames := make(gitdomain.LocalBranchNames, len(branches))
+	for b, branch := range branches {
+		branchNames[b] = branch.LocalBranchName()
+	}
+	checkRegex := func(key configdomain.Key, regex configdomain.VerifiedRegex) {
+		if !slices.ContainsFunc(branchNames, regex.MatchesBranch) {
+			result = append(result, fmt.Sprintf(messages.ConfigExplainRegexUnused, key, regex))
+		}
+	}
+	if regex, has := normalConfig.ContributionRegex.Get(); has {
+		checkRegex(configdomain.KeyContributionRegex, regex.VerifiedRegex)
+	}
+	if regex, has := normalConfig.FeatureRegex.Get(); has {
+		checkRegex(configdomain.KeyFeatureRegex, regex.VerifiedRegex)
+	}
+	if regex, has := normalConfig.ObservedRegex.Get(); has {
+		checkRegex(configdomain.KeyObservedRegex, regex.VerifiedRegex)
+	}
+	if regex, has := normalConfig.PerennialRegex.Get(); has {
+		checkRegex(configdomain.KeyPerennialRegex, regex.VerifiedRegex)
+	}
+	for _, entry := range normalConfig.Lineage.Entries() {
+		if !branches.HasBranch(entry.Child) {
+			result = append(result, fmt.Sprintf(messages.ConfigExplainLineageMissingBranch, entry.Child))
+		}
+		if !branches.HasBranch(entry.Parent) {
+			result = append(result, fmt.Sprintf(messages.ConfigExplainLineageMissingParent, entry.Child, entry.Parent))
+		}
+	}
+	for _, cycle := range normalConfig.Lineage.Cycles() {
+		result = append(result, fmt.Sprintf(messages.ConfigExplainLineageCycle, cycle.Join(" -> ")+" -> "+cycle[0].String()))
+	}
+	return result
+}
+
+// deprecatedSettings describes the settings in the given Git metadata that Git Town would migrate or remove.
+func deprecatedSettings(scope configdomain.ConfigScope, snapshot configdomain.SingleSnapshot) []string {
+	result := []string{}
+	keys := maps.Keys(snapshot)
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := snapshot[key]
+		if newKey, isDeprecated := configdomain.DeprecatedKeys[key]; isDeprecated {
+			result = append(result, fmt.Sprintf(messages.ConfigExplainDeprecatedKey, scope, key, newKey))
+		}
+		if slices.Contains(configdomain.ObsoleteKeys, key) {
+			result = append(result, fmt.Sprintf(messages.ConfigExplainObsoleteKey, scope, key))
+		}
+		for _, update := range configdomain.ConfigUpdates {
+			if key == update.Before.Key && value == update.Before.Value {
+				result = append(result, fmt.Sprintf(messages.ConfigExplainDeprecatedValue, scope, key, value, update.After.Key, update.After.Value))
+			}
+		}
+	}
+	return result
+}
+
+// formatSettingValue provides the printable version of the given value of a setting in the machine-readable configuration.
+func formatSettingValue(value any) string {
+	switch value := value.(type) {
+	case bool:
+		return strconv.FormatBool(value)
+	case string:
+		return value
+	case []string:
+		return format.StringsSetting(strings.Join(value, " "))
+	}
+	return "(not set)"
+}
+
+// isBranchList indicates whether the given value of a setting in the machine-readable configuration is a list of branches.
+func isBranchList(value any) bool {
+	_, isList := value.([]string)
+	return isList
+}
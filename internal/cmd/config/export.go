@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/format"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/config/configfile"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	configInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/config"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const exportConfigDesc = "Stores the Git Town configuration in the configuration file"
+
+const exportConfigHelp = `
+Writes all Git Town settings into the configuration file
+and removes them from the local Git metadata.
+Shows the changes to the configuration file and asks for confirmation before writing it.
+API tokens and other personal settings remain in the Git metadata.`
+
+func exportConfigCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "export",
+		Args:  cobra.NoArgs,
+		Short: exportConfigDesc,
+		Long:  cmdhelpers.Long(exportConfigDesc, exportConfigHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeExportConfig(verbose)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeExportConfig(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	configPath := configfile.FilePath(repo.RootDir)
+	configFileName := filepath.Base(configPath)
+	oldContent, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf(messages.ConfigFileCannotRead, configFileName, err)
+	}
+	newContent := configfile.RenderTOML(&repo.UnvalidatedConfig)
+	diff := format.Diff(string(oldContent), newContent)
+	if diff == "" {
+		fmt.Printf(messages.ConfigExportUnchanged, configFileName)
+		return nil
+	}
+	fmt.Printf(messages.ConfigFileChanges, configFileName)
+	fmt.Println(diff)
+	apply, aborted, err := dialog.ConfigChanges(components.LoadTestInputs(os.Environ()).Next())
+	if err != nil || aborted || !apply {
+		return err
+	}
+	err = os.WriteFile(configPath, []byte(newContent), 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, configPath, err)
+	}
+	removeSettingsInConfigFile(repo.UnvalidatedConfig)
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		Backend:               repo.Backend,
+		BeginBranchesSnapshot: None[gitdomain.BranchesSnapshot](),
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		Command:               "config export",
+		CommandsCounter:       repo.CommandsCounter,
+		FinalMessages:         repo.FinalMessages,
+		Git:                   repo.Git,
+		RootDir:               repo.RootDir,
+		TouchedBranches:       []gitdomain.BranchName{},
+		Verbose:               verbose,
+	})
+}
@@ -35,12 +35,13 @@ func getParentCommand() *cobra.Command {
 
 func executeGetParent(args []string, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: false,
-		PrintCommands:    false,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          false,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
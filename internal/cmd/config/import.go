@@ -0,0 +1,231 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/format"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/config/configfile"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	configInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/config"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+const importConfigDesc = "Stores the settings from the configuration file in the Git metadata"
+
+const importConfigHelp = `
+Writes all settings from the configuration file into the local Git metadata
+and the aliases defined in the configuration file into the global Git metadata.
+Shows the changes to the Git metadata and asks for confirmation before writing them.
+The configuration file remains unchanged.`
+
+func importConfigCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:   "import",
+		Args:  cobra.NoArgs,
+		Short: importConfigDesc,
+		Long:  cmdhelpers.Long(importConfigDesc, importConfigHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeImportConfig(verbose)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeImportConfig(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	configPath := configfile.FilePath(repo.RootDir)
+	configFileName := filepath.Base(configPath)
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf(messages.ConfigImportNoFile, configFileName)
+		}
+		return fmt.Errorf(messages.ConfigFileCannotRead, configFileName, err)
+	}
+	data, err := configfile.Decode(string(content))
+	if err != nil {
+		return fmt.Errorf(messages.ConfigFileInvalidContent, configFileName, err)
+	}
+	fileConfig, err := configfile.Validate(*data, repo.FinalMessages)
+	if err != nil {
+		return err
+	}
+	fileConfig.Aliases, err = configfile.ValidateAliases(*data)
+	if err != nil {
+		return err
+	}
+	changes := determineImportChanges(fileConfig, repo.UnvalidatedConfig.NormalConfig)
+	if len(changes) == 0 {
+		fmt.Printf(messages.ConfigImportUnchanged, configFileName)
+		return nil
+	}
+	fmt.Print(messages.ConfigGitChanges)
+	fmt.Println(changes.diff())
+	apply, aborted, err := dialog.ConfigChanges(components.LoadTestInputs(os.Environ()).Next())
+	if err != nil || aborted || !apply {
+		return err
+	}
+	for _, change := range changes {
+		err = repo.UnvalidatedConfig.NormalConfig.GitConfigAccess.SetConfigValue(change.scope, change.key, change.newValue)
+		if err != nil {
+			return err
+		}
+	}
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		Backend:               repo.Backend,
+		BeginBranchesSnapshot: None[gitdomain.BranchesSnapshot](),
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		Command:               "config import",
+		CommandsCounter:       repo.CommandsCounter,
+		FinalMessages:         repo.FinalMessages,
+		Git:                   repo.Git,
+		RootDir:               repo.RootDir,
+		TouchedBranches:       []gitdomain.BranchName{},
+		Verbose:               verbose,
+	})
+}
+
+// gitConfigChange describes a Git configuration setting that importing the configuration file changes.
+type gitConfigChange struct {
+	key      configdomain.Key
+	newValue string
+	oldValue Option[string]
+	scope    configdomain.ConfigScope
+}
+
+type gitConfigChanges []gitConfigChange
+
+// diff provides the human-readable changes to the Git metadata.
+func (self gitConfigChanges) diff() string {
+	oldLines := []string{}
+	newLines := []string{}
+	for _, change := range self {
+		if oldValue, hasOldValue := change.oldValue.Get(); hasOldValue {
+			oldLines = append(oldLines, fmt.Sprintf("%s = %s", change.key, oldValue))
+		}
+		newLines = append(newLines, fmt.Sprintf("%s = %s", change.key, change.newValue))
+	}
+	return format.Diff(strings.Join(oldLines, "\n"), strings.Join(newLines, "\n"))
+}
+
+// determineImportChanges provides the changes to the Git metadata that make it contain the given configuration file content.
+// Aliases go into the global Git metadata because Git Town only looks for them there.
+func determineImportChanges(fileConfig configdomain.PartialConfig, existing config.NormalConfig) gitConfigChanges {
+	result := gitConfigChanges{}
+	oldValues := gitConfigValues(existing.LocalGitConfig)
+	newValues := gitConfigValues(fileConfig)
+	keys := maps.Keys(newValues)
+	slices.Sort(keys)
+	for _, key := range keys {
+		newValue := newValues[key]
+		oldValue, hasOldValue := oldValues[key]
+		if hasOldValue && oldValue == newValue {
+			continue
+		}
+		result = append(result, gitConfigChange{
+			key:      key,
+			newValue: newValue,
+			oldValue: NewOption(oldValue),
+			scope:    configdomain.ConfigScopeLocal,
+		})
+	}
+	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
+		newValue, hasNewValue := fileConfig.Aliases[aliasableCommand]
+		if !hasNewValue {
+			continue
+		}
+		oldValue, hasOldValue := existing.GlobalGitConfig.Aliases[aliasableCommand]
+		if hasOldValue && oldValue == newValue {
+			continue
+		}
+		result = append(result, gitConfigChange{
+			key:      aliasableCommand.Key().Key(),
+			newValue: newValue,
+			oldValue: NewOption(oldValue),
+			scope:    configdomain.ConfigScopeGlobal,
+		})
+	}
+	return result
+}
+
+// gitConfigValues provides the Git configuration entries for the settings in the given PartialConfig.
+func gitConfigValues(partialConfig configdomain.PartialConfig) map[configdomain.Key]string {
+	result := map[configdomain.Key]string{}
+	for branch, syncStrategy := range partialConfig.BranchSyncStrategies {
+		result[configdomain.NewSyncStrategyKey(branch)] = syncStrategy.String()
+	}
+	addBranchNames(result, configdomain.KeyContributionBranches, partialConfig.ContributionBranches)
+	addValue(result, configdomain.KeyContributionRegex, partialConfig.ContributionRegex)
+	addValue(result, configdomain.KeyDefaultBranchType, partialConfig.DefaultBranchType)
+	addValue(result, configdomain.KeyDevRemote, partialConfig.DevRemote)
+	addValue(result, configdomain.KeyFeatureRegex, partialConfig.FeatureRegex)
+	addValue(result, configdomain.KeyHookAfterCreateBranch, partialConfig.HookAfterCreateBranch)
+	addValue(result, configdomain.KeyHookAfterShip, partialConfig.HookAfterShip)
+	addValue(result, configdomain.KeyHookBeforeSyncBranch, partialConfig.HookBeforeSyncBranch)
+	addValue(result, configdomain.KeyHostingOriginHostname, partialConfig.HostingOriginHostname)
+	addValue(result, configdomain.KeyHostingPlatform, partialConfig.HostingPlatform)
+	addValue(result, configdomain.KeyInferParents, partialConfig.InferParents)
+	addValue(result, configdomain.KeyMainBranch, partialConfig.MainBranch)
+	addValue(result, configdomain.KeyNewBranchType, partialConfig.NewBranchType)
+	addBranchNames(result, configdomain.KeyObservedBranches, partialConfig.ObservedBranches)
+	addValue(result, configdomain.KeyObservedRegex, partialConfig.ObservedRegex)
+	addBranchNames(result, configdomain.KeyParkedBranches, partialConfig.ParkedBranches)
+	addBranchNames(result, configdomain.KeyPerennialBranches, partialConfig.PerennialBranches)
+	addValue(result, configdomain.KeyPerennialRegex, partialConfig.PerennialRegex)
+	addBranchNames(result, configdomain.KeyPrototypeBranches, partialConfig.PrototypeBranches)
+	addValue(result, configdomain.KeyPushHook, partialConfig.PushHook)
+	addValue(result, configdomain.KeyPushNewBranches, partialConfig.PushNewBranches)
+	addValue(result, configdomain.KeyShipDeleteTrackingBranch, partialConfig.ShipDeleteTrackingBranch)
+	addValue(result, configdomain.KeyShipSquashCommitTemplate, partialConfig.ShipSquashCommitTemplate)
+	addValue(result, configdomain.KeyShipStrategy, partialConfig.ShipStrategy)
+	addValue(result, configdomain.KeySyncFeatureStrategy, partialConfig.SyncFeatureStrategy)
+	addValue(result, configdomain.KeySyncMetadata, partialConfig.SyncMetadata)
+	addValue(result, configdomain.KeySyncPerennialStrategy, partialConfig.SyncPerennialStrategy)
+	addValue(result, configdomain.KeySyncPrototypeStrategy, partialConfig.SyncPrototypeStrategy)
+	addValue(result, configdomain.KeySyncTags, partialConfig.SyncTags)
+	addValue(result, configdomain.KeySyncUpstream, partialConfig.SyncUpstream)
+	return result
+}
+
+func addBranchNames(result map[configdomain.Key]string, key configdomain.Key, branches gitdomain.LocalBranchNames) {
+	if len(branches) > 0 {
+		result[key] = branches.Join(" ")
+	}
+}
+
+func addValue[T fmt.Stringer](result map[configdomain.Key]string, key configdomain.Key, value Option[T]) {
+	if value, has := value.Get(); has {
+		result[key] = value.String()
+	}
+}
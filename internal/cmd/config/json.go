@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// configJSON is the machine-readable version of the output of "git town config".
+type configJSON struct {
+	BranchSyncStrategies []branchSyncStrategyJSON `json:"branch-sync-strategies,omitempty"`
+	Lineage              []lineageEntryJSON       `json:"lineage"`
+	Settings             map[string]settingJSON   `json:"settings"` // key is the name of the setting without the "git-town." prefix
+}
+
+// branchSyncStrategyJSON describes the sync strategy of an individual branch or of all branches matching a pattern.
+type branchSyncStrategyJSON struct {
+	Branch       string                    `json:"branch"`
+	Source       configdomain.ConfigSource `json:"source"`
+	SyncStrategy string                    `json:"sync-strategy"`
+}
+
+type lineageEntryJSON struct {
+	Branch string                    `json:"branch"`
+	Parent string                    `json:"parent"`
+	Source configdomain.ConfigSource `json:"source"`
+}
+
+type settingJSON struct {
+	Source configdomain.ConfigSource `json:"source"`
+	Value  any                       `json:"value"`
+}
+
+func newConfigJSON(unvalidatedConfig config.UnvalidatedConfig) configJSON {
+	normal := unvalidatedConfig.NormalConfig
+	settings := map[string]settingJSON{}
+	add := func(key configdomain.Key, value any, isSet func(configdomain.PartialConfig) bool) {
+		settings[strings.TrimPrefix(key.String(), "git-town.")] = settingJSON{
+			Source: configdomain.DetermineConfigSource(normal.ConfigFile, normal.GlobalGitConfig, normal.LocalGitConfig, isSet),
+			Value:  value,
+		}
+	}
+	add(configdomain.KeyAzureDevOpsToken, optionalString(normal.AzureDevOpsToken), func(c configdomain.PartialConfig) bool { return c.AzureDevOpsToken.IsSome() })
+	add(configdomain.KeyContributionBranches, normal.ContributionBranches.Strings(), func(c configdomain.PartialConfig) bool { return len(c.ContributionBranches) > 0 })
+	add(configdomain.KeyContributionRegex, optionalString(normal.ContributionRegex), func(c configdomain.PartialConfig) bool { return c.ContributionRegex.IsSome() })
+	add(configdomain.KeyDefaultBranchType, normal.DefaultBranchType.String(), func(c configdomain.PartialConfig) bool { return c.DefaultBranchType.IsSome() })
+	add(configdomain.KeyDevRemote, normal.DevRemote.String(), func(c configdomain.PartialConfig) bool { return c.DevRemote.IsSome() })
+	add(configdomain.KeyFeatureRegex, optionalString(normal.FeatureRegex), func(c configdomain.PartialConfig) bool { return c.FeatureRegex.IsSome() })
+	add(configdomain.KeyGiteaToken, optionalString(normal.GiteaToken), func(c configdomain.PartialConfig) bool { return c.GiteaToken.IsSome() })
+	add(configdomain.KeyGithubToken, optionalString(normal.GitHubToken), func(c configdomain.PartialConfig) bool { return c.GitHubToken.IsSome() })
+	add(configdomain.KeyGitlabToken, optionalString(normal.GitLabToken), func(c configdomain.PartialConfig) bool { return c.GitLabToken.IsSome() })
+	add(configdomain.KeyHookAfterCreateBranch, optionalString(normal.HookAfterCreateBranch), func(c configdomain.PartialConfig) bool { return c.HookAfterCreateBranch.IsSome() })
+	add(configdomain.KeyHookAfterShip, optionalString(normal.HookAfterShip), func(c configdomain.PartialConfig) bool { return c.HookAfterShip.IsSome() })
+	add(configdomain.KeyHookBeforeSyncBranch, optionalString(normal.HookBeforeSyncBranch), func(c configdomain.PartialConfig) bool { return c.HookBeforeSyncBranch.IsSome() })
+	add(configdomain.KeyHostingOriginHostname, optionalString(normal.HostingOriginHostname), func(c configdomain.PartialConfig) bool { return c.HostingOriginHostname.IsSome() })
+	add(configdomain.KeyHostingPlatform, optionalString(normal.HostingPlatform), func(c configdomain.PartialConfig) bool { return c.HostingPlatform.IsSome() })
+	add(configdomain.KeyInferParents, normal.InferParents.IsTrue(), func(c configdomain.PartialConfig) bool { return c.InferParents.IsSome() })
+	add(configdomain.KeyMainBranch, optionalString(unvalidatedConfig.UnvalidatedConfig.MainBranch), func(c configdomain.PartialConfig) bool { return c.MainBranch.IsSome() })
+	add(configdomain.KeyNewBranchType, normal.NewBranchType.String(), func(c configdomain.PartialConfig) bool { return c.NewBranchType.IsSome() })
+	add(configdomain.KeyObservedBranches, normal.ObservedBranches.Strings(), func(c configdomain.PartialConfig) bool { return len(c.ObservedBranches) > 0 })
+	add(configdomain.KeyObservedRegex, optionalString(normal.ObservedRegex), func(c configdomain.PartialConfig) bool { return c.ObservedRegex.IsSome() })
+	add(configdomain.KeyOffline, normal.Offline.IsTrue(), func(c configdomain.PartialConfig) bool { return c.Offline.IsSome() })
+	add(configdomain.KeyParkedBranches, normal.ParkedBranches.Strings(), func(c configdomain.PartialConfig) bool { return len(c.ParkedBranches) > 0 })
+	add(configdomain.KeyPerennialBranches, normal.PerennialBranches.Strings(), func(c configdomain.PartialConfig) bool { return len(c.PerennialBranches) > 0 })
+	add(configdomain.KeyPerennialRegex, optionalString(normal.PerennialRegex), func(c configdomain.PartialConfig) bool { return c.PerennialRegex.IsSome() })
+	add(configdomain.KeyPrototypeBranches, normal.PrototypeBranches.Strings(), func(c configdomain.PartialConfig) bool { return len(c.PrototypeBranches) > 0 })
+	add(configdomain.KeyPushHook, bool(normal.PushHook), func(c configdomain.PartialConfig) bool { return c.PushHook.IsSome() })
+	add(configdomain.KeyPushNewBranches, normal.ShouldPushNewBranches(), func(c configdomain.PartialConfig) bool { return c.PushNewBranches.IsSome() })
+	add(configdomain.KeyShipDeleteTrackingBranch, normal.ShipDeleteTrackingBranch.IsTrue(), func(c configdomain.PartialConfig) bool { return c.ShipDeleteTrackingBranch.IsSome() })
+	add(configdomain.KeyShipSquashCommitTemplate, optionalString(normal.ShipSquashCommitTemplate), func(c configdomain.PartialConfig) bool { return c.ShipSquashCommitTemplate.IsSome() })
+	add(configdomain.KeyShipStrategy, normal.ShipStrategy.String(), func(c configdomain.PartialConfig) bool { return c.ShipStrategy.IsSome() })
+	add(configdomain.KeySyncFeatureStrategy, normal.SyncFeatureStrategy.String(), func(c configdomain.PartialConfig) bool { return c.SyncFeatureStrategy.IsSome() })
+	add(configdomain.KeySyncMetadata, normal.SyncMetadata.IsTrue(), func(c configdomain.PartialConfig) bool { return c.SyncMetadata.IsSome() })
+	add(configdomain.KeySyncPerennialStrategy, normal.SyncPerennialStrategy.String(), func(c configdomain.PartialConfig) bool { return c.SyncPerennialStrategy.IsSome() })
+	add(configdomain.KeySyncPrototypeStrategy, normal.SyncPrototypeStrategy.String(), func(c configdomain.PartialConfig) bool { return c.SyncPrototypeStrategy.IsSome() })
+	add(configdomain.KeySyncTags, normal.SyncTags.IsTrue(), func(c configdomain.PartialConfig) bool { return c.SyncTags.IsSome() })
+	add(configdomain.KeySyncUpstream, normal.SyncUpstream.IsTrue(), func(c configdomain.PartialConfig) bool { return c.SyncUpstream.IsSome() })
+	lineage := []lineageEntryJSON{}
+	for _, entry := range normal.Lineage.Entries() {
+		lineage = append(lineage, lineageEntryJSON{
+			Branch: entry.Child.String(),
+			Parent: entry.Parent.String(),
+			Source: configdomain.DetermineConfigSource(normal.ConfigFile, normal.GlobalGitConfig, normal.LocalGitConfig, func(c configdomain.PartialConfig) bool {
+				return c.Lineage.Parent(entry.Child).IsSome()
+			}),
+		})
+	}
+	branchSyncStrategies := []branchSyncStrategyJSON{}
+	for _, branch := range normal.BranchSyncStrategies.Branches() {
+		branchSyncStrategies = append(branchSyncStrategies, branchSyncStrategyJSON{
+			Branch: branch.String(),
+			Source: configdomain.DetermineConfigSource(normal.ConfigFile, normal.GlobalGitConfig, normal.LocalGitConfig, func(c configdomain.PartialConfig) bool {
+				_, has := c.BranchSyncStrategies[branch]
+				return has
+			}),
+			SyncStrategy: normal.BranchSyncStrategies[branch].String(),
+		})
+	}
+	for _, pattern := range normal.BranchSyncStrategyPatterns {
+		branchSyncStrategies = append(branchSyncStrategies, branchSyncStrategyJSON{
+			Branch:       pattern.Pattern,
+			Source:       configdomain.ConfigSourceConfigFile,
+			SyncStrategy: pattern.SyncStrategy.String(),
+		})
+	}
+	return configJSON{
+		BranchSyncStrategies: branchSyncStrategies,
+		Lineage:              lineage,
+		Settings:             settings,
+	}
+}
+
+// optionalString provides the string representation of the given optional value, or nil if it doesn't exist.
+func optionalString[T fmt.Stringer](option Option[T]) any {
+	if value, has := option.Get(); has {
+		return value.String()
+	}
+	return nil
+}
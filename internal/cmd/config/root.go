@@ -17,6 +17,7 @@ import (
 const configDesc = "Display your Git Town configuration"
 
 func RootCmd() *cobra.Command {
+	addFormatFlag, readFormatFlag := flags.Format()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	configCmd := cobra.Command{
 		Use:     "config",
@@ -25,32 +26,44 @@ func RootCmd() *cobra.Command {
 		Short:   configDesc,
 		Long:    cmdhelpers.Long(configDesc),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat, err := readFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
 			verbose, err := readVerboseFlag(cmd)
 			if err != nil {
 				return err
 			}
-			return executeDisplayConfig(verbose)
+			return executeDisplayConfig(outputFormat, verbose)
 		},
 	}
+	addFormatFlag(&configCmd)
 	addVerboseFlag(&configCmd)
+	configCmd.AddCommand(explainConfigCommand())
+	configCmd.AddCommand(exportConfigCommand())
 	configCmd.AddCommand(getParentCommand())
+	configCmd.AddCommand(importConfigCommand())
 	configCmd.AddCommand(removeConfigCommand())
 	configCmd.AddCommand(SetupCommand())
 	return &configCmd
 }
 
-func executeDisplayConfig(verbose configdomain.Verbose) error {
+func executeDisplayConfig(outputFormat configdomain.OutputFormat, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: false,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
 	}
+	if outputFormat == configdomain.OutputFormatJSON {
+		return print.JSON(newConfigJSON(repo.UnvalidatedConfig))
+	}
 	printConfig(repo.UnvalidatedConfig)
 	return nil
 }
@@ -62,6 +75,7 @@ func printConfig(config config.UnvalidatedConfig) {
 	print.Entry("contribution regex", format.OptionalStringerSetting((config.NormalConfig.ContributionRegex)))
 	print.Entry("default branch type", config.NormalConfig.DefaultBranchType.String())
 	print.Entry("feature regex", format.OptionalStringerSetting(config.NormalConfig.FeatureRegex))
+	print.Entry("infer parents", format.Bool(config.NormalConfig.InferParents.IsTrue()))
 	print.Entry("main branch", format.OptionalStringerSetting(config.UnvalidatedConfig.MainBranch))
 	print.Entry("observed branches", format.StringsSetting((config.NormalConfig.ObservedBranches.Join(", "))))
 	print.Entry("observed regex", format.OptionalStringerSetting((config.NormalConfig.ObservedRegex)))
@@ -76,15 +90,22 @@ func printConfig(config config.UnvalidatedConfig) {
 	print.Entry("new branch type", format.StringsSetting(config.NormalConfig.NewBranchType.String()))
 	print.Entry("push new branches", format.Bool(config.NormalConfig.ShouldPushNewBranches()))
 	fmt.Println()
+	print.Header("Hooks")
+	print.Entry("after create branch", format.OptionalStringerSetting(config.NormalConfig.HookAfterCreateBranch))
+	print.Entry("after ship", format.OptionalStringerSetting(config.NormalConfig.HookAfterShip))
+	print.Entry("before sync branch", format.OptionalStringerSetting(config.NormalConfig.HookBeforeSyncBranch))
+	fmt.Println()
 	print.Header("Hosting")
 	print.Entry("hosting platform", format.OptionalStringerSetting(config.NormalConfig.HostingPlatform))
 	print.Entry("hostname", format.OptionalStringerSetting(config.NormalConfig.HostingOriginHostname))
 	print.Entry("GitHub token", format.OptionalStringerSetting(config.NormalConfig.GitHubToken))
 	print.Entry("GitLab token", format.OptionalStringerSetting(config.NormalConfig.GitLabToken))
 	print.Entry("Gitea token", format.OptionalStringerSetting(config.NormalConfig.GiteaToken))
+	print.Entry("Azure DevOps token", format.OptionalStringerSetting(config.NormalConfig.AzureDevOpsToken))
 	fmt.Println()
 	print.Header("Ship")
 	print.Entry("delete the tracking branch", format.Bool(config.NormalConfig.ShipDeleteTrackingBranch.IsTrue()))
+	print.Entry("squash commit template", format.OptionalStringerSetting(config.NormalConfig.ShipSquashCommitTemplate))
 	print.Entry("strategy", config.NormalConfig.ShipStrategy.String())
 	fmt.Println()
 	print.Header("Sync")
@@ -92,9 +113,20 @@ func printConfig(config config.UnvalidatedConfig) {
 	print.Entry("sync-feature strategy", config.NormalConfig.SyncFeatureStrategy.String())
 	print.Entry("sync-perennial strategy", config.NormalConfig.SyncPerennialStrategy.String())
 	print.Entry("sync-prototype strategy", config.NormalConfig.SyncPrototypeStrategy.String())
+	print.Entry("sync metadata", format.Bool(config.NormalConfig.SyncMetadata.IsTrue()))
 	print.Entry("sync tags", format.Bool(config.NormalConfig.SyncTags.IsTrue()))
 	print.Entry("sync with upstream", format.Bool(config.NormalConfig.SyncUpstream.IsTrue()))
 	fmt.Println()
+	if len(config.NormalConfig.BranchSyncStrategies) > 0 || len(config.NormalConfig.BranchSyncStrategyPatterns) > 0 {
+		print.Header("Branch Sync Strategies")
+		for _, branch := range config.NormalConfig.BranchSyncStrategies.Branches() {
+			print.Entry(branch.String(), config.NormalConfig.BranchSyncStrategies[branch].String())
+		}
+		for _, pattern := range config.NormalConfig.BranchSyncStrategyPatterns {
+			print.Entry(pattern.Pattern, pattern.SyncStrategy.String())
+		}
+		fmt.Println()
+	}
 	if config.NormalConfig.Lineage.Len() > 0 {
 		print.LabelAndValue("Branch Lineage", format.BranchLineage(config.NormalConfig.Lineage))
 	}
@@ -11,7 +11,6 @@ import (
 	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/config/configfile"
-	"github.com/git-town/git-town/v17/internal/config/gitconfig"
 	"github.com/git-town/git-town/v17/internal/execute"
 	"github.com/git-town/git-town/v17/internal/git"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
@@ -42,22 +41,24 @@ func SetupCommand() *cobra.Command {
 	return &cmd
 }
 
-// the config settings to be used if the user accepts all default options
-func defaultUserInput(gitAccess gitconfig.Access, gitVersion git.Version) userInput {
+// the config settings to be used if the user accepts all default options,
+// starts with the existing configuration so that the settings that the setup assistant doesn't ask for remain intact
+func defaultUserInput(existing config.UnvalidatedConfig) userInput {
 	return userInput{
-		config:        config.DefaultUnvalidatedConfig(gitAccess, gitVersion),
+		config:        existing,
 		configStorage: dialog.ConfigStorageOptionFile,
 	}
 }
 
 func executeConfigSetup(verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: false,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -159,6 +160,11 @@ func enterData(config config.UnvalidatedConfig, gitCommands git.Commands, backen
 	}
 	if platform, has := determineHostingPlatform(config, data.userInput.config.NormalConfig.HostingPlatform).Get(); has {
 		switch platform {
+		case configdomain.HostingPlatformAzureDevOps:
+			data.userInput.config.NormalConfig.AzureDevOpsToken, aborted, err = dialog.AzureDevOpsToken(config.NormalConfig.AzureDevOpsToken, data.dialogInputs.Next())
+			if err != nil || aborted {
+				return aborted, err
+			}
 		case configdomain.HostingPlatformBitbucket, configdomain.HostingPlatformBitbucketDatacenter:
 			data.userInput.config.NormalConfig.BitbucketUsername, aborted, err = dialog.BitbucketUsername(config.NormalConfig.BitbucketUsername, data.dialogInputs.Next())
 			if err != nil || aborted {
@@ -272,7 +278,7 @@ func loadSetupData(repo execute.OpenRepoResult, verbose configdomain.Verbose) (d
 		hasConfigFile: repo.UnvalidatedConfig.NormalConfig.ConfigFile.IsSome(),
 		localBranches: branchesSnapshot.Branches,
 		remotes:       remotes,
-		userInput:     defaultUserInput(repo.UnvalidatedConfig.NormalConfig.GitConfigAccess, repo.UnvalidatedConfig.NormalConfig.GitVersion),
+		userInput:     defaultUserInput(repo.UnvalidatedConfig),
 	}, exit, nil
 }
 
@@ -281,6 +287,10 @@ func saveAll(userInput userInput, oldConfig config.UnvalidatedConfig, gitCommand
 	if err != nil {
 		return err
 	}
+	err = saveAzureDevOpsToken(oldConfig.NormalConfig.AzureDevOpsToken, userInput.config.NormalConfig.AzureDevOpsToken, gitCommands, frontend)
+	if err != nil {
+		return err
+	}
 	err = saveBitbucketUsername(oldConfig.NormalConfig.BitbucketUsername, userInput.config.NormalConfig.BitbucketUsername, gitCommands, frontend)
 	if err != nil {
 		return err
@@ -350,6 +360,16 @@ func saveAliases(oldAliases, newAliases configdomain.Aliases, gitCommands git.Co
 	return nil
 }
 
+func saveAzureDevOpsToken(oldToken, newToken Option[configdomain.AzureDevOpsToken], gitCommands git.Commands, frontend gitdomain.Runner) error {
+	if newToken == oldToken {
+		return nil
+	}
+	if value, has := newToken.Get(); has {
+		return gitCommands.SetAzureDevOpsToken(frontend, value)
+	}
+	return gitCommands.RemoveAzureDevOpsToken(frontend)
+}
+
 func saveBitbucketAppPassword(oldPassword, newPassword Option[configdomain.BitbucketAppPassword], gitCommands git.Commands, frontend gitdomain.Runner) error {
 	if newPassword == oldPassword {
 		return nil
@@ -550,24 +570,43 @@ func saveToFile(userInput userInput, config config.UnvalidatedConfig) error {
 	if err != nil {
 		return err
 	}
+	removeSettingsInConfigFile(config)
+	return nil
+}
+
+// removeSettingsInConfigFile removes the settings that the configuration file contains from the local Git metadata,
+// so that the local Git metadata doesn't override them.
+func removeSettingsInConfigFile(config config.UnvalidatedConfig) {
+	config.NormalConfig.RemoveBranchSyncStrategies()
+	config.NormalConfig.RemoveContributionBranches()
+	config.NormalConfig.RemoveContributionRegex()
 	config.NormalConfig.RemoveCreatePrototypeBranches()
+	config.NormalConfig.RemoveDefaultBranchType()
 	config.NormalConfig.RemoveDevRemote()
+	config.NormalConfig.RemoveFeatureRegex()
+	config.NormalConfig.RemoveHookAfterCreateBranch()
+	config.NormalConfig.RemoveHookAfterShip()
+	config.NormalConfig.RemoveHookBeforeSyncBranch()
+	config.NormalConfig.RemoveHostingOriginHostname()
+	config.NormalConfig.RemoveHostingPlatform()
+	config.NormalConfig.RemoveInferParents()
 	config.RemoveMainBranch()
 	config.NormalConfig.RemoveNewBranchType()
+	config.NormalConfig.RemoveObservedBranches()
+	config.NormalConfig.RemoveObservedRegex()
+	config.NormalConfig.RemoveParkedBranches()
 	config.NormalConfig.RemovePerennialBranches()
 	config.NormalConfig.RemovePerennialRegex()
-	config.NormalConfig.RemovePushNewBranches()
+	config.NormalConfig.RemovePrototypeBranches()
 	config.NormalConfig.RemovePushHook()
-	config.NormalConfig.RemoveShipStrategy()
+	config.NormalConfig.RemovePushNewBranches()
 	config.NormalConfig.RemoveShipDeleteTrackingBranch()
+	config.NormalConfig.RemoveShipSquashCommitTemplate()
+	config.NormalConfig.RemoveShipStrategy()
 	config.NormalConfig.RemoveSyncFeatureStrategy()
+	config.NormalConfig.RemoveSyncMetadata()
 	config.NormalConfig.RemoveSyncPerennialStrategy()
 	config.NormalConfig.RemoveSyncPrototypeStrategy()
-	config.NormalConfig.RemoveSyncUpstream()
 	config.NormalConfig.RemoveSyncTags()
-	err = saveDefaultBranchType(config.NormalConfig.DefaultBranchType, userInput.config.NormalConfig.DefaultBranchType, config)
-	if err != nil {
-		return err
-	}
-	return saveFeatureRegex(config.NormalConfig.FeatureRegex, userInput.config.NormalConfig.FeatureRegex, config)
+	config.NormalConfig.RemoveSyncUpstream()
 }
@@ -20,6 +20,7 @@ import (
 	fullInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/full"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
 	"github.com/git-town/git-town/v17/internal/vm/statefile"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 	"github.com/spf13/cobra"
@@ -49,12 +50,13 @@ func continueCmd() *cobra.Command {
 
 func executeContinue(verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -177,6 +179,20 @@ func determineContinueRunstate(repo execute.OpenRepoResult) (runstate.RunState,
 		fmt.Println(messages.ContinueNothingToDo)
 		return runstate.EmptyRunState(), true, nil
 	}
+	if worktree, inWorktree := runState.Worktree.Get(); inWorktree {
+		// the unfinished command stopped in another worktree, so the conflicts to resolve are there
+		worktreeBackend, _, err := shared.RunnersInWorktree(repo.Backend, repo.Frontend, Some(worktree))
+		if err != nil {
+			return runstate.EmptyRunState(), true, err
+		}
+		worktreeStatus, err := repo.Git.RepoStatus(worktreeBackend)
+		if err != nil {
+			return runstate.EmptyRunState(), true, err
+		}
+		if worktreeStatus.Conflicts {
+			return runstate.EmptyRunState(), true, errors.New(messages.ContinueUnresolvedConflicts)
+		}
+	}
 	runState.AbortProgram = program.Program{}
 	return runState, false, nil
 }
@@ -12,6 +12,7 @@ import (
 // Execute runs the Cobra stack.
 func Execute() error {
 	rootCmd := rootCmd()
+	rootCmd.AddCommand(absorbCommand())
 	rootCmd.AddCommand(appendCmd())
 	rootCmd.AddCommand(branchCmd())
 	rootCmd.AddCommand(completionsCmd(&rootCmd))
@@ -21,10 +22,13 @@ func Execute() error {
 	rootCmd.AddCommand(contributeCmd())
 	rootCmd.AddCommand(debug.RootCmd())
 	rootCmd.AddCommand(diffParentCommand())
+	rootCmd.AddCommand(doctorCommand())
 	rootCmd.AddCommand(hackCmd())
+	rootCmd.AddCommand(historyCommand())
 	rootCmd.AddCommand(deleteCommand())
 	rootCmd.AddCommand(killCommand())
 	rootCmd.AddCommand(mergeCommand())
+	rootCmd.AddCommand(moveCommitCommand())
 	rootCmd.AddCommand(newPullRequestCommand())
 	rootCmd.AddCommand(observeCmd())
 	rootCmd.AddCommand(offlineCmd())
@@ -39,6 +43,8 @@ func Execute() error {
 	rootCmd.AddCommand(setParentCommand())
 	rootCmd.AddCommand(ship.Cmd())
 	rootCmd.AddCommand(skipCmd())
+	rootCmd.AddCommand(splitCommand())
+	rootCmd.AddCommand(stackCommand())
 	rootCmd.AddCommand(switchCmd())
 	rootCmd.AddCommand(sync.Cmd())
 	rootCmd.AddCommand(undoCmd())
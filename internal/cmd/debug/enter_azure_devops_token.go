@@ -0,0 +1,22 @@
+package debug
+
+import (
+	"os"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+func enterAzureDevOpsToken() *cobra.Command {
+	return &cobra.Command{
+		Use: "azure-devops-token",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			dialogInputs := components.LoadTestInputs(os.Environ())
+			_, _, err := dialog.AzureDevOpsToken(None[configdomain.AzureDevOpsToken](), dialogInputs.Next())
+			return err
+		},
+	}
+}
@@ -12,6 +12,7 @@ func RootCmd() *cobra.Command {
 		Hidden: true,
 	}
 	debugCommand.AddCommand(enterAliases())
+	debugCommand.AddCommand(enterAzureDevOpsToken())
 	debugCommand.AddCommand(enterBitbucketAppPassword())
 	debugCommand.AddCommand(enterBitbucketUsername())
 	debugCommand.AddCommand(enterNewBranchType())
@@ -62,12 +62,13 @@ func deleteCommand() *cobra.Command {
 
 func executeDelete(args []string, dryRun configdomain.DryRun, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -202,12 +203,16 @@ func determineDeleteData(args []string, repo execute.OpenRepoResult, dryRun conf
 		mainBranch:         validatedConfig.ValidatedConfigData.MainBranch,
 		previousBranch:     previousBranchOpt,
 	})
+	proposalFinder, err := hosting.NewProposalFinder(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, connector, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
 	proposalsOfChildBranches := ship.LoadProposalsOfChildBranches(ship.LoadProposalsOfChildBranchesArgs{
-		ConnectorOpt:               connector,
 		Lineage:                    validatedConfig.NormalConfig.Lineage,
 		Offline:                    repo.IsOffline,
 		OldBranch:                  branchNameToDelete,
 		OldBranchHasTrackingBranch: branchToDelete.HasTrackingBranch(),
+		ProposalFinder:             proposalFinder,
 	})
 	lineageBranches := validatedConfig.NormalConfig.Lineage.BranchNames()
 	_, nonExistingBranches := branchesSnapshot.Branches.Select(repo.UnvalidatedConfig.NormalConfig.DevRemote, lineageBranches...)
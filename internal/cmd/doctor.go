@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	configInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/config"
+	"github.com/git-town/git-town/v17/internal/vm/statefile"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
+)
+
+const doctorDesc = "Finds and fixes problems with the Git Town setup of this repository"
+
+const doctorHelp = `
+Checks this repository for:
+- lineage entries of branches that don't exist
+- branches in the lists of contribution, observed, parked, perennial, and prototype branches that don't exist
+- perennial branches that are also observed branches
+- runstate files that Git Town cannot load, for example because an older Git Town version created them
+
+Asks for confirmation before fixing these problems.
+The --fix flag fixes them without asking.
+"git town undo" reverts the changes to the Git metadata.`
+
+func doctorCommand() *cobra.Command {
+	addFixFlag, readFixFlag := flags.Fix("fix all problems without asking")
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "doctor",
+		Args:    cobra.NoArgs,
+		GroupID: "errors",
+		Short:   doctorDesc,
+		Long:    cmdhelpers.Long(doctorDesc, doctorHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fix, err := readFixFlag(cmd)
+			if err != nil {
+				return err
+			}
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeDoctor(fix, verbose)
+		},
+	}
+	addFixFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeDoctor(fix bool, verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       false,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return err
+	}
+	unloadableRunstateFiles, err := statefile.UnloadableFiles()
+	if err != nil {
+		return err
+	}
+	diagnosis := diagnose(repo.UnvalidatedConfig.NormalConfig, branchesSnapshot.Branches, unloadableRunstateFiles)
+	if len(diagnosis.problems) == 0 {
+		fmt.Println(messages.DoctorNoProblems)
+		return nil
+	}
+	print.Header("Problems")
+	for _, problem := range diagnosis.problems {
+		fmt.Println("  " + problem)
+	}
+	fmt.Println()
+	if !fix {
+		apply, aborted, err := dialog.ConfigChanges(components.LoadTestInputs(os.Environ()).Next())
+		if err != nil || aborted || !apply {
+			return err
+		}
+	}
+	err = diagnosis.fix(repo.UnvalidatedConfig.NormalConfig)
+	if err != nil {
+		return err
+	}
+	fmt.Println(messages.DoctorFixed)
+	return configInterpreter.Finished(configInterpreter.FinishedArgs{
+		Backend:               repo.Backend,
+		BeginBranchesSnapshot: None[gitdomain.BranchesSnapshot](),
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		Command:               "doctor",
+		CommandsCounter:       repo.CommandsCounter,
+		FinalMessages:         repo.FinalMessages,
+		Git:                   repo.Git,
+		RootDir:               repo.RootDir,
+		TouchedBranches:       []gitdomain.BranchName{},
+		Verbose:               verbose,
+	})
+}
+
+// doctorDiagnosis contains the problems that "git town doctor" found and the changes that fix them.
+type doctorDiagnosis struct {
+	branchLists   map[configdomain.Key]gitdomain.LocalBranchNames // the fixed versions of the local branch type lists that contain problems
+	problems      []string                                        // human-readable descriptions of the problems
+	runstateFiles []string                                        // the runstate files to delete
+	staleParents  gitdomain.LocalBranchNames                      // the branches whose lineage entries to remove
+}
+
+// fix applies the changes that fix the problems in this diagnosis.
+func (self doctorDiagnosis) fix(normalConfig config.NormalConfig) error {
+	for _, branch := range self.staleParents {
+		normalConfig.RemoveParent(branch)
+	}
+	keys := maps.Keys(self.branchLists)
+	slices.Sort(keys)
+	for _, key := range keys {
+		branches := self.branchLists[key]
+		if len(branches) == 0 {
+			_ = normalConfig.GitConfigAccess.RemoveLocalConfigValue(key)
+			continue
+		}
+		err := normalConfig.GitConfigAccess.SetConfigValue(configdomain.ConfigScopeLocal, key, branches.Join(" "))
+		if err != nil {
+			return err
+		}
+	}
+	for _, runstateFile := range self.runstateFiles {
+		err := os.Remove(runstateFile)
+		if err != nil {
+			return fmt.Errorf(messages.FileDeleteProblem, runstateFile, err)
+		}
+	}
+	return nil
+}
+
+// diagnose finds the problems in the Git Town setup of the current repository.
+// It only considers the local Git metadata because that's what this command can fix.
+func diagnose(normalConfig config.NormalConfig, branches gitdomain.BranchInfos, unloadableRunstateFiles []string) doctorDiagnosis {
+	result := doctorDiagnosis{
+		branchLists:   map[configdomain.Key]gitdomain.LocalBranchNames{},
+		problems:      []string{},
+		runstateFiles: unloadableRunstateFiles,
+		staleParents:  gitdomain.LocalBranchNames{},
+	}
+	for _, entry := range normalConfig.LocalGitConfig.Lineage.Entries() {
+		if !branches.HasBranch(entry.Child) {
+			result.problems = append(result.problems, fmt.Sprintf(messages.DoctorLineageStale, entry.Child))
+			result.staleParents = append(result.staleParents, entry.Child)
+		}
+	}
+	checkBranchList := func(key configdomain.Key, list gitdomain.LocalBranchNames) {
+		fixed := gitdomain.LocalBranchNames{}
+		for _, branch := range list {
+			if branches.HasBranch(branch) {
+				fixed = append(fixed, branch)
+			} else {
+				result.problems = append(result.problems, fmt.Sprintf(messages.DoctorBranchListMissingBranch, key, branch))
+			}
+		}
+		if len(fixed) != len(list) {
+			result.branchLists[key] = fixed
+		}
+	}
+	checkBranchList(configdomain.KeyContributionBranches, normalConfig.LocalGitConfig.ContributionBranches)
+	checkBranchList(configdomain.KeyObservedBranches, normalConfig.LocalGitConfig.ObservedBranches)
+	checkBranchList(configdomain.KeyParkedBranches, normalConfig.LocalGitConfig.ParkedBranches)
+	checkBranchList(configdomain.KeyPerennialBranches, normalConfig.LocalGitConfig.PerennialBranches)
+	checkBranchList(configdomain.KeyPrototypeBranches, normalConfig.LocalGitConfig.PrototypeBranches)
+	observedBranches, hasFixedObservedBranches := result.branchLists[configdomain.KeyObservedBranches]
+	if !hasFixedObservedBranches {
+		observedBranches = normalConfig.LocalGitConfig.ObservedBranches
+	}
+	observedNonPerennials := gitdomain.LocalBranchNames{}
+	for _, branch := range observedBranches {
+		if normalConfig.PerennialBranches.Contains(branch) {
+			result.problems = append(result.problems, fmt.Sprintf(messages.DoctorPerennialAndObserved, branch))
+		} else {
+			observedNonPerennials = append(observedNonPerennials, branch)
+		}
+	}
+	if len(observedNonPerennials) != len(observedBranches) {
+		result.branchLists[configdomain.KeyObservedBranches] = observedNonPerennials
+	}
+	for _, runstateFile := range unloadableRunstateFiles {
+		result.problems = append(result.problems, fmt.Sprintf(messages.DoctorRunstateUnloadable, runstateFile))
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/git-town/git-town/v17/internal/vm/statefile"
+	"github.com/spf13/cobra"
+)
+
+const historyDesc = "Display the most recent Git Town commands"
+
+const historyHelp = `
+Lists the Git Town commands that have finished in this repository,
+the most recent command first.
+For each command, displays when it finished
+and which branches it touched.
+
+To undo several commands at once,
+run "git town undo --to <number>".
+`
+
+func historyCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "history",
+		GroupID: "errors",
+		Args:    cobra.NoArgs,
+		Short:   historyDesc,
+		Long:    cmdhelpers.Long(historyDesc, historyHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeHistory(verbose)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeHistory(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	journal, err := statefile.LoadJournal(repo.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalLoadProblem, err)
+	}
+	if len(journal) == 0 {
+		fmt.Println(messages.HistoryEmpty)
+	}
+	for e, entry := range journal.MostRecent(len(journal)) {
+		fmt.Println(HistoryLine(e+1, entry))
+	}
+	print.Footer(verbose, *repo.CommandsCounter.Value, print.NoFinalMessages)
+	return nil
+}
+
+// HistoryLine provides the line that the history command displays for the given journal entry.
+func HistoryLine(position int, entry runstate.JournalEntry) string {
+	result := strings.Builder{}
+	result.WriteString(strconv.Itoa(position))
+	result.WriteString("  ")
+	result.WriteString(entry.RunState.Command)
+	result.WriteString("  (")
+	result.WriteString(entry.Time.Format("2006-01-02 15:04:05"))
+	branches := gitdomain.LocalBranchNames{}
+	for _, branch := range entry.RunState.TouchedBranches {
+		branches = branches.AppendAllMissing(branch.LocalName())
+	}
+	branches.Sort()
+	if len(branches) > 0 {
+		result.WriteString(", ")
+		result.WriteString(branches.Join(", "))
+	}
+	result.WriteString(")")
+	return result.String()
+}
@@ -0,0 +1,40 @@
+package cmd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v17/internal/cmd"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestHistoryLine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with touched branches", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.EmptyRunState()
+		runState.Command = "sync"
+		runState.TouchedBranches = []gitdomain.BranchName{"main", "origin/main", "feature", "origin/feature"}
+		entry := runstate.JournalEntry{
+			RunState: runState,
+			Time:     time.Date(2024, 10, 17, 14, 3, 12, 0, time.UTC),
+		}
+		have := cmd.HistoryLine(2, entry)
+		must.EqOp(t, "2  sync  (2024-10-17 14:03:12, feature, main)", have)
+	})
+
+	t.Run("without touched branches", func(t *testing.T) {
+		t.Parallel()
+		runState := runstate.EmptyRunState()
+		runState.Command = "park"
+		entry := runstate.JournalEntry{
+			RunState: runState,
+			Time:     time.Date(2024, 10, 17, 14, 3, 12, 0, time.UTC),
+		}
+		have := cmd.HistoryLine(1, entry)
+		must.EqOp(t, "1  park  (2024-10-17 14:03:12)", have)
+	})
+}
@@ -65,12 +65,13 @@ func mergeCommand() *cobra.Command {
 
 func executeMerge(dryRun configdomain.DryRun, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -279,19 +280,23 @@ func mergeProgram(data mergeData, dryRun configdomain.DryRun) program.Program {
 	}
 	branchesToDelete := set.New[gitdomain.LocalBranchName]()
 	sync.BranchProgram(data.initialBranch, data.initialBranchInfo, data.initialBranchFirstCommitMessage, sync.BranchProgramArgs{
-		BranchInfos:         data.branchesSnapshot.Branches,
-		BranchesToDelete:    NewMutable(&branchesToDelete),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.prefetchBranchesSnapshot.Branches,
-		Program:             prog,
-		PushBranches:        configdomain.PushBranches(data.initialBranchInfo.HasTrackingBranch()),
-		Remotes:             data.remotes,
+		BranchInfos:              data.branchesSnapshot.Branches,
+		BranchesToDelete:         NewMutable(&branchesToDelete),
+		Config:                   data.config,
+		InitialBranch:            data.initialBranch,
+		PrefetchBranchInfos:      data.prefetchBranchesSnapshot.Branches,
+		Program:                  prog,
+		PushBranches:             configdomain.PushBranches(data.initialBranchInfo.HasTrackingBranch()),
+		Remotes:                  data.remotes,
+		ShippedBranches:          sync.ShippedBranches{},
+		Worktrees:                gitdomain.Worktrees{},
+		WorktreesWithOpenChanges: gitdomain.Worktrees{},
 	})
 	for _, branchToDelete := range branchesToDelete.Values() {
 		prog.Value.Add(
 			&opcodes.BranchLocalDelete{Branch: branchToDelete},
 			&opcodes.LineageBranchRemove{Branch: branchToDelete},
+			&opcodes.BranchSyncStrategyRemove{Branch: branchToDelete},
 		)
 	}
 	if connector, hasConnector := data.connector.Get(); hasConnector && data.offline.IsFalse() {
@@ -320,6 +325,9 @@ func mergeProgram(data mergeData, dryRun configdomain.DryRun) program.Program {
 	prog.Value.Add(&opcodes.LineageParentRemove{
 		Branch: data.parentBranch,
 	})
+	prog.Value.Add(&opcodes.BranchSyncStrategyRemove{
+		Branch: data.parentBranch,
+	})
 	prog.Value.Add(&opcodes.BranchLocalDelete{
 		Branch: data.parentBranch,
 	})
@@ -0,0 +1,330 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/cmd/sync"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v17/internal/validate"
+	fullInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/full"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/git-town/git-town/v17/pkg/set"
+	"github.com/spf13/cobra"
+)
+
+const moveCommitDesc = "Move commits of the current branch into an ancestor branch"
+
+const moveCommitHelp = `
+Lets you select commits of the current branch and an ancestor branch to move them into. Doesn't move commits into the main branch or perennial branches. Applies the selected commits to the ancestor branch, removes them from the current branch, and syncs the ancestor branch and all its descendants.
+
+Branches using the merge sync strategy get commits that revert the moved commits. Branches using the rebase or compress sync strategy drop the moved commits and get force-pushed.`
+
+func moveCommitCommand() *cobra.Command {
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "move-commit",
+		GroupID: "stack",
+		Args:    cobra.NoArgs,
+		Short:   moveCommitDesc,
+		Long:    cmdhelpers.Long(moveCommitDesc, moveCommitHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dryRun, err := readDryRunFlag(cmd)
+			if err != nil {
+				return err
+			}
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeMoveCommit(dryRun, verbose)
+		},
+	}
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeMoveCommit(dryRun configdomain.DryRun, verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineMoveCommitData(repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runProgram := moveCommitProgram(data, repo.FinalMessages)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               "move-commit",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               data.connector,
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type moveCommitData struct {
+	branchInfos         gitdomain.BranchInfos
+	branchesSnapshot    gitdomain.BranchesSnapshot
+	branchesToSync      []configdomain.BranchToSync
+	commitsToMove       gitdomain.Commits // the commits to move, oldest first
+	config              config.ValidatedConfig
+	connector           Option[hostingdomain.Connector]
+	dialogTestInputs    components.TestInputs
+	dryRun              configdomain.DryRun
+	hasOpenChanges      bool
+	initialBranch       gitdomain.LocalBranchName
+	initialBranchInfo   gitdomain.BranchInfo
+	nonExistingBranches gitdomain.LocalBranchNames // branches that are listed in the lineage information, but don't exist in the repo, neither locally nor remotely
+	preFetchBranchInfos gitdomain.BranchInfos
+	previousBranch      Option[gitdomain.LocalBranchName]
+	remotes             gitdomain.Remotes
+	stashSize           gitdomain.StashSize
+	targetBranch        gitdomain.LocalBranchName
+}
+
+func determineMoveCommitData(repo execute.OpenRepoResult, dryRun configdomain.DryRun, verbose configdomain.Verbose) (data moveCommitData, exit bool, err error) {
+	preFetchBranchSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 true,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	remotes := fc.Remotes(repo.Git.Remotes(repo.Backend))
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	connector, err := hosting.NewConnector(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(localBranches)
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		Connector:          connector,
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	switch validatedConfig.BranchType(initialBranch) {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		return data, false, fmt.Errorf(messages.MoveCommitNoFeatureBranch, initialBranch)
+	}
+	lineage := validatedConfig.NormalConfig.Lineage
+	parent, hasParent := lineage.Parent(initialBranch).Get()
+	if !hasParent {
+		return data, false, fmt.Errorf(messages.SetParentNoFeatureBranch, initialBranch)
+	}
+	initialBranchInfo, hasInitialBranchInfo := branchesSnapshot.Branches.FindByLocalName(initialBranch).Get()
+	if !hasInitialBranchInfo {
+		return data, false, fmt.Errorf(messages.BranchInfoNotFound, initialBranch)
+	}
+	commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, initialBranch, parent)
+	if err != nil {
+		return data, false, err
+	}
+	if len(commits) == 0 {
+		return data, false, fmt.Errorf(messages.MoveCommitNoCommits, initialBranch)
+	}
+	targetCandidates := moveCommitTargets(initialBranch, validatedConfig, branchesSnapshot.Branches)
+	if len(targetCandidates) == 0 {
+		return data, false, fmt.Errorf(messages.MoveCommitNoTarget, initialBranch)
+	}
+	commitsToMove, exit, err := dialog.MoveCommits(initialBranch, commits, dialogTestInputs.Next())
+	if err != nil || exit {
+		return data, exit, err
+	}
+	if len(commitsToMove) == 0 {
+		return data, false, errors.New(messages.MoveCommitNoneSelected)
+	}
+	targetBranch, exit, err := dialog.MoveCommitTarget(initialBranch, targetCandidates, dialogTestInputs.Next())
+	if err != nil || exit {
+		return data, exit, err
+	}
+	branchNamesToSync := append(gitdomain.LocalBranchNames{targetBranch}, lineage.Descendants(targetBranch)...)
+	branchInfosToSync, nonExistingBranches := branchesSnapshot.Branches.Select(repo.UnvalidatedConfig.NormalConfig.DevRemote, branchNamesToSync...)
+	branchesToSync, err := sync.BranchesToSync(branchInfosToSync, branchesSnapshot.Branches, repo, validatedConfig.ValidatedConfigData.MainBranch)
+	if err != nil {
+		return data, false, err
+	}
+	return moveCommitData{
+		branchInfos:         branchesSnapshot.Branches,
+		branchesSnapshot:    branchesSnapshot,
+		branchesToSync:      branchesToSync,
+		commitsToMove:       commitsToMove,
+		config:              validatedConfig,
+		connector:           connector,
+		dialogTestInputs:    dialogTestInputs,
+		dryRun:              dryRun,
+		hasOpenChanges:      repoStatus.OpenChanges,
+		initialBranch:       initialBranch,
+		initialBranchInfo:   *initialBranchInfo,
+		nonExistingBranches: nonExistingBranches,
+		preFetchBranchInfos: preFetchBranchSnapshot.Branches,
+		previousBranch:      previousBranch,
+		remotes:             remotes,
+		stashSize:           stashSize,
+		targetBranch:        targetBranch,
+	}, false, fc.Err
+}
+
+// moveCommitTargets provides the local ancestors of the given branch that can receive commits, starting with the parent branch.
+// Commits cannot move into the main branch or perennial branches.
+func moveCommitTargets(branch gitdomain.LocalBranchName, validatedConfig config.ValidatedConfig, branchInfos gitdomain.BranchInfos) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, ancestor := range validatedConfig.NormalConfig.Lineage.Ancestors(branch) {
+		if !branchInfos.HasLocalBranch(ancestor) {
+			continue
+		}
+		switch validatedConfig.BranchType(ancestor) {
+		case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+			continue
+		case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		}
+		result = append(result, ancestor)
+	}
+	slices.Reverse(result)
+	return result
+}
+
+func moveCommitProgram(data moveCommitData, finalMessages stringslice.Collector) program.Program {
+	prog := NewMutable(&program.Program{})
+	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, finalMessages)
+	prog.Value.Add(&opcodes.Checkout{Branch: data.targetBranch})
+	for _, commit := range data.commitsToMove {
+		prog.Value.Add(&opcodes.CherryPick{SHA: commit.SHA})
+	}
+	prog.Value.Add(&opcodes.Checkout{Branch: data.initialBranch})
+	branchType := data.config.BranchType(data.initialBranch)
+	syncStrategy := data.config.NormalConfig.SyncFeatureStrategy.SyncStrategy()
+	if branchType == configdomain.BranchTypePrototypeBranch {
+		syncStrategy = data.config.NormalConfig.SyncPrototypeStrategy.SyncStrategy()
+	}
+	switch syncStrategy {
+	case configdomain.SyncStrategyMerge:
+		for c := len(data.commitsToMove) - 1; c >= 0; c-- {
+			prog.Value.Add(&opcodes.CommitRevertMoved{SHA: data.commitsToMove[c].SHA})
+		}
+	case configdomain.SyncStrategyRebase, configdomain.SyncStrategyCompress:
+		for c := len(data.commitsToMove) - 1; c >= 0; c-- {
+			prog.Value.Add(&opcodes.CommitRemove{SHA: data.commitsToMove[c].SHA})
+		}
+		isOnline := data.remotes.HasDev(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.IsOnline()
+		if isOnline && data.initialBranchInfo.HasTrackingBranch() && branchType.ShouldPush(true) {
+			prog.Value.Add(&opcodes.PushCurrentBranchForce{ForceIfIncludes: false})
+		}
+	}
+	branchesToDelete := set.New[gitdomain.LocalBranchName]()
+	sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
+		BranchInfos:              data.branchInfos,
+		BranchesToDelete:         NewMutable(&branchesToDelete),
+		Config:                   data.config,
+		InitialBranch:            data.initialBranch,
+		PrefetchBranchInfos:      data.preFetchBranchInfos,
+		Program:                  prog,
+		Remotes:                  data.remotes,
+		ShippedBranches:          sync.ShippedBranches{},
+		Worktrees:                gitdomain.Worktrees{},
+		WorktreesWithOpenChanges: gitdomain.Worktrees{},
+		PushBranches:             true,
+	})
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.initialBranch})
+	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         data.hasOpenChanges,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return prog.Immutable()
+}
@@ -51,7 +51,13 @@ func newPullRequestCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			result := executePropose(detached, dryRun, verbose, title, bodyText, bodyFile)
+			result := executePropose(detached, dryRun, false, verbose, title, bodyText, bodyFile, proposeAPIArgs{
+				api:       false,
+				assignees: []string{},
+				draft:     false,
+				labels:    []string{},
+				reviewers: []string{},
+			})
 			printDeprecationNotice()
 			return result
 		},
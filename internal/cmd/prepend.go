@@ -78,12 +78,13 @@ func prependCommand() *cobra.Command {
 
 func executePrepend(args []string, detached configdomain.Detached, dryRun configdomain.DryRun, prototype configdomain.Prototype, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -261,14 +262,17 @@ func prependProgram(data prependData, finalMessages stringslice.Collector) progr
 		data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, finalMessages)
 		branchesToDelete := set.New[gitdomain.LocalBranchName]()
 		sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-			BranchInfos:         data.branchInfos,
-			BranchesToDelete:    NewMutable(&branchesToDelete),
-			Config:              data.config,
-			InitialBranch:       data.initialBranch,
-			PrefetchBranchInfos: data.preFetchBranchInfos,
-			Program:             prog,
-			PushBranches:        true,
-			Remotes:             data.remotes,
+			BranchInfos:              data.branchInfos,
+			BranchesToDelete:         NewMutable(&branchesToDelete),
+			Config:                   data.config,
+			InitialBranch:            data.initialBranch,
+			PrefetchBranchInfos:      data.preFetchBranchInfos,
+			Program:                  prog,
+			PushBranches:             true,
+			Remotes:                  data.remotes,
+			ShippedBranches:          sync.ShippedBranches{},
+			Worktrees:                gitdomain.Worktrees{},
+			WorktreesWithOpenChanges: gitdomain.Worktrees{},
 		})
 	}
 	prog.Value.Add(&opcodes.BranchCreateAndCheckoutExistingParent{
@@ -316,6 +320,7 @@ func prependProgram(data prependData, finalMessages stringslice.Collector) progr
 			ProposalNumber: proposal.Number,
 		})
 	}
+	cmdhelpers.AddHook(prog, data.config.NormalConfig.NormalConfigData, configdomain.HookAfterCreateBranch, data.targetBranch)
 	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
 	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
 		DryRun:                   data.dryRun,
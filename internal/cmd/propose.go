@@ -39,13 +39,21 @@ Syncs the current branch and opens a browser window to the new proposal page of
 
 The form is pre-populated for the current branch so that the proposal only shows the changes made against the immediate parent branch.
 
+With --api, creates the proposal through the API of your code hosting platform and prints its URL instead of opening a browser window.
+
 Supported only for repositories hosted on GitHub, GitLab, Gitea and Bitbucket. When using self-hosted versions this command needs to be configured with "git config %s <driver>" where driver is "github", "gitlab", "gitea", or "bitbucket". When using SSH identities, this command needs to be configured with "git config %s <hostname>" where hostname matches what is in your ssh config file.`
 
 func proposeCommand() *cobra.Command {
+	addAPIFlag, readAPIFlag := flags.ProposeAPI()
+	addAssigneesFlag, readAssigneesFlag := flags.ProposalAssignees()
 	addBodyFlag, readBodyFlag := flags.ProposalBody()
 	addBodyFileFlag, readBodyFileFlag := flags.ProposalBodyFile()
 	addDetachedFlag, readDetachedFlag := flags.Detached()
+	addDraftFlag, readDraftFlag := flags.ProposalDraft()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
+	addLabelsFlag, readLabelsFlag := flags.ProposalLabels()
+	addReviewersFlag, readReviewersFlag := flags.ProposalReviewers()
 	addTitleFlag, readTitleFlag := flags.ProposalTitle()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
@@ -63,6 +71,10 @@ func proposeCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			inferParents, err := readInferParentsFlag(cmd)
+			if err != nil {
+				return err
+			}
 			bodyFile, err := readBodyFileFlag(cmd)
 			if err != nil {
 				return err
@@ -79,35 +91,79 @@ func proposeCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return executePropose(detached, dryRun, verbose, title, bodyText, bodyFile)
+			api, err := readAPIFlag(cmd)
+			if err != nil {
+				return err
+			}
+			assignees, err := readAssigneesFlag(cmd)
+			if err != nil {
+				return err
+			}
+			draft, err := readDraftFlag(cmd)
+			if err != nil {
+				return err
+			}
+			labels, err := readLabelsFlag(cmd)
+			if err != nil {
+				return err
+			}
+			reviewers, err := readReviewersFlag(cmd)
+			if err != nil {
+				return err
+			}
+			apiArgs := proposeAPIArgs{
+				api:       api,
+				assignees: assignees,
+				draft:     draft,
+				labels:    labels,
+				reviewers: reviewers,
+			}
+			if err = apiArgs.validate(); err != nil {
+				return err
+			}
+			return executePropose(detached, dryRun, inferParents, verbose, title, bodyText, bodyFile, apiArgs)
 		},
 	}
+	addAPIFlag(&cmd)
+	addAssigneesFlag(&cmd)
 	addBodyFlag(&cmd)
 	addBodyFileFlag(&cmd)
 	addDetachedFlag(&cmd)
+	addDraftFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
+	addLabelsFlag(&cmd)
+	addReviewersFlag(&cmd)
 	addTitleFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executePropose(detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile) error {
+func executePropose(detached configdomain.Detached, dryRun configdomain.DryRun, inferParents configdomain.InferParents, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile, apiArgs proposeAPIArgs) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: true,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       true,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineProposeData(repo, detached, dryRun, verbose, title, body, bodyFile)
+	if inferParents.IsTrue() {
+		repo.UnvalidatedConfig.NormalConfig.InferParents = inferParents
+	}
+	data, exit, err := determineProposeData(repo, detached, dryRun, verbose, title, body, bodyFile, apiArgs)
 	if err != nil || exit {
 		return err
 	}
 	if existingProposalURL, hasExistingProposal := data.existingProposalURL.Get(); hasExistingProposal {
+		if apiArgs.api.IsTrue() {
+			fmt.Printf(messages.ProposalExists, data.branchToPropose, existingProposalURL)
+			return nil
+		}
 		browser.Open(existingProposalURL, repo.Frontend, repo.Backend)
 		return nil
 	}
@@ -145,7 +201,25 @@ func executePropose(detached configdomain.Detached, dryRun configdomain.DryRun,
 	})
 }
 
+// proposeAPIArgs contains the CLI arguments for creating proposals through the API of the code hosting platform
+type proposeAPIArgs struct {
+	api       configdomain.ProposeAPI
+	assignees []string
+	draft     configdomain.ProposalDraft
+	labels    []string
+	reviewers []string
+}
+
+// validate ensures that the API-only options are only used together with --api
+func (self proposeAPIArgs) validate() error {
+	if self.api.IsFalse() && (self.draft.IsTrue() || len(self.assignees) > 0 || len(self.labels) > 0 || len(self.reviewers) > 0) {
+		return errors.New(messages.ProposalAPIOptionsWithoutAPI)
+	}
+	return nil
+}
+
 type proposeData struct {
+	apiArgs             proposeAPIArgs
 	branchInfos         gitdomain.BranchInfos
 	branchToPropose     gitdomain.LocalBranchName
 	branchTypeToPropose configdomain.BranchType
@@ -167,7 +241,7 @@ type proposeData struct {
 	stashSize           gitdomain.StashSize
 }
 
-func determineProposeData(repo execute.OpenRepoResult, detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile) (data proposeData, exit bool, err error) {
+func determineProposeData(repo execute.OpenRepoResult, detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, title gitdomain.ProposalTitle, body gitdomain.ProposalBody, bodyFile gitdomain.ProposalBodyFile, apiArgs proposeAPIArgs) (data proposeData, exit bool, err error) {
 	preFetchBranchSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
 	if err != nil {
 		return data, false, err
@@ -242,6 +316,9 @@ func determineProposeData(repo execute.OpenRepoResult, detached configdomain.Det
 	if !hasConnector {
 		return data, false, hostingdomain.UnsupportedServiceError()
 	}
+	if apiArgs.api.IsTrue() && connector.CreateProposalFn().IsNone() {
+		return data, false, errors.New(messages.ProposalAPIUnsupported)
+	}
 	existingProposalURL := None[string]()
 	if findProposal, canFindProposal := connector.FindProposalFn().Get(); canFindProposal {
 		existingProposalOpt, err := findProposal(initialBranch, parentOfBranchToPropose)
@@ -279,7 +356,11 @@ func determineProposeData(repo execute.OpenRepoResult, detached configdomain.Det
 			bodyText = gitdomain.ProposalBody(fileData)
 		}
 	}
+	if apiArgs.api.IsTrue() && len(title) == 0 {
+		title = DefaultProposalTitle(branchToPropose, branchesToSync)
+	}
 	return proposeData{
+		apiArgs:             apiArgs,
 		branchInfos:         branchesSnapshot.Branches,
 		branchToPropose:     branchToPropose,
 		branchTypeToPropose: branchTypeToPropose,
@@ -307,14 +388,17 @@ func proposeProgram(repo execute.OpenRepoResult, data proposeData) program.Progr
 	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, repo.FinalMessages)
 	branchesToDelete := set.New[gitdomain.LocalBranchName]()
 	sync.BranchesProgram(data.branchesToSync, sync.BranchProgramArgs{
-		BranchInfos:         data.branchInfos,
-		BranchesToDelete:    NewMutable(&branchesToDelete),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.preFetchBranchInfos,
-		Remotes:             data.remotes,
-		Program:             prog,
-		PushBranches:        true,
+		BranchInfos:              data.branchInfos,
+		BranchesToDelete:         NewMutable(&branchesToDelete),
+		Config:                   data.config,
+		InitialBranch:            data.initialBranch,
+		PrefetchBranchInfos:      data.preFetchBranchInfos,
+		Remotes:                  data.remotes,
+		ShippedBranches:          sync.ShippedBranches{},
+		Worktrees:                gitdomain.Worktrees{},
+		WorktreesWithOpenChanges: gitdomain.Worktrees{},
+		Program:                  prog,
+		PushBranches:             true,
 	})
 	if data.branchTypeToPropose == configdomain.BranchTypePrototypeBranch {
 		prog.Value.Add(&opcodes.BranchesPrototypeRemove{Branch: data.branchToPropose})
@@ -334,6 +418,18 @@ func proposeProgram(repo execute.OpenRepoResult, data proposeData) program.Progr
 		repo.FinalMessages.Add(fmt.Sprintf(messages.BranchDeletedAtRemote, data.branchToPropose))
 		return prog.Immutable()
 	}
+	if data.apiArgs.api.IsTrue() {
+		prog.Value.Add(&opcodes.ProposalCreateViaAPI{
+			Assignees:     data.apiArgs.assignees,
+			Branch:        data.branchToPropose,
+			Draft:         data.apiArgs.draft.IsTrue(),
+			Labels:        data.apiArgs.labels,
+			ProposalBody:  data.proposalBody,
+			ProposalTitle: data.proposalTitle,
+			Reviewers:     data.apiArgs.reviewers,
+		})
+		return prog.Immutable()
+	}
 	prog.Value.Add(&opcodes.ProposalCreate{
 		Branch:        data.branchToPropose,
 		MainBranch:    data.config.ValidatedConfigData.MainBranch,
@@ -343,6 +439,20 @@ func proposeProgram(repo execute.OpenRepoResult, data proposeData) program.Progr
 	return prog.Immutable()
 }
 
+// DefaultProposalTitle provides the title for proposals created through the API without a given title:
+// the subject of the first commit on the branch, or the branch name if the branch has no commits.
+func DefaultProposalTitle(branch gitdomain.LocalBranchName, branchesToSync []configdomain.BranchToSync) gitdomain.ProposalTitle {
+	for _, branchToSync := range branchesToSync {
+		if branchToSync.BranchInfo.LocalName.GetOrDefault() != branch {
+			continue
+		}
+		if firstCommitMessage, has := branchToSync.FirstCommitMessage.Get(); has {
+			return gitdomain.ProposalTitle(firstCommitMessage.Parts().Subject)
+		}
+	}
+	return gitdomain.ProposalTitle(branch.String())
+}
+
 func validateBranchTypeToPropose(branchType configdomain.BranchType) error {
 	switch branchType {
 	case
@@ -0,0 +1,43 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cmd"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestDefaultProposalTitle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("branch with commits", func(t *testing.T) {
+		t.Parallel()
+		branchesToSync := []configdomain.BranchToSync{
+			{
+				BranchInfo:         gitdomain.BranchInfo{LocalName: Some(gitdomain.NewLocalBranchName("main"))},
+				FirstCommitMessage: None[gitdomain.CommitMessage](),
+			},
+			{
+				BranchInfo:         gitdomain.BranchInfo{LocalName: Some(gitdomain.NewLocalBranchName("feature"))},
+				FirstCommitMessage: Some(gitdomain.CommitMessage("add the feature\n\ndetails")),
+			},
+		}
+		have := cmd.DefaultProposalTitle("feature", branchesToSync)
+		must.EqOp(t, "add the feature", have)
+	})
+
+	t.Run("branch without commits", func(t *testing.T) {
+		t.Parallel()
+		branchesToSync := []configdomain.BranchToSync{
+			{
+				BranchInfo:         gitdomain.BranchInfo{LocalName: Some(gitdomain.NewLocalBranchName("feature"))},
+				FirstCommitMessage: None[gitdomain.CommitMessage](),
+			},
+		}
+		have := cmd.DefaultProposalTitle("feature", branchesToSync)
+		must.EqOp(t, "feature", have)
+	})
+}
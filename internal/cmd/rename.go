@@ -81,12 +81,13 @@ func renameCommand() *cobra.Command {
 
 func executeRename(args []string, dryRun configdomain.DryRun, force configdomain.Force, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -237,12 +238,16 @@ func determineRenameData(args []string, force configdomain.Force, repo execute.O
 	lineageBranches := validatedConfig.NormalConfig.Lineage.BranchNames()
 	_, nonExistingBranches := branchesSnapshot.Branches.Select(repo.UnvalidatedConfig.NormalConfig.DevRemote, lineageBranches...)
 	proposalOpt := ship.FindProposal(connectorOpt, initialBranch, parentOpt)
+	proposalFinder, err := hosting.NewProposalFinder(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, connectorOpt, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
 	proposalsOfChildBranches := ship.LoadProposalsOfChildBranches(ship.LoadProposalsOfChildBranchesArgs{
-		ConnectorOpt:               connectorOpt,
 		Lineage:                    validatedConfig.NormalConfig.Lineage,
 		Offline:                    false,
 		OldBranch:                  oldBranchName,
 		OldBranchHasTrackingBranch: oldBranch.HasTrackingBranch(),
+		ProposalFinder:             proposalFinder,
 	})
 	return renameData{
 		branchesSnapshot:         branchesSnapshot,
@@ -44,12 +44,13 @@ func repoCommand() *cobra.Command {
 
 func executeRepo(args []string, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: true,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       true,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
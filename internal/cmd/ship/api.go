@@ -3,12 +3,14 @@ package ship
 import (
 	"errors"
 	"fmt"
+	"net"
 
-	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v17/internal/messages"
 	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 )
@@ -21,26 +23,34 @@ type shipDataAPI struct {
 	proposalMessage        string
 }
 
-func determineAPIData(sharedData sharedShipData) (result shipDataAPI, err error) {
+// determineAPIData provides the data for shipping via the API.
+// Indicates through the returned bool whether the code hosting platform is unavailable.
+func determineAPIData(sharedData sharedShipData, parent gitdomain.LocalBranchName) (result shipDataAPI, unavailable bool, err error) {
 	branchToShipRemoteName, hasRemoteBranchToShip := sharedData.branchToShip.RemoteName.Get()
 	if !hasRemoteBranchToShip {
-		return result, fmt.Errorf(messages.ShipAPINoRemoteBranch, sharedData.branchNameToShip)
+		return result, false, fmt.Errorf(messages.ShipAPINoRemoteBranch, sharedData.branchNameToShip)
 	}
 	connector, hasConnector := sharedData.connector.Get()
 	if !hasConnector {
-		return result, errors.New(messages.ShipAPIConnectorRequired)
+		return result, false, errors.New(messages.ShipAPIConnectorRequired)
 	}
-	findProposal, canFindProposal := connector.FindProposalFn().Get()
-	if !canFindProposal {
-		return result, errors.New(messages.ShipAPIConnectorUnsupported)
+	if connector.FindProposalFn().IsNone() {
+		return result, false, errors.New(messages.ShipAPIConnectorUnsupported)
 	}
-	proposalOpt, err := findProposal(sharedData.branchNameToShip, sharedData.targetBranchName)
+	if sharedData.config.NormalConfig.Offline.IsTrue() {
+		return result, true, nil
+	}
+	proposalOpt, err := sharedData.proposalFinder.Find(sharedData.branchNameToShip, parent)
 	if err != nil {
-		return result, err
+		if !isUnreachable(err) {
+			return result, false, err
+		}
+		print.Error(err)
+		return result, true, nil
 	}
 	proposal, hasProposal := proposalOpt.Get()
 	if !hasProposal {
-		return result, fmt.Errorf(messages.ShipAPINoProposal, sharedData.branchNameToShip)
+		return result, false, fmt.Errorf(messages.ShipAPINoProposal, sharedData.branchNameToShip)
 	}
 	proposalMessage := connector.DefaultProposalMessage(proposal)
 	return shipDataAPI{
@@ -48,7 +58,7 @@ func determineAPIData(sharedData sharedShipData) (result shipDataAPI, err error)
 		connector:              connector,
 		proposal:               proposal,
 		proposalMessage:        proposalMessage,
-	}, nil
+	}, false, nil
 }
 
 func shipAPIProgram(prog Mutable[program.Program], sharedData sharedShipData, apiData shipDataAPI, commitMessage Option[gitdomain.CommitMessage]) error {
@@ -76,16 +86,64 @@ func shipAPIProgram(prog Mutable[program.Program], sharedData sharedShipData, ap
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.LineageParentRemove{Branch: branchToShipLocal})
+		prog.Value.Add(&opcodes.BranchSyncStrategyRemove{Branch: branchToShipLocal})
 	}
 	for _, child := range sharedData.childBranches {
 		prog.Value.Add(&opcodes.LineageParentSetToGrandParent{Branch: child})
 	}
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
-	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
-		DryRun:                   sharedData.dryRun,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
-	})
+	return nil
+}
+
+// isUnreachable indicates whether the given error means that Git Town could not reach the code hosting platform,
+// i.e. whether it could not resolve the hostname of the platform or could not connect to it.
+// Other errors, like an invalid API token, an untrusted TLS certificate, or a missing proposal, aren't caused by being offline.
+func isUnreachable(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// shipAPIOfflineProgram ships the given branch while the code hosting platform is unavailable.
+// It stores the changes at the code hosting platform in the outbox, which the next online sync performs.
+// The shipped branch stays in the local repository until that sync has merged its proposal.
+func shipAPIOfflineProgram(prog Mutable[program.Program], sharedData sharedShipData, parent gitdomain.LocalBranchName, commitMessage Option[gitdomain.CommitMessage]) error {
+	connector, hasConnector := sharedData.connector.Get()
+	if !hasConnector {
+		return errors.New(messages.ShipAPIConnectorRequired)
+	}
+	if connector.SquashMergeProposalFn().IsNone() {
+		return errors.New(messages.ShipAPIConnectorUnsupported)
+	}
+	if !sharedData.dryRun {
+		for _, child := range sharedData.childBranches {
+			prog.Value.Add(&opcodes.OutboxEntryAdd{Entry: outbox.Entry{
+				Action:        outbox.ActionProposalRetarget,
+				Branch:        child,
+				CommitMessage: None[gitdomain.CommitMessage](),
+				NewTarget:     Some(parent),
+				Target:        Some(sharedData.branchNameToShip),
+			}})
+		}
+		prog.Value.Add(&opcodes.OutboxEntryAdd{Entry: outbox.Entry{
+			Action:        outbox.ActionProposalMerge,
+			Branch:        sharedData.branchNameToShip,
+			CommitMessage: commitMessage,
+			NewTarget:     None[gitdomain.LocalBranchName](),
+			Target:        Some(parent),
+		}})
+		if sharedData.config.NormalConfig.ShipDeleteTrackingBranch {
+			prog.Value.Add(&opcodes.OutboxEntryAdd{Entry: outbox.Entry{
+				Action:        outbox.ActionTrackingBranchDelete,
+				Branch:        sharedData.branchNameToShip,
+				CommitMessage: None[gitdomain.CommitMessage](),
+				NewTarget:     None[gitdomain.LocalBranchName](),
+				Target:        None[gitdomain.LocalBranchName](),
+			}})
+		}
+	}
+	prog.Value.Add(&opcodes.MessageQueue{Message: fmt.Sprintf(messages.OutboxQueued, sharedData.branchNameToShip)})
 	return nil
 }
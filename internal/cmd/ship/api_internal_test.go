@@ -0,0 +1,30 @@
+package ship
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/shoenig/test/must"
+)
+
+func TestIsUnreachable(t *testing.T) {
+	t.Parallel()
+	tests := map[error]bool{
+		&url.Error{Err: &net.OpError{Err: errors.New("connection refused"), Op: "dial"}, Op: "Get", URL: "https://api.github.com"}:                                true,
+		&url.Error{Err: &net.DNSError{Err: "no such host", Name: "api.github.com"}, Op: "Get", URL: "https://api.github.com"}:                                     true,
+		&net.DNSError{Err: "no such host", Name: "api.github.com"}:                                                                                                true,
+		fmt.Errorf("wrapped: %w", &net.OpError{Err: errors.New("network is unreachable"), Op: "dial"}):                                                            true,
+		&url.Error{Err: errors.New("tls: failed to verify certificate: x509: certificate signed by unknown authority"), Op: "Get", URL: "https://api.github.com"}: false,
+		&url.Error{Err: &net.OpError{Err: errors.New("connection reset by peer"), Op: "read"}, Op: "Get", URL: "https://api.github.com"}:                          false,
+		errors.New("401 Bad credentials"):         false,
+		errors.New("404 Not Found"):               false,
+		errors.New("403 API rate limit exceeded"): false,
+	}
+	for give, want := range tests {
+		have := isUnreachable(give)
+		must.EqOp(t, want, have)
+	}
+}
@@ -45,8 +45,11 @@ func Cmd() *cobra.Command {
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	addMessageFlag, readMessageFlag := flags.CommitMessage("specify the commit message for the squash commit")
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addShipStrategyFlag, readShipStrategyFlag := flags.ShipStrategy()
+	addStackFlag, readStackFlag := flags.ShipStack()
 	addToParentFlag, readToParentFlag := flags.ShipIntoNonPerennialParent()
+	addWaitFlag, readWaitFlag := flags.ShipWait()
 	cmd := cobra.Command{
 		Use:   shipCommand,
 		Args:  cobra.MaximumNArgs(1),
@@ -65,6 +68,14 @@ func Cmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			inferParents, err := readInferParentsFlag(cmd)
+			if err != nil {
+				return err
+			}
+			stack, err := readStackFlag(cmd)
+			if err != nil {
+				return err
+			}
 			toParent, err := readToParentFlag(cmd)
 			if err != nil {
 				return err
@@ -73,61 +84,68 @@ func Cmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return executeShip(args, message, dryRun, verbose, shipStrategyOverride, toParent)
+			wait, err := readWaitFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeShip(args, message, dryRun, inferParents, verbose, shipStrategyOverride, stack, toParent, wait)
 		},
 	}
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addVerboseFlag(&cmd)
 	addMessageFlag(&cmd)
 	addShipStrategyFlag(&cmd)
+	addStackFlag(&cmd)
 	addToParentFlag(&cmd)
+	addWaitFlag(&cmd)
 	return &cmd
 }
 
-func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun configdomain.DryRun, verbose configdomain.Verbose, shipStrategy Option[configdomain.ShipStrategy], toParent configdomain.ShipIntoNonperennialParent) error {
+func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun configdomain.DryRun, inferParents configdomain.InferParents, verbose configdomain.Verbose, shipStrategy Option[configdomain.ShipStrategy], stack configdomain.FullStack, toParent configdomain.ShipIntoNonperennialParent, wait configdomain.ShipWait) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
 	}
+	if inferParents.IsTrue() {
+		repo.UnvalidatedConfig.NormalConfig.InferParents = inferParents
+	}
 	sharedData, exit, err := determineSharedShipData(args, repo, dryRun, shipStrategy, verbose)
 	if err != nil || exit {
 		return err
 	}
-	err = validateSharedData(sharedData, toParent, message)
-	if err != nil {
-		return err
-	}
 	prog := NewMutable(&program.Program{})
-	switch sharedData.config.NormalConfig.ShipStrategy {
-	case configdomain.ShipStrategyAPI:
-		apiData, err := determineAPIData(sharedData)
+	stashOpenChanges := !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges
+	if stack.Enabled() {
+		stashOpenChanges, err = shipStackProgram(prog, repo, sharedData, message, wait)
 		if err != nil {
 			return err
 		}
-		err = shipAPIProgram(prog, sharedData, apiData, message)
+	} else {
+		err = validateSharedData(sharedData, toParent, message, wait)
 		if err != nil {
 			return err
 		}
-	case configdomain.ShipStragegyFastForward:
-		mergeData, err := determineMergeData(repo, sharedData.branchNameToShip, sharedData.targetBranchName)
-		if err != nil {
-			return err
-		}
-		shipProgramFastForward(prog, sharedData, mergeData)
-	case configdomain.ShipStrategySquashMerge:
-		squashMergeData, err := determineMergeData(repo, sharedData.branchNameToShip, sharedData.targetBranchName)
+		err = shipBranchProgram(prog, repo, sharedData, sharedData.targetBranchName, message, wait)
 		if err != nil {
 			return err
 		}
-		shipProgramSquashMerge(prog, sharedData, squashMergeData, message)
 	}
+	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   sharedData.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         stashOpenChanges,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
 	runState := runstate.RunState{
 		BeginBranchesSnapshot: sharedData.branchesSnapshot,
 		BeginConfigSnapshot:   repo.ConfigSnapshot,
@@ -161,6 +179,55 @@ func executeShip(args []string, message Option[gitdomain.CommitMessage], dryRun
 	})
 }
 
+// adds the opcodes to ship the given branch into its target branch using the configured ship strategy
+func shipBranchProgram(prog Mutable[program.Program], repo execute.OpenRepoResult, sharedData sharedShipData, parent gitdomain.LocalBranchName, message Option[gitdomain.CommitMessage], wait configdomain.ShipWait) error {
+	switch sharedData.config.NormalConfig.ShipStrategy {
+	case configdomain.ShipStrategyAPI:
+		apiData, unavailable, err := determineAPIData(sharedData, parent)
+		if err != nil {
+			return err
+		}
+		if unavailable {
+			err = shipAPIOfflineProgram(prog, sharedData, parent, message)
+		} else {
+			err = shipAPIProgram(prog, sharedData, apiData, message)
+		}
+		if err != nil {
+			return err
+		}
+	case configdomain.ShipStragegyFastForward:
+		mergeData, err := determineMergeData(repo, sharedData.branchNameToShip, parent)
+		if err != nil {
+			return err
+		}
+		shipProgramFastForward(prog, sharedData, mergeData)
+	case configdomain.ShipStrategyMergeQueue:
+		mergeQueueData, err := determineMergeQueueData(sharedData, parent)
+		if err != nil {
+			return err
+		}
+		shipMergeQueueProgram(prog, sharedData, mergeQueueData, wait)
+	case configdomain.ShipStrategyRebaseMerge:
+		rebaseMergeData, err := determineMergeData(repo, sharedData.branchNameToShip, parent)
+		if err != nil {
+			return err
+		}
+		shipProgramRebaseMerge(prog, sharedData, rebaseMergeData)
+	case configdomain.ShipStrategySquashMerge:
+		squashMergeData, err := determineMergeData(repo, sharedData.branchNameToShip, parent)
+		if err != nil {
+			return err
+		}
+		templateData, err := determineSquashCommitTemplateData(repo, sharedData, parent, squashMergeData, message)
+		if err != nil {
+			return err
+		}
+		shipProgramSquashMerge(prog, sharedData, squashMergeData, message, templateData)
+	}
+	cmdhelpers.AddHook(prog, sharedData.config.NormalConfig.NormalConfigData, configdomain.HookAfterShip, sharedData.branchNameToShip)
+	return nil
+}
+
 func UpdateChildBranchProposalsToGrandParent(prog *program.Program, proposals []hostingdomain.Proposal) {
 	for _, childProposal := range proposals {
 		prog.Add(&opcodes.ProposalUpdateTargetToGrandParent{
@@ -171,10 +238,19 @@ func UpdateChildBranchProposalsToGrandParent(prog *program.Program, proposals []
 	}
 }
 
-func validateSharedData(data sharedShipData, toParent configdomain.ShipIntoNonperennialParent, message Option[gitdomain.CommitMessage]) error {
+func validateSharedData(data sharedShipData, toParent configdomain.ShipIntoNonperennialParent, message Option[gitdomain.CommitMessage], wait configdomain.ShipWait) error {
 	if data.config.NormalConfig.ShipStrategy == configdomain.ShipStragegyFastForward && message.IsSome() {
 		return errors.New(messages.ShipMessageWithFastForward)
 	}
+	if data.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyMergeQueue && message.IsSome() {
+		return errors.New(messages.ShipMessageWithMergeQueue)
+	}
+	if data.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyRebaseMerge && message.IsSome() {
+		return errors.New(messages.ShipMessageWithRebaseMerge)
+	}
+	if data.config.NormalConfig.ShipStrategy != configdomain.ShipStrategyMergeQueue && wait.Enabled() {
+		return errors.New(messages.ShipWaitWithoutMergeQueue)
+	}
 	if !toParent {
 		branch := data.branchToShip.LocalName.GetOrPanic()
 		parentBranch := data.targetBranch.LocalName.GetOrPanic()
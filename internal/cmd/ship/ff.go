@@ -1,8 +1,6 @@
 package ship
 
 import (
-	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
-	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/vm/opcodes"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
@@ -22,6 +20,7 @@ func shipProgramFastForward(prog Mutable[program.Program], sharedData sharedShip
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.LineageParentRemove{Branch: sharedData.branchNameToShip})
+		prog.Value.Add(&opcodes.BranchSyncStrategyRemove{Branch: sharedData.branchNameToShip})
 	}
 	if branchToShipRemoteName, hasRemoteName := sharedData.branchToShip.RemoteName.Get(); hasRemoteName {
 		if sharedData.config.NormalConfig.IsOnline() {
@@ -37,11 +36,4 @@ func shipProgramFastForward(prog Mutable[program.Program], sharedData sharedShip
 		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.initialBranch})
 	}
 	prog.Value.Add(&opcodes.BranchLocalDelete{Branch: sharedData.branchNameToShip})
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
-	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
-		DryRun:                   sharedData.dryRun,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
-	})
 }
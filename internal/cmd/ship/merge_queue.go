@@ -0,0 +1,64 @@
+package ship
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+func determineMergeQueueData(sharedData sharedShipData, parent gitdomain.LocalBranchName) (shipDataAPI, error) {
+	apiData, unavailable, err := determineAPIData(sharedData, parent)
+	if err != nil {
+		return apiData, err
+	}
+	if unavailable {
+		return apiData, errors.New(messages.ShipMergeQueueUnavailable)
+	}
+	if apiData.connector.EnqueueProposalFn().IsNone() {
+		return apiData, errors.New(messages.ShipMergeQueueUnsupported)
+	}
+	return apiData, nil
+}
+
+// adds the opcodes to ship the given branch by adding its proposal to the merge queue of the code hosting platform
+func shipMergeQueueProgram(prog Mutable[program.Program], sharedData sharedShipData, apiData shipDataAPI, wait configdomain.ShipWait) {
+	branchToShipLocal, hasLocalBranchToShip := sharedData.branchToShip.LocalName.Get()
+	prog.Value.Add(&opcodes.ConnectorProposalEnqueue{
+		Branch:         branchToShipLocal,
+		ProposalNumber: apiData.proposal.Number,
+	})
+	// The merge queue can still reject the proposal,
+	// so Git Town removes the shipped branch only after the merge queue has merged the proposal.
+	// Without waiting, the next sync removes the branch once the proposal is merged.
+	if !wait.Enabled() {
+		prog.Value.Add(&opcodes.MessageQueue{Message: fmt.Sprintf(messages.ShipMergeQueueEnqueued, apiData.proposal.Number, branchToShipLocal)})
+		return
+	}
+	prog.Value.Add(&opcodes.ConnectorProposalAwaitMerge{
+		Branch:         branchToShipLocal,
+		ProposalNumber: apiData.proposal.Number,
+	})
+	UpdateChildBranchProposalsToGrandParent(prog.Value, sharedData.proposalsOfChildBranches)
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.targetBranchName})
+	// Deleting the tracking branch removes the proposal from the merge queue,
+	// so Git Town does this only after the merge queue has merged the proposal.
+	if sharedData.config.NormalConfig.ShipDeleteTrackingBranch {
+		prog.Value.Add(&opcodes.BranchTrackingDelete{Branch: apiData.branchToShipRemoteName})
+	}
+	if hasLocalBranchToShip {
+		prog.Value.Add(&opcodes.BranchLocalDelete{Branch: branchToShipLocal})
+	}
+	if !sharedData.dryRun {
+		prog.Value.Add(&opcodes.LineageParentRemove{Branch: branchToShipLocal})
+		prog.Value.Add(&opcodes.BranchSyncStrategyRemove{Branch: branchToShipLocal})
+	}
+	for _, child := range sharedData.childBranches {
+		prog.Value.Add(&opcodes.LineageParentSetToGrandParent{Branch: child})
+	}
+}
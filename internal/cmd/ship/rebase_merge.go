@@ -0,0 +1,39 @@
+package ship
+
+import (
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+func shipProgramRebaseMerge(prog Mutable[program.Program], sharedData sharedShipData, rebaseMergeData shipDataMerge) {
+	prog.Value.Add(&opcodes.BranchEnsureShippableChanges{Branch: sharedData.branchNameToShip, Parent: sharedData.targetBranchName})
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.branchNameToShip})
+	prog.Value.Add(&opcodes.RebaseBranch{Branch: sharedData.targetBranchName.BranchName()})
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.targetBranchName})
+	if rebaseMergeData.remotes.HasDev(sharedData.config.NormalConfig.DevRemote) && sharedData.config.NormalConfig.IsOnline() {
+		UpdateChildBranchProposalsToGrandParent(prog.Value, sharedData.proposalsOfChildBranches)
+	}
+	prog.Value.Add(&opcodes.MergeRebasedProgram{Branch: sharedData.branchNameToShip, Parent: sharedData.targetBranchName})
+	if rebaseMergeData.remotes.HasDev(sharedData.config.NormalConfig.DevRemote) && sharedData.config.NormalConfig.IsOnline() {
+		prog.Value.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: sharedData.targetBranchName})
+	}
+	if !sharedData.dryRun {
+		prog.Value.Add(&opcodes.LineageParentRemove{Branch: sharedData.branchNameToShip})
+		prog.Value.Add(&opcodes.BranchSyncStrategyRemove{Branch: sharedData.branchNameToShip})
+	}
+	if branchToShipRemoteName, hasRemoteName := sharedData.branchToShip.RemoteName.Get(); hasRemoteName {
+		if sharedData.config.NormalConfig.IsOnline() {
+			if sharedData.config.NormalConfig.ShipDeleteTrackingBranch {
+				prog.Value.Add(&opcodes.BranchTrackingDelete{Branch: branchToShipRemoteName})
+			}
+		}
+	}
+	for _, child := range sharedData.childBranches {
+		prog.Value.Add(&opcodes.LineageParentSetToGrandParent{Branch: child})
+	}
+	if !sharedData.isShippingInitialBranch {
+		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.initialBranch})
+	}
+	prog.Value.Add(&opcodes.BranchLocalDelete{Branch: sharedData.branchNameToShip})
+}
@@ -33,6 +33,7 @@ type sharedShipData struct {
 	initialBranch            gitdomain.LocalBranchName
 	isShippingInitialBranch  bool
 	previousBranch           Option[gitdomain.LocalBranchName]
+	proposalFinder           hosting.ProposalFinder
 	proposalsOfChildBranches []hostingdomain.Proposal
 	stashSize                gitdomain.StashSize
 	targetBranch             gitdomain.BranchInfo
@@ -101,19 +102,8 @@ func determineSharedShipData(args []string, repo execute.OpenRepoResult, dryRun
 	if shipStrategyOverride, hasShipStrategyOverride := shipStrategyOverride.Get(); hasShipStrategyOverride {
 		validatedConfig.NormalConfig.ShipStrategy = shipStrategyOverride
 	}
-	switch validatedConfig.BranchType(branchNameToShip) {
-	case configdomain.BranchTypeContributionBranch:
-		return data, false, errors.New(messages.ContributionBranchCannotShip)
-	case configdomain.BranchTypeMainBranch:
-		return data, false, errors.New(messages.MainBranchCannotShip)
-	case configdomain.BranchTypeObservedBranch:
-		return data, false, errors.New(messages.ObservedBranchCannotShip)
-	case configdomain.BranchTypePerennialBranch:
-		return data, false, errors.New(messages.PerennialBranchCannotShip)
-	case
-		configdomain.BranchTypeFeatureBranch,
-		configdomain.BranchTypeParkedBranch,
-		configdomain.BranchTypePrototypeBranch:
+	if err = validateBranchTypeToShip(validatedConfig.BranchType(branchNameToShip)); err != nil {
+		return data, false, err
 	}
 	targetBranchName, hasTargetBranch := validatedConfig.NormalConfig.Lineage.Parent(branchNameToShip).Get()
 	if !hasTargetBranch {
@@ -128,12 +118,20 @@ func determineSharedShipData(args []string, repo execute.OpenRepoResult, dryRun
 	if err != nil {
 		return data, false, err
 	}
+	proposalFinder, err := hosting.NewProposalFinder(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, connectorOpt, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
+	usesAPI := validatedConfig.NormalConfig.ShipStrategy == configdomain.ShipStrategyAPI || validatedConfig.NormalConfig.ShipStrategy == configdomain.ShipStrategyMergeQueue
+	if usesAPI && repo.IsOffline.IsFalse() {
+		proposalFinder.Preload(shipProposalLookups(validatedConfig.NormalConfig.Lineage, branchNameToShip))
+	}
 	proposalsOfChildBranches := LoadProposalsOfChildBranches(LoadProposalsOfChildBranchesArgs{
-		ConnectorOpt:               connectorOpt,
 		Lineage:                    validatedConfig.NormalConfig.Lineage,
 		Offline:                    repo.IsOffline,
 		OldBranch:                  branchNameToShip,
 		OldBranchHasTrackingBranch: branchToShip.HasTrackingBranch(),
+		ProposalFinder:             proposalFinder,
 	})
 	return sharedShipData{
 		branchNameToShip:         branchNameToShip,
@@ -148,6 +146,7 @@ func determineSharedShipData(args []string, repo execute.OpenRepoResult, dryRun
 		initialBranch:            initialBranch,
 		isShippingInitialBranch:  isShippingInitialBranch,
 		previousBranch:           previousBranch,
+		proposalFinder:           proposalFinder,
 		proposalsOfChildBranches: proposalsOfChildBranches,
 		stashSize:                stashSize,
 		targetBranch:             *targetBranch,
@@ -156,14 +155,6 @@ func determineSharedShipData(args []string, repo execute.OpenRepoResult, dryRun
 }
 
 func LoadProposalsOfChildBranches(args LoadProposalsOfChildBranchesArgs) []hostingdomain.Proposal {
-	connector, hasConnector := args.ConnectorOpt.Get()
-	if !hasConnector {
-		return []hostingdomain.Proposal{}
-	}
-	findProposal, canFindProposal := connector.FindProposalFn().Get()
-	if !canFindProposal {
-		return []hostingdomain.Proposal{}
-	}
 	if args.Offline.IsTrue() {
 		return []hostingdomain.Proposal{}
 	}
@@ -171,9 +162,14 @@ func LoadProposalsOfChildBranches(args LoadProposalsOfChildBranchesArgs) []hosti
 		return []hostingdomain.Proposal{}
 	}
 	childBranches := args.Lineage.Children(args.OldBranch)
+	lookups := make([]hostingdomain.ProposalLookup, len(childBranches))
+	for c, childBranch := range childBranches {
+		lookups[c] = hostingdomain.ProposalLookup{Branch: childBranch, Target: args.OldBranch}
+	}
+	args.ProposalFinder.Preload(lookups)
 	result := make([]hostingdomain.Proposal, 0, len(childBranches))
 	for _, childBranch := range childBranches {
-		childProposalOpt, err := findProposal(childBranch, args.OldBranch)
+		childProposalOpt, err := args.ProposalFinder.Find(childBranch, args.OldBranch)
 		if err != nil {
 			print.Error(err)
 			continue
@@ -187,12 +183,25 @@ func LoadProposalsOfChildBranches(args LoadProposalsOfChildBranchesArgs) []hosti
 	return result
 }
 
+// shipProposalLookups provides the proposals that shipping the given branch via the API needs:
+// the proposal of the branch itself and the proposals of its child branches.
+func shipProposalLookups(lineage configdomain.Lineage, branch gitdomain.LocalBranchName) []hostingdomain.ProposalLookup {
+	result := []hostingdomain.ProposalLookup{}
+	if parent, hasParent := lineage.Parent(branch).Get(); hasParent {
+		result = append(result, hostingdomain.ProposalLookup{Branch: branch, Target: parent})
+	}
+	for _, child := range lineage.Children(branch) {
+		result = append(result, hostingdomain.ProposalLookup{Branch: child, Target: branch})
+	}
+	return result
+}
+
 type LoadProposalsOfChildBranchesArgs struct {
-	ConnectorOpt               Option[hostingdomain.Connector]
 	Lineage                    configdomain.Lineage
 	Offline                    configdomain.Offline
 	OldBranch                  gitdomain.LocalBranchName
 	OldBranchHasTrackingBranch bool
+	ProposalFinder             hosting.ProposalFinder
 }
 
 func FindProposal(connectorOpt Option[hostingdomain.Connector], sourceBranch gitdomain.LocalBranchName, targetBranch Option[gitdomain.LocalBranchName]) Option[hostingdomain.Proposal] {
@@ -215,3 +224,22 @@ func FindProposal(connectorOpt Option[hostingdomain.Connector], sourceBranch git
 	}
 	return proposal
 }
+
+// ensures that branches of the given type can be shipped
+func validateBranchTypeToShip(branchType configdomain.BranchType) error {
+	switch branchType {
+	case configdomain.BranchTypeContributionBranch:
+		return errors.New(messages.ContributionBranchCannotShip)
+	case configdomain.BranchTypeMainBranch:
+		return errors.New(messages.MainBranchCannotShip)
+	case configdomain.BranchTypeObservedBranch:
+		return errors.New(messages.ObservedBranchCannotShip)
+	case configdomain.BranchTypePerennialBranch:
+		return errors.New(messages.PerennialBranchCannotShip)
+	case
+		configdomain.BranchTypeFeatureBranch,
+		configdomain.BranchTypeParkedBranch,
+		configdomain.BranchTypePrototypeBranch:
+	}
+	return nil
+}
@@ -1,7 +1,7 @@
 package ship
 
 import (
-	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/execute"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/vm/opcodes"
@@ -29,7 +29,46 @@ func determineMergeData(repo execute.OpenRepoResult, branch, parent gitdomain.Lo
 	}, nil
 }
 
-func shipProgramSquashMerge(prog Mutable[program.Program], sharedData sharedShipData, squashMergeData shipDataMerge, commitMessage Option[gitdomain.CommitMessage]) {
+// determineSquashCommitTemplateData provides the data for rendering the configured squash commit template.
+// Provides None if there is no template or the user has provided a commit message.
+func determineSquashCommitTemplateData(repo execute.OpenRepoResult, sharedData sharedShipData, parent gitdomain.LocalBranchName, squashMergeData shipDataMerge, commitMessage Option[gitdomain.CommitMessage]) (Option[configdomain.ShipSquashCommitTemplateData], error) {
+	if sharedData.config.NormalConfig.ShipSquashCommitTemplate.IsNone() || commitMessage.IsSome() {
+		return None[configdomain.ShipSquashCommitTemplateData](), nil
+	}
+	commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, sharedData.branchNameToShip, parent)
+	if err != nil {
+		return None[configdomain.ShipSquashCommitTemplateData](), err
+	}
+	subjects := make([]string, len(commits))
+	for c, commit := range commits {
+		subjects[c] = commit.Message.String()
+	}
+	result := configdomain.ShipSquashCommitTemplateData{
+		Authors:   squashMergeData.authors,
+		Body:      "",
+		Branch:    sharedData.branchNameToShip.String(),
+		CoAuthors: []gitdomain.Author{}, // determined once the user has chosen the author of the squash commit
+		Commits:   subjects,
+		Number:    0,
+		Title:     "",
+		URL:       "",
+	}
+	if sharedData.config.NormalConfig.IsOnline() {
+		proposalOpt, err := sharedData.proposalFinder.Find(sharedData.branchNameToShip, parent)
+		if err != nil {
+			return None[configdomain.ShipSquashCommitTemplateData](), err
+		}
+		if proposal, hasProposal := proposalOpt.Get(); hasProposal {
+			result.Body = proposal.Body.String()
+			result.Number = proposal.Number
+			result.Title = proposal.Title
+			result.URL = proposal.URL
+		}
+	}
+	return Some(result), nil
+}
+
+func shipProgramSquashMerge(prog Mutable[program.Program], sharedData sharedShipData, squashMergeData shipDataMerge, commitMessage Option[gitdomain.CommitMessage], templateData Option[configdomain.ShipSquashCommitTemplateData]) {
 	prog.Value.Add(&opcodes.BranchEnsureShippableChanges{Branch: sharedData.branchNameToShip, Parent: sharedData.targetBranchName})
 	localTargetBranch, _ := sharedData.targetBranch.LocalName.Get()
 	if sharedData.initialBranch != sharedData.targetBranchName {
@@ -38,12 +77,13 @@ func shipProgramSquashMerge(prog Mutable[program.Program], sharedData sharedShip
 	if squashMergeData.remotes.HasDev(sharedData.config.NormalConfig.DevRemote) && sharedData.config.NormalConfig.IsOnline() {
 		UpdateChildBranchProposalsToGrandParent(prog.Value, sharedData.proposalsOfChildBranches)
 	}
-	prog.Value.Add(&opcodes.MergeSquashProgram{Authors: squashMergeData.authors, Branch: sharedData.branchNameToShip, CommitMessage: commitMessage, Parent: localTargetBranch})
+	prog.Value.Add(&opcodes.MergeSquashProgram{Authors: squashMergeData.authors, Branch: sharedData.branchNameToShip, CommitMessage: commitMessage, Parent: localTargetBranch, TemplateData: templateData})
 	if squashMergeData.remotes.HasDev(sharedData.config.NormalConfig.DevRemote) && sharedData.config.NormalConfig.IsOnline() {
 		prog.Value.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: sharedData.targetBranchName})
 	}
 	if !sharedData.dryRun {
 		prog.Value.Add(&opcodes.LineageParentRemove{Branch: sharedData.branchNameToShip})
+		prog.Value.Add(&opcodes.BranchSyncStrategyRemove{Branch: sharedData.branchNameToShip})
 	}
 	if branchToShipRemoteName, hasRemoteName := sharedData.branchToShip.RemoteName.Get(); hasRemoteName {
 		if sharedData.config.NormalConfig.IsOnline() {
@@ -59,11 +99,4 @@ func shipProgramSquashMerge(prog Mutable[program.Program], sharedData sharedShip
 		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: sharedData.initialBranch})
 	}
 	prog.Value.Add(&opcodes.BranchLocalDelete{Branch: sharedData.branchNameToShip})
-	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{sharedData.previousBranch}
-	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
-		DryRun:                   sharedData.dryRun,
-		RunInGitRoot:             true,
-		StashOpenChanges:         !sharedData.isShippingInitialBranch && sharedData.hasOpenChanges,
-		PreviousBranchCandidates: previousBranchCandidates,
-	})
 }
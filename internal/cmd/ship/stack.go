@@ -0,0 +1,159 @@
+package ship
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/validate"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// shipStackProgram adds the opcodes to ship all branches in the stack of the branch to ship,
+// starting with the oldest ancestor.
+// Each branch gets synced with the root branch after its parent got shipped, and then shipped into the root branch.
+// Indicates whether the program needs to stash open changes.
+func shipStackProgram(prog Mutable[program.Program], repo execute.OpenRepoResult, sharedData sharedShipData, commitMessage Option[gitdomain.CommitMessage], wait configdomain.ShipWait) (stashOpenChanges bool, err error) {
+	if commitMessage.IsSome() {
+		return false, errors.New(messages.ShipStackMessage)
+	}
+	if sharedData.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyMergeQueue {
+		return false, errors.New(messages.ShipStackMergeQueue)
+	}
+	if wait.Enabled() {
+		return false, errors.New(messages.ShipWaitWithoutMergeQueue)
+	}
+	lineage := sharedData.config.NormalConfig.Lineage
+	ancestors := lineage.Ancestors(sharedData.branchNameToShip)
+	if len(ancestors) == 0 {
+		return false, fmt.Errorf(messages.ShipBranchHasNoParent, sharedData.branchNameToShip)
+	}
+	rootName := ancestors[0]
+	root, hasRoot := sharedData.branchesSnapshot.Branches.FindByLocalName(rootName).Get()
+	if !hasRoot {
+		return false, fmt.Errorf(messages.BranchDoesntExist, rootName)
+	}
+	stack := sharedData.config.RemovePerennials(lineage.BranchAndAncestors(sharedData.branchNameToShip))
+	initialBranchInStack := stack.Contains(sharedData.initialBranch)
+	if initialBranchInStack {
+		if err = validate.NoOpenChanges(sharedData.hasOpenChanges); err != nil {
+			return false, err
+		}
+	}
+	remotes, err := repo.Git.Remotes(repo.Backend)
+	if err != nil {
+		return false, err
+	}
+	if sharedData.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyAPI && repo.IsOffline.IsFalse() {
+		lookups := []hostingdomain.ProposalLookup{}
+		for _, branchName := range stack {
+			lookups = append(lookups, shipProposalLookups(lineage, branchName)...)
+		}
+		sharedData.proposalFinder.Preload(lookups)
+	}
+	var shippedParent Option[gitdomain.BranchInfo]
+	for _, branchName := range stack {
+		branch, hasBranch := sharedData.branchesSnapshot.Branches.FindByLocalName(branchName).Get()
+		if !hasBranch {
+			return false, fmt.Errorf(messages.BranchDoesntExist, branchName)
+		}
+		parent, hasParent := lineage.Parent(branchName).Get()
+		if !hasParent {
+			return false, fmt.Errorf(messages.ShipBranchHasNoParent, branchName)
+		}
+		if err = validateBranchTypeToShip(sharedData.config.BranchType(branchName)); err != nil {
+			return false, err
+		}
+		branchData := sharedShipData{
+			branchNameToShip: branchName,
+			branchToShip:     *branch,
+			branchesSnapshot: sharedData.branchesSnapshot,
+			childBranches:    lineage.Children(branchName),
+			config:           sharedData.config,
+			connector:        sharedData.connector,
+			dialogTestInputs: sharedData.dialogTestInputs,
+			dryRun:           sharedData.dryRun,
+			hasOpenChanges:   sharedData.hasOpenChanges,
+			initialBranch:    sharedData.initialBranch,
+			// if the initial branch is in the stack, it gets shipped as well, so we must not check it out after shipping a branch
+			isShippingInitialBranch: initialBranchInStack,
+			previousBranch:          sharedData.previousBranch,
+			proposalFinder:          sharedData.proposalFinder,
+			proposalsOfChildBranches: LoadProposalsOfChildBranches(LoadProposalsOfChildBranchesArgs{
+				Lineage:                    lineage,
+				Offline:                    repo.IsOffline,
+				OldBranch:                  branchName,
+				OldBranchHasTrackingBranch: branch.HasTrackingBranch(),
+				ProposalFinder:             sharedData.proposalFinder,
+			}),
+			stashSize:        sharedData.stashSize,
+			targetBranch:     *root,
+			targetBranchName: rootName,
+		}
+		if err = validateSharedData(branchData, true, None[gitdomain.CommitMessage](), false); err != nil {
+			return false, err
+		}
+		if shippedParent, hasShippedParent := shippedParent.Get(); hasShippedParent {
+			shipStackSyncBranchProgram(prog, branchData, shippedParent, remotes)
+		}
+		if err = shipBranchProgram(prog, repo, branchData, parent, commitMessage, false); err != nil {
+			return false, err
+		}
+		shippedParent = Some(*branch)
+	}
+	return !initialBranchInStack && sharedData.hasOpenChanges, nil
+}
+
+// shipStackSyncBranchProgram adds the opcodes to sync the given branch with the root branch of its stack
+// after its parent branch got shipped into the root branch.
+// Ends on the root branch, ready to ship the given branch into it,
+// or on the given branch when using the rebase-merge strategy, which starts there.
+func shipStackSyncBranchProgram(prog Mutable[program.Program], data sharedShipData, shippedParent gitdomain.BranchInfo, remotes gitdomain.Remotes) {
+	isOnline := remotes.HasDev(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.IsOnline()
+	if data.config.NormalConfig.ShipStrategy == configdomain.ShipStrategyAPI && isOnline {
+		// the parent branch got shipped at the code hosting platform, download the resulting commit
+		prog.Value.Add(
+			&opcodes.CheckoutIfNeeded{Branch: data.targetBranchName},
+			&opcodes.PullCurrentBranch{},
+		)
+	}
+	prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.branchNameToShip})
+	syncStrategy := data.config.NormalConfig.SyncFeatureStrategy.SyncStrategy()
+	if data.config.BranchType(data.branchNameToShip) == configdomain.BranchTypePrototypeBranch {
+		syncStrategy = data.config.NormalConfig.SyncPrototypeStrategy.SyncStrategy()
+	}
+	switch syncStrategy {
+	case configdomain.SyncStrategyMerge, configdomain.SyncStrategyCompress:
+		prog.Value.Add(&opcodes.MergeParentIfNeeded{
+			Branch:             data.branchNameToShip,
+			OriginalParentName: shippedParent.LocalName,
+			OriginalParentSHA:  shippedParent.LocalSHA,
+		})
+	case configdomain.SyncStrategyRebase:
+		// the shipped parent branch no longer exists at this point, hence we remove its commits via its SHA
+		if shippedParentSHA, hasShippedParentSHA := shippedParent.LocalSHA.Get(); hasShippedParentSHA {
+			prog.Value.Add(&opcodes.RebaseOnto{
+				BranchToRebaseAgainst: gitdomain.NewBranchName(shippedParentSHA.String()),
+				BranchToRebaseOnto:    data.targetBranchName,
+				Upstream:              None[gitdomain.LocalBranchName](),
+			})
+		}
+	}
+	if data.branchToShip.HasTrackingBranch() && isOnline {
+		switch syncStrategy {
+		case configdomain.SyncStrategyMerge:
+			prog.Value.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: data.branchNameToShip})
+		case configdomain.SyncStrategyCompress, configdomain.SyncStrategyRebase:
+			prog.Value.Add(&opcodes.PushCurrentBranchForceIfNeeded{ForceIfIncludes: false})
+		}
+	}
+	if data.config.NormalConfig.ShipStrategy != configdomain.ShipStrategyRebaseMerge {
+		prog.Value.Add(&opcodes.CheckoutIfNeeded{Branch: data.targetBranchName})
+	}
+}
@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/cmd/ship"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v17/internal/validate"
+	fullInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/full"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const splitDesc = "Split the current branch into two stacked branches"
+
+const splitHelp = `
+Creates a new feature branch with the given name that contains the commits of the current branch up to and including the given commit, and makes it the parent of the current branch. The current branch keeps all its commits and now builds on top of the new branch.
+
+If the current branch has a proposal, pushes the new branch, updates the proposal to target the new branch, and opens a proposal for the new branch.`
+
+func splitCommand() *cobra.Command {
+	addAtFlag, readAtFlag := flags.SplitAt()
+	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "split <branch>",
+		GroupID: "stack",
+		Args:    cobra.ExactArgs(1),
+		Short:   splitDesc,
+		Long:    cmdhelpers.Long(splitDesc, splitHelp),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			at, err := readAtFlag(cmd)
+			if err != nil {
+				return err
+			}
+			dryRun, err := readDryRunFlag(cmd)
+			if err != nil {
+				return err
+			}
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeSplit(args, at, dryRun, verbose)
+		},
+	}
+	addAtFlag(&cmd)
+	addDryRunFlag(&cmd)
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeSplit(args []string, at Option[gitdomain.SHA], dryRun configdomain.DryRun, verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	data, exit, err := determineSplitData(args, at, repo, dryRun, verbose)
+	if err != nil || exit {
+		return err
+	}
+	runProgram := splitProgram(data)
+	runState := runstate.RunState{
+		BeginBranchesSnapshot: data.branchesSnapshot,
+		BeginConfigSnapshot:   repo.ConfigSnapshot,
+		BeginStashSize:        data.stashSize,
+		Command:               "split",
+		DryRun:                dryRun,
+		EndBranchesSnapshot:   None[gitdomain.BranchesSnapshot](),
+		EndConfigSnapshot:     None[undoconfig.ConfigSnapshot](),
+		EndStashSize:          None[gitdomain.StashSize](),
+		RunProgram:            runProgram,
+		TouchedBranches:       runProgram.TouchedBranches(),
+		UndoAPIProgram:        program.Program{},
+	}
+	return fullInterpreter.Execute(fullInterpreter.ExecuteArgs{
+		Backend:                 repo.Backend,
+		CommandsCounter:         repo.CommandsCounter,
+		Config:                  data.config,
+		Connector:               data.connector,
+		DialogTestInputs:        data.dialogTestInputs,
+		FinalMessages:           repo.FinalMessages,
+		Frontend:                repo.Frontend,
+		Git:                     repo.Git,
+		HasOpenChanges:          data.hasOpenChanges,
+		InitialBranch:           data.initialBranch,
+		InitialBranchesSnapshot: data.branchesSnapshot,
+		InitialConfigSnapshot:   repo.ConfigSnapshot,
+		InitialStashSize:        data.stashSize,
+		RootDir:                 repo.RootDir,
+		RunState:                runState,
+		Verbose:                 verbose,
+	})
+}
+
+type splitData struct {
+	branchesSnapshot gitdomain.BranchesSnapshot
+	config           config.ValidatedConfig
+	connector        Option[hostingdomain.Connector]
+	dialogTestInputs components.TestInputs
+	dryRun           configdomain.DryRun
+	existingParent   gitdomain.LocalBranchName
+	hasOpenChanges   bool
+	initialBranch    gitdomain.LocalBranchName
+	previousBranch   Option[gitdomain.LocalBranchName]
+	proposal         Option[hostingdomain.Proposal]
+	remotes          gitdomain.Remotes
+	splitCommit      gitdomain.SHA
+	stashSize        gitdomain.StashSize
+	targetBranch     gitdomain.LocalBranchName
+}
+
+func determineSplitData(args []string, at Option[gitdomain.SHA], repo execute.OpenRepoResult, dryRun configdomain.DryRun, verbose configdomain.Verbose) (data splitData, exit bool, err error) {
+	splitAt, hasSplitAt := at.Get()
+	if !hasSplitAt {
+		return data, false, errors.New(messages.SplitAtMissing)
+	}
+	dialogTestInputs := components.LoadTestInputs(os.Environ())
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return data, false, err
+	}
+	fc := execute.FailureCollector{}
+	branchesSnapshot, stashSize, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      dialogTestInputs,
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: true,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	previousBranch := repo.Git.PreviouslyCheckedOutBranch(repo.Backend)
+	remotes := fc.Remotes(repo.Git.Remotes(repo.Backend))
+	targetBranch := gitdomain.NewLocalBranchName(args[0])
+	if branchesSnapshot.Branches.HasLocalBranch(targetBranch) {
+		return data, false, fmt.Errorf(messages.BranchAlreadyExistsLocally, targetBranch)
+	}
+	if branchesSnapshot.Branches.HasMatchingTrackingBranchFor(targetBranch, repo.UnvalidatedConfig.NormalConfig.DevRemote) {
+		return data, false, fmt.Errorf(messages.BranchAlreadyExistsRemotely, targetBranch)
+	}
+	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
+	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
+	if !hasInitialBranch {
+		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
+	}
+	connector, err := hosting.NewConnector(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(branchesSnapshot.Branches.LocalBranches().Names())
+	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
+		Backend:            repo.Backend,
+		BranchesAndTypes:   branchesAndTypes,
+		BranchesSnapshot:   branchesSnapshot,
+		BranchesToValidate: gitdomain.LocalBranchNames{initialBranch},
+		Connector:          connector,
+		DialogTestInputs:   dialogTestInputs,
+		Frontend:           repo.Frontend,
+		Git:                repo.Git,
+		LocalBranches:      localBranches,
+		RepoStatus:         repoStatus,
+		TestInputs:         dialogTestInputs,
+		Unvalidated:        NewMutable(&repo.UnvalidatedConfig),
+	})
+	if err != nil || exit {
+		return data, exit, err
+	}
+	switch validatedConfig.BranchType(initialBranch) {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		return data, false, fmt.Errorf(messages.SplitNoFeatureBranch, initialBranch)
+	}
+	parentOpt := validatedConfig.NormalConfig.Lineage.Parent(initialBranch)
+	parent, hasParent := parentOpt.Get()
+	if !hasParent {
+		return data, false, fmt.Errorf(messages.SetParentNoFeatureBranch, initialBranch)
+	}
+	commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, initialBranch, parent)
+	if err != nil {
+		return data, false, err
+	}
+	splitCommit, err := findSplitCommit(commits, splitAt, initialBranch)
+	if err != nil {
+		return data, false, err
+	}
+	proposalOpt := ship.FindProposal(connector, initialBranch, parentOpt)
+	return splitData{
+		branchesSnapshot: branchesSnapshot,
+		config:           validatedConfig,
+		connector:        connector,
+		dialogTestInputs: dialogTestInputs,
+		dryRun:           dryRun,
+		existingParent:   parent,
+		hasOpenChanges:   repoStatus.OpenChanges,
+		initialBranch:    initialBranch,
+		previousBranch:   previousBranch,
+		proposal:         proposalOpt,
+		remotes:          remotes,
+		splitCommit:      splitCommit,
+		stashSize:        stashSize,
+		targetBranch:     targetBranch,
+	}, false, fc.Err
+}
+
+// findSplitCommit provides the full SHA of the commit in the given branch commits that the given SHA refers to.
+func findSplitCommit(commits gitdomain.Commits, at gitdomain.SHA, branch gitdomain.LocalBranchName) (gitdomain.SHA, error) {
+	matches := []int{}
+	for c, commit := range commits {
+		if strings.HasPrefix(commit.SHA.String(), at.String()) {
+			matches = append(matches, c)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf(messages.SplitCommitNotInBranch, at, branch)
+	case 1:
+		if matches[0] == len(commits)-1 {
+			return "", fmt.Errorf(messages.SplitAtLastCommit, branch)
+		}
+		return commits[matches[0]].SHA, nil
+	default:
+		return "", fmt.Errorf(messages.SplitCommitAmbiguous, at, branch)
+	}
+}
+
+func splitProgram(data splitData) program.Program {
+	prog := NewMutable(&program.Program{})
+	prog.Value.Add(
+		&opcodes.BranchCreate{
+			Branch:        data.targetBranch,
+			StartingPoint: data.splitCommit.Location(),
+		},
+		&opcodes.LineageParentSet{
+			Branch: data.targetBranch,
+			Parent: data.existingParent,
+		},
+		&opcodes.LineageParentSet{
+			Branch: data.initialBranch,
+			Parent: data.targetBranch,
+		},
+	)
+	switch data.config.NormalConfig.NewBranchType {
+	case configdomain.BranchTypePrototypeBranch:
+		prog.Value.Add(&opcodes.BranchesPrototypeAdd{Branch: data.targetBranch})
+	case configdomain.BranchTypeContributionBranch:
+		prog.Value.Add(&opcodes.BranchesContributionAdd{Branch: data.targetBranch})
+	case configdomain.BranchTypeFeatureBranch:
+	case configdomain.BranchTypeMainBranch:
+	case configdomain.BranchTypeObservedBranch:
+		prog.Value.Add(&opcodes.BranchesObservedAdd{Branch: data.targetBranch})
+	case configdomain.BranchTypeParkedBranch:
+		prog.Value.Add(&opcodes.BranchesParkedAdd{Branch: data.targetBranch})
+	case configdomain.BranchTypePerennialBranch:
+		prog.Value.Add(&opcodes.BranchesPerennialAdd{Branch: data.targetBranch})
+	}
+	proposal, hasProposal := data.proposal.Get()
+	isOnline := data.remotes.HasDev(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.IsOnline()
+	if isOnline && (data.config.NormalConfig.ShouldPushNewBranches() || hasProposal) {
+		prog.Value.Add(&opcodes.BranchTrackingCreate{Branch: data.targetBranch})
+	}
+	connector, hasConnector := data.connector.Get()
+	if isOnline && hasProposal && hasConnector {
+		if connector.UpdateProposalTargetFn().IsSome() {
+			prog.Value.Add(&opcodes.ProposalUpdateTarget{
+				NewBranch:      data.targetBranch,
+				OldBranch:      data.existingParent,
+				ProposalNumber: proposal.Number,
+			})
+		}
+		prog.Value.Add(&opcodes.ProposalCreate{
+			Branch:        data.targetBranch,
+			MainBranch:    data.config.ValidatedConfigData.MainBranch,
+			ProposalBody:  "",
+			ProposalTitle: "",
+		})
+	}
+	previousBranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
+	cmdhelpers.Wrap(prog, cmdhelpers.WrapOptions{
+		DryRun:                   data.dryRun,
+		RunInGitRoot:             true,
+		StashOpenChanges:         false,
+		PreviousBranchCandidates: previousBranchCandidates,
+	})
+	return prog.Immutable()
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/spf13/cobra"
+)
+
+const stackDesc = "Display the branch hierarchy with the status of each branch"
+
+const stackHelp = `
+Displays all branch hierarchies in your repository.
+For each branch, this command displays its type,
+whether it is in sync with its tracking branch,
+and how many commits it contains compared to its parent branch.
+
+If Git Town can talk to the API of your code hosting platform,
+this command also displays the number and URL of the open proposal of each branch.
+`
+
+func stackCommand() *cobra.Command {
+	addVerboseFlag, readVerboseFlag := flags.Verbose()
+	cmd := cobra.Command{
+		Use:     "stack",
+		GroupID: "stack",
+		Args:    cobra.NoArgs,
+		Short:   stackDesc,
+		Long:    cmdhelpers.Long(stackDesc, stackHelp),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verbose, err := readVerboseFlag(cmd)
+			if err != nil {
+				return err
+			}
+			return executeStack(verbose)
+		},
+	}
+	addVerboseFlag(&cmd)
+	return &cmd
+}
+
+func executeStack(verbose configdomain.Verbose) error {
+	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
+	})
+	if err != nil {
+		return err
+	}
+	repoStatus, err := repo.Git.RepoStatus(repo.Backend)
+	if err != nil {
+		return err
+	}
+	branchesSnapshot, _, exit, err := execute.LoadRepoSnapshot(execute.LoadRepoSnapshotArgs{
+		Backend:               repo.Backend,
+		CommandsCounter:       repo.CommandsCounter,
+		ConfigSnapshot:        repo.ConfigSnapshot,
+		DialogTestInputs:      components.LoadTestInputs(os.Environ()),
+		Fetch:                 false,
+		FinalMessages:         repo.FinalMessages,
+		Frontend:              repo.Frontend,
+		Git:                   repo.Git,
+		HandleUnfinishedState: false,
+		Repo:                  repo,
+		RepoStatus:            repoStatus,
+		RootDir:               repo.RootDir,
+		UnvalidatedConfig:     repo.UnvalidatedConfig,
+		ValidateNoOpenChanges: false,
+		Verbose:               verbose,
+	})
+	if err != nil || exit {
+		return err
+	}
+	branchInfos := branchesSnapshot.Branches
+	lineage := repo.UnvalidatedConfig.NormalConfig.Lineage
+	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(append(branchInfos.Names(), lineage.BranchNames()...))
+	entries := StackEntries(branchInfos, branchesAndTypes, lineage, repo.UnvalidatedConfig.NormalConfig.DevRemote)
+	if len(entries) == 0 {
+		return errors.New(messages.StackNoBranches)
+	}
+	for e, entry := range entries {
+		if entry.SyncStatus != gitdomain.SyncStatusNotInSync {
+			continue
+		}
+		branchInfo, hasBranchInfo := branchInfos.FindByLocalName(entry.Branch).Get()
+		if !hasBranchInfo {
+			continue
+		}
+		trackingBranch, hasTrackingBranch := branchInfo.RemoteName.Get()
+		if !hasTrackingBranch {
+			continue
+		}
+		entries[e].Ahead, entries[e].Behind, err = repo.Git.AheadBehind(repo.Backend, entry.Branch, trackingBranch)
+		if err != nil {
+			return err
+		}
+	}
+	for e, entry := range entries {
+		parent, hasParent := lineage.Parent(entry.Branch).Get()
+		if !hasParent || !branchInfos.HasLocalBranch(entry.Branch) || !branchInfos.HasLocalBranch(parent) {
+			continue
+		}
+		commits, err := repo.Git.CommitsInFeatureBranch(repo.Backend, entry.Branch, parent)
+		if err != nil {
+			return err
+		}
+		entries[e].CommitCount = Some(len(commits))
+	}
+	connector, err := hosting.NewConnector(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, print.NoLogger{})
+	if err != nil {
+		return err
+	}
+	proposalFinder, err := hosting.NewProposalFinder(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, connector, print.NoLogger{})
+	if err != nil {
+		return err
+	}
+	loadStackProposals(entries, branchInfos, lineage, proposalFinder)
+	for _, entry := range entries {
+		fmt.Println(entry.String())
+	}
+	print.Footer(verbose, *repo.CommandsCounter.Value, print.NoFinalMessages)
+	return nil
+}
+
+// StackEntry is a branch displayed by the "stack" command.
+type StackEntry struct {
+	Ahead       int // number of commits that the local branch is ahead of its tracking branch
+	Behind      int // number of commits that the local branch is behind its tracking branch
+	Branch      gitdomain.LocalBranchName
+	BranchType  configdomain.BranchType
+	CommitCount Option[int] // number of commits compared to the parent branch
+	Depth       int         // how deep this branch is nested in the branch hierarchy
+	Proposal    Option[hostingdomain.Proposal]
+	SyncStatus  gitdomain.SyncStatus
+}
+
+// String provides the line that displays this entry in the output of the "stack" command.
+func (self StackEntry) String() string {
+	details := []string{self.BranchType.String()}
+	switch {
+	case self.Ahead > 0 && self.Behind > 0:
+		details = append(details, fmt.Sprintf("%d ahead, %d behind", self.Ahead, self.Behind))
+	case self.Ahead > 0:
+		details = append(details, fmt.Sprintf("%d ahead", self.Ahead))
+	case self.Behind > 0:
+		details = append(details, fmt.Sprintf("%d behind", self.Behind))
+	default:
+		details = append(details, self.SyncStatus.String())
+	}
+	if commitCount, hasCommitCount := self.CommitCount.Get(); hasCommitCount {
+		if commitCount == 1 {
+			details = append(details, "1 commit")
+		} else {
+			details = append(details, fmt.Sprintf("%d commits", commitCount))
+		}
+	}
+	if proposal, hasProposal := self.Proposal.Get(); hasProposal {
+		details = append(details, fmt.Sprintf("proposal #%d %s", proposal.Number, proposal.URL))
+	}
+	return fmt.Sprintf("%s%s  (%s)", strings.Repeat("  ", self.Depth), self.Branch, strings.Join(details, ", "))
+}
+
+// StackEntries provides the entries for all branches in the given lineage, ordered hierarchically.
+func StackEntries(branchInfos gitdomain.BranchInfos, branchesAndTypes configdomain.BranchesAndTypes, lineage configdomain.Lineage, devRemote gitdomain.Remote) []StackEntry {
+	result := []StackEntry{}
+	roots := lineage.Roots()
+	for _, root := range roots {
+		addStackEntries(&result, root, 0, branchInfos, branchesAndTypes, lineage, devRemote)
+	}
+	// add local branches that aren't part of the lineage
+	branchesInLineage := lineage.BranchesWithParents()
+	for _, branchInfo := range branchInfos {
+		localBranch, hasLocalBranch := branchInfo.LocalName.Get()
+		if !hasLocalBranch || slices.Contains(roots, localBranch) || slices.Contains(branchesInLineage, localBranch) {
+			continue
+		}
+		addStackEntries(&result, localBranch, 0, branchInfos, branchesAndTypes, lineage, devRemote)
+	}
+	return result
+}
+
+// addStackEntries adds entries for the given branch and its descendents to the given entry list.
+func addStackEntries(result *[]StackEntry, branch gitdomain.LocalBranchName, depth int, branchInfos gitdomain.BranchInfos, branchesAndTypes configdomain.BranchesAndTypes, lineage configdomain.Lineage, devRemote gitdomain.Remote) {
+	if branchInfo, hasBranchInfo := branchInfos.FindLocalOrRemote(branch, devRemote).Get(); hasBranchInfo {
+		*result = append(*result, StackEntry{
+			Ahead:       0,
+			Behind:      0,
+			Branch:      branch,
+			BranchType:  branchesAndTypes[branch],
+			CommitCount: None[int](),
+			Depth:       depth,
+			Proposal:    None[hostingdomain.Proposal](),
+			SyncStatus:  branchInfo.SyncStatus,
+		})
+	}
+	for _, child := range lineage.Children(branch) {
+		addStackEntries(result, child, depth+1, branchInfos, branchesAndTypes, lineage, devRemote)
+	}
+}
+
+// loadStackProposals adds the proposals of the given entries from the code hosting platform to them.
+func loadStackProposals(entries []StackEntry, branchInfos gitdomain.BranchInfos, lineage configdomain.Lineage, proposalFinder hosting.ProposalFinder) {
+	lookups := []hostingdomain.ProposalLookup{}
+	for _, entry := range entries {
+		parent, hasParent := lineage.Parent(entry.Branch).Get()
+		branchInfo, hasBranchInfo := branchInfos.FindByLocalName(entry.Branch).Get()
+		if hasParent && hasBranchInfo && branchInfo.HasTrackingBranch() {
+			lookups = append(lookups, hostingdomain.ProposalLookup{Branch: entry.Branch, Target: parent})
+		}
+	}
+	proposalFinder.Preload(lookups)
+	for _, lookup := range lookups {
+		proposal, err := proposalFinder.Find(lookup.Branch, lookup.Target)
+		if err != nil {
+			print.Error(err)
+			continue
+		}
+		for e, entry := range entries {
+			if entry.Branch == lookup.Branch {
+				entries[e].Proposal = proposal
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cmd"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestStack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("StackEntries", func(t *testing.T) {
+		t.Parallel()
+		t.Run("stacked and unrelated branches", func(t *testing.T) {
+			t.Parallel()
+			alpha := gitdomain.NewLocalBranchName("alpha")
+			beta := gitdomain.NewLocalBranchName("beta")
+			main := gitdomain.NewLocalBranchName("main")
+			perennial := gitdomain.NewLocalBranchName("perennial")
+			lineage := configdomain.NewLineageWith(configdomain.LineageData{
+				alpha: main,
+				beta:  alpha,
+			})
+			branchInfos := gitdomain.BranchInfos{
+				gitdomain.BranchInfo{LocalName: Some(alpha), SyncStatus: gitdomain.SyncStatusUpToDate},
+				gitdomain.BranchInfo{LocalName: Some(beta), SyncStatus: gitdomain.SyncStatusLocalOnly},
+				gitdomain.BranchInfo{LocalName: Some(main), SyncStatus: gitdomain.SyncStatusUpToDate},
+				gitdomain.BranchInfo{LocalName: Some(perennial), SyncStatus: gitdomain.SyncStatusNotInSync},
+			}
+			branchesAndTypes := configdomain.BranchesAndTypes{
+				alpha:     configdomain.BranchTypeFeatureBranch,
+				beta:      configdomain.BranchTypePrototypeBranch,
+				main:      configdomain.BranchTypeMainBranch,
+				perennial: configdomain.BranchTypePerennialBranch,
+			}
+			have := cmd.StackEntries(branchInfos, branchesAndTypes, lineage, gitdomain.RemoteOrigin)
+			want := []cmd.StackEntry{
+				{Branch: main, BranchType: configdomain.BranchTypeMainBranch, Depth: 0, SyncStatus: gitdomain.SyncStatusUpToDate},
+				{Branch: alpha, BranchType: configdomain.BranchTypeFeatureBranch, Depth: 1, SyncStatus: gitdomain.SyncStatusUpToDate},
+				{Branch: beta, BranchType: configdomain.BranchTypePrototypeBranch, Depth: 2, SyncStatus: gitdomain.SyncStatusLocalOnly},
+				{Branch: perennial, BranchType: configdomain.BranchTypePerennialBranch, Depth: 0, SyncStatus: gitdomain.SyncStatusNotInSync},
+			}
+			must.Eq(t, want, have)
+		})
+	})
+
+	t.Run("StackEntry.String", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]cmd.StackEntry{
+			"main  (main, up to date)": {
+				Branch:     "main",
+				BranchType: configdomain.BranchTypeMainBranch,
+				SyncStatus: gitdomain.SyncStatusUpToDate,
+			},
+			"    beta  (feature, 2 ahead, 1 behind, 1 commit)": {
+				Ahead:       2,
+				Behind:      1,
+				Branch:      "beta",
+				BranchType:  configdomain.BranchTypeFeatureBranch,
+				CommitCount: Some(1),
+				Depth:       2,
+				SyncStatus:  gitdomain.SyncStatusNotInSync,
+			},
+			"  alpha  (feature, up to date, 3 commits, proposal #12 https://github.com/git-town/git-town/pull/12)": {
+				Branch:      "alpha",
+				BranchType:  configdomain.BranchTypeFeatureBranch,
+				CommitCount: Some(3),
+				Depth:       1,
+				Proposal:    Some(hostingdomain.Proposal{Number: 12, URL: "https://github.com/git-town/git-town/pull/12"}),
+				SyncStatus:  gitdomain.SyncStatusUpToDate,
+			},
+		}
+		for want, give := range tests {
+			have := give.String()
+			must.EqOp(t, want, have)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package status
+
+import (
+	"time"
+)
+
+// statusJSON is the machine-readable version of the output of "git town status".
+type statusJSON struct {
+	Outbox   []outboxEntryJSON `json:"outbox,omitempty"`
+	RunState *runStateJSON     `json:"runstate"` // nil if no runstate exists
+}
+
+// outboxEntryJSON describes a pending action at the code hosting platform in machine-readable form.
+type outboxEntryJSON struct {
+	Action      string `json:"action"`
+	Branch      string `json:"branch"`
+	Description string `json:"description"`
+}
+
+// runStateJSON describes the persisted runstate in machine-readable form.
+type runStateJSON struct {
+	CanContinue bool       `json:"canContinue"`
+	CanSkip     bool       `json:"canSkip"`
+	CanUndo     bool       `json:"canUndo"`
+	Command     string     `json:"command"`
+	EndBranch   string     `json:"endBranch,omitempty"`
+	EndTime     *time.Time `json:"endTime,omitempty"`
+	Finished    bool       `json:"finished"`
+}
+
+func newStatusJSON(data displayStatusData) statusJSON {
+	outboxEntries := make([]outboxEntryJSON, len(data.outbox))
+	for e, entry := range data.outbox {
+		outboxEntries[e] = outboxEntryJSON{
+			Action:      string(entry.Action),
+			Branch:      entry.Branch.String(),
+			Description: entry.String(),
+		}
+	}
+	state, hasState := data.state.Get()
+	if !hasState {
+		return statusJSON{Outbox: outboxEntries, RunState: nil}
+	}
+	result := runStateJSON{
+		CanContinue: false,
+		CanSkip:     false,
+		CanUndo:     true,
+		Command:     state.Command,
+		EndBranch:   "",
+		EndTime:     nil,
+		Finished:    state.IsFinished(),
+	}
+	if !result.Finished {
+		result.CanContinue = state.HasRunProgram()
+		result.CanUndo = state.HasAbortProgram()
+		if unfinishedDetails, hasUnfinishedDetails := state.UnfinishedDetails.Get(); hasUnfinishedDetails {
+			result.CanSkip = unfinishedDetails.CanSkip
+			result.EndBranch = unfinishedDetails.EndBranch.String()
+			result.EndTime = &unfinishedDetails.EndTime
+		}
+	}
+	return statusJSON{Outbox: outboxEntries, RunState: &result}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/execute"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
 	"github.com/git-town/git-town/v17/internal/vm/runstate"
 	"github.com/git-town/git-town/v17/internal/vm/statefile"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
@@ -20,6 +21,7 @@ import (
 const statusDesc = "Displays or resets the current suspended Git Town command"
 
 func RootCommand() *cobra.Command {
+	addFormatFlag, readFormatFlag := flags.Format()
 	addPendingFlag, readPendingFlag := flags.Pending()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
@@ -29,6 +31,10 @@ func RootCommand() *cobra.Command {
 		Short:   statusDesc,
 		Long:    cmdhelpers.Long(statusDesc),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			outputFormat, err := readFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
 			pending, err := readPendingFlag(cmd)
 			if err != nil {
 				return err
@@ -37,23 +43,25 @@ func RootCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return executeStatus(pending, verbose)
+			return executeStatus(outputFormat, pending, verbose)
 		},
 	}
+	addFormatFlag(&cmd)
 	addPendingFlag(&cmd)
 	addVerboseFlag(&cmd)
 	cmd.AddCommand(resetRunstateCommand())
 	return &cmd
 }
 
-func executeStatus(pending configdomain.Pending, verbose configdomain.Verbose) error {
+func executeStatus(outputFormat configdomain.OutputFormat, pending configdomain.Pending, verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		if pending {
@@ -65,6 +73,9 @@ func executeStatus(pending configdomain.Pending, verbose configdomain.Verbose) e
 	if err != nil {
 		return err
 	}
+	if outputFormat == configdomain.OutputFormatJSON {
+		return print.JSON(newStatusJSON(data))
+	}
 	displayStatus(data, pending)
 	if !pending {
 		print.Footer(verbose, *repo.CommandsCounter.Value, print.NoFinalMessages)
@@ -74,6 +85,7 @@ func executeStatus(pending configdomain.Pending, verbose configdomain.Verbose) e
 
 type displayStatusData struct {
 	filepath string                    // filepath of the runstate file
+	outbox   outbox.Outbox             // the pending actions at the code hosting platform
 	state    Option[runstate.RunState] // content of the runstate file
 }
 
@@ -86,14 +98,26 @@ func loadDisplayStatusData(rootDir gitdomain.RepoRootDir) (result displayStatusD
 	if err != nil {
 		return result, err
 	}
+	entries, err := outbox.Load(rootDir)
+	if err != nil {
+		return result, err
+	}
 	return displayStatusData{
 		filepath: filepath,
+		outbox:   entries,
 		state:    state,
 	}, nil
 }
 
 func displayStatus(data displayStatusData, pending configdomain.Pending) {
-	state, hasState := data.state.Get()
+	displayRunState(data.state, pending)
+	if !pending {
+		displayOutbox(data.outbox)
+	}
+}
+
+func displayRunState(stateOpt Option[runstate.RunState], pending configdomain.Pending) {
+	state, hasState := stateOpt.Get()
 	if !hasState {
 		if !pending {
 			fmt.Println(messages.StatusFileNotFound)
@@ -107,6 +131,17 @@ func displayStatus(data displayStatusData, pending configdomain.Pending) {
 	}
 }
 
+func displayOutbox(entries outbox.Outbox) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println(messages.OutboxIntro)
+	for _, entry := range entries {
+		fmt.Println("  - " + entry.String())
+	}
+}
+
 func displayUnfinishedStatus(state runstate.RunState, pending configdomain.Pending) {
 	unfinishedDetails, hasUnfinishedDetails := state.UnfinishedDetails.Get()
 	if pending {
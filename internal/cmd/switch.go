@@ -10,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/cli/dialog"
 	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
 	"github.com/git-town/git-town/v17/internal/cli/flags"
+	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
@@ -25,6 +26,7 @@ const switchDesc = "Display the local branches visually and allows switching bet
 func switchCmd() *cobra.Command {
 	addAllFlag, readAllFlag := flags.All("list both remote-tracking and local branches")
 	addDisplayTypesFlag, readDisplayTypesFlag := flags.Displaytypes()
+	addFormatFlag, readFormatFlag := flags.Format()
 	addMergeFlag, readMergeFlag := flags.SwitchMerge()
 	addTypeFlag, readTypeFlag := flags.BranchType()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
@@ -47,6 +49,10 @@ func switchCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			outputFormat, err := readFormatFlag(cmd)
+			if err != nil {
+				return err
+			}
 			merge, err := readMergeFlag(cmd)
 			if err != nil {
 				return err
@@ -55,25 +61,27 @@ func switchCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return executeSwitch(args, allBranches, verbose, merge, displayTypes, branchTypes)
+			return executeSwitch(args, allBranches, verbose, merge, displayTypes, branchTypes, outputFormat)
 		},
 	}
 	addAllFlag(&cmd)
 	addDisplayTypesFlag(&cmd)
+	addFormatFlag(&cmd)
 	addMergeFlag(&cmd)
 	addTypeFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeSwitch(args []string, allBranches configdomain.AllBranches, verbose configdomain.Verbose, merge configdomain.SwitchUsingMerge, displayTypes configdomain.DisplayTypes, branchTypes []configdomain.BranchType) error {
+func executeSwitch(args []string, allBranches configdomain.AllBranches, verbose configdomain.Verbose, merge configdomain.SwitchUsingMerge, displayTypes configdomain.DisplayTypes, branchTypes []configdomain.BranchType, outputFormat configdomain.OutputFormat) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -88,6 +96,9 @@ func executeSwitch(args []string, allBranches configdomain.AllBranches, verbose
 	if len(entries) == 0 {
 		return errors.New(messages.SwitchNoBranches)
 	}
+	if outputFormat == configdomain.OutputFormatJSON {
+		return print.JSON(newSwitchJSON(entries, data))
+	}
 	cursor := SwitchBranchCursorPos(entries, data.initialBranch)
 	branchToCheckout, exit, err := dialog.SwitchBranch(entries, cursor, data.uncommittedChanges, displayTypes, data.dialogInputs.Next())
 	if err != nil || exit {
@@ -108,6 +119,38 @@ func executeSwitch(args []string, allBranches configdomain.AllBranches, verbose
 	return nil
 }
 
+// switchBranchJSON is the machine-readable version of an entry displayed by "git town switch".
+type switchBranchJSON struct {
+	Branch     string `json:"branch"`
+	Current    bool   `json:"current"`
+	Parent     string `json:"parent,omitempty"`
+	SyncStatus string `json:"syncStatus"`
+	Type       string `json:"type"`
+}
+
+func newSwitchJSON(entries []dialog.SwitchBranchEntry, data switchData) []switchBranchJSON {
+	result := make([]switchBranchJSON, len(entries))
+	devRemote := data.config.NormalConfig.DevRemote
+	for e, entry := range entries {
+		var syncStatus gitdomain.SyncStatus
+		if branchInfo, hasBranchInfo := data.branchesSnapshot.Branches.FindLocalOrRemote(entry.Branch, devRemote).Get(); hasBranchInfo {
+			syncStatus = branchInfo.SyncStatus
+		}
+		parent := ""
+		if entryParent, hasParent := data.lineage.Parent(entry.Branch).Get(); hasParent {
+			parent = entryParent.String()
+		}
+		result[e] = switchBranchJSON{
+			Branch:     entry.Branch.String(),
+			Current:    entry.Branch == data.initialBranch,
+			Parent:     parent,
+			SyncStatus: syncStatus.String(),
+			Type:       entry.Type.String(),
+		}
+	}
+	return result
+}
+
 type switchData struct {
 	branchNames        gitdomain.LocalBranchNames
 	branchesSnapshot   gitdomain.BranchesSnapshot
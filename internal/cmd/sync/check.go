@@ -0,0 +1,158 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// checkBranches predicts which of the branches to sync would run into merge conflicts.
+// It simulates the sync in memory, without changing the workspace or any branches.
+func checkBranches(repo execute.OpenRepoResult, data syncData) error {
+	if !data.config.NormalConfig.GitVersion.HasMergeTreeWriteTree() {
+		return errors.New(messages.SyncCheckGitVersionTooLow)
+	}
+	checker := branchChecker{
+		conflicting: gitdomain.LocalBranchNames{},
+		data:        data,
+		repo:        repo,
+		synced:      map[gitdomain.LocalBranchName]gitdomain.SHA{},
+	}
+	for _, branchToSync := range data.branchesToSync {
+		hasLocalBranch, branch, sha := branchToSync.BranchInfo.GetLocal()
+		if !hasLocalBranch {
+			continue
+		}
+		syncStatus := branchToSync.BranchInfo.SyncStatus
+		if syncStatus == gitdomain.SyncStatusDeletedAtRemote {
+			// sync doesn't integrate changes into this branch
+			continue
+		}
+		if syncStatus == gitdomain.SyncStatusOtherWorktree && data.worktrees.FindByBranch(branch).IsNone() {
+			// sync doesn't integrate changes into branches in worktrees with uncommitted changes
+			continue
+		}
+		if err := checker.check(branch, sha, branchToSync.BranchInfo); err != nil {
+			return err
+		}
+	}
+	if len(checker.conflicting) > 0 {
+		return errors.New(messages.SyncCheckConflicts)
+	}
+	return nil
+}
+
+type branchChecker struct {
+	conflicting gitdomain.LocalBranchNames                  // the branches that would have conflicts
+	data        syncData                                    // information about the sync
+	repo        execute.OpenRepoResult                      // the repo to check
+	synced      map[gitdomain.LocalBranchName]gitdomain.SHA // the simulated result of syncing the already checked branches
+}
+
+// check simulates syncing the given branch and prints the outcome.
+func (self *branchChecker) check(branch gitdomain.LocalBranchName, sha gitdomain.SHA, branchInfo gitdomain.BranchInfo) error {
+	config := self.data.config
+	hasDevRemote := self.data.remotes.HasDev(config.NormalConfig.DevRemote)
+	result := sha
+	var conflict Option[branchConflict]
+	var err error
+	switch branchType := config.BranchType(branch); branchType {
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch:
+		if !hasDevRemote {
+			return nil
+		}
+		result, conflict, err = self.integrateTrackingBranch(result, sha, branchInfo, config.NormalConfig.SyncPerennialStrategy.SyncStrategy())
+	case configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		result, conflict, err = self.integrateTrackingBranch(result, sha, branchInfo, configdomain.SyncStrategyRebase)
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		if branchType == configdomain.BranchTypeParkedBranch && branch != self.data.initialBranch {
+			return nil
+		}
+		syncStrategy := FeatureBranchSyncStrategy(branch, branchType, config.NormalConfig.NormalConfigData)
+		if parent, hasParent := config.NormalConfig.Lineage.Parent(branch).Get(); hasParent {
+			if self.conflicting.Contains(parent) {
+				self.conflicting = append(self.conflicting, branch)
+				fmt.Printf(messages.SyncCheckParentConflicts, branch, parent)
+				return nil
+			}
+			if parentSHA, hasParentSHA := self.parentSHA(parent).Get(); hasParentSHA {
+				result, conflict, err = self.integrate(result, parentSHA, parent.BranchName(), syncStrategy)
+				if err != nil || conflict.IsSome() {
+					break
+				}
+			}
+		}
+		if syncStrategy == configdomain.SyncStrategyRebase && config.NormalConfig.Offline.IsTrue() {
+			break
+		}
+		result, conflict, err = self.integrateTrackingBranch(result, sha, branchInfo, syncStrategy)
+	}
+	if err != nil {
+		return err
+	}
+	if branchConflict, hasConflict := conflict.Get(); hasConflict {
+		self.conflicting = append(self.conflicting, branch)
+		fmt.Printf(messages.SyncCheckHasConflicts, branch, branchConflict.with, strings.Join(branchConflict.files, ", "))
+		return nil
+	}
+	self.synced[branch] = result
+	fmt.Printf(messages.SyncCheckNoConflicts, branch)
+	return nil
+}
+
+// integrate simulates integrating the given other commit into the given commit using the given sync strategy.
+func (self *branchChecker) integrate(commit, other gitdomain.SHA, otherName gitdomain.BranchName, syncStrategy configdomain.SyncStrategy) (gitdomain.SHA, Option[branchConflict], error) {
+	var result gitdomain.SHA
+	var conflictingFiles []string
+	var err error
+	switch syncStrategy {
+	case configdomain.SyncStrategyMerge, configdomain.SyncStrategyCompress:
+		result, conflictingFiles, err = self.repo.Git.MergeInMemory(self.repo.Backend, commit, other)
+	case configdomain.SyncStrategyRebase:
+		result, conflictingFiles, err = self.repo.Git.RebaseInMemory(self.repo.Backend, commit, other)
+	}
+	if err != nil {
+		return commit, None[branchConflict](), err
+	}
+	if len(conflictingFiles) > 0 {
+		return commit, Some(branchConflict{files: conflictingFiles, with: otherName}), nil
+	}
+	return result, None[branchConflict](), nil
+}
+
+// integrateTrackingBranch simulates integrating the tracking branch of the given branch into the given commit.
+func (self *branchChecker) integrateTrackingBranch(commit, originalSHA gitdomain.SHA, branchInfo gitdomain.BranchInfo, syncStrategy configdomain.SyncStrategy) (gitdomain.SHA, Option[branchConflict], error) {
+	hasTrackingBranch, trackingBranch, trackingSHA := branchInfo.GetRemoteBranch()
+	if !hasTrackingBranch {
+		return commit, None[branchConflict](), nil
+	}
+	// the tracking branch contains no new commits if the local branch contained it before the sync
+	isContained, err := self.repo.Git.IsAncestor(self.repo.Backend, trackingSHA, originalSHA)
+	if err != nil || isContained {
+		return commit, None[branchConflict](), err
+	}
+	return self.integrate(commit, trackingSHA, trackingBranch.BranchName(), syncStrategy)
+}
+
+// parentSHA provides the SHA that the given parent branch would have after the sync.
+func (self *branchChecker) parentSHA(parent gitdomain.LocalBranchName) Option[gitdomain.SHA] {
+	if sha, has := self.synced[parent]; has {
+		return Some(sha)
+	}
+	if parentInfo, hasParentInfo := self.data.branchInfos.FindLocalOrRemote(parent, self.data.config.NormalConfig.DevRemote).Get(); hasParentInfo {
+		return parentInfo.LocalSHA.Or(parentInfo.RemoteSHA)
+	}
+	return None[gitdomain.SHA]()
+}
+
+// branchConflict describes the conflicts that syncing a branch would cause.
+type branchConflict struct {
+	files []string             // the files that would have conflicts
+	with  gitdomain.BranchName // the branch whose changes would conflict
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
 	"github.com/git-town/git-town/v17/internal/cli/flags"
@@ -24,6 +25,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/vm/optimizer"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 	"github.com/git-town/git-town/v17/pkg/set"
 	"github.com/spf13/cobra"
@@ -50,8 +52,10 @@ If the repository contains an "upstream" remote, syncs the main branch with its
 
 func Cmd() *cobra.Command {
 	addAllFlag, readAllFlag := flags.All("sync all local branches")
+	addCheckFlag, readCheckFlag := flags.SyncCheck()
 	addDetachedFlag, readDetachedFlag := flags.Detached()
 	addDryRunFlag, readDryRunFlag := flags.DryRun()
+	addInferParentsFlag, readInferParentsFlag := flags.InferParents()
 	addNoPushFlag, readNoPushFlag := flags.NoPush()
 	addStackFlag, readStackFlag := flags.Stack("sync the stack that the current branch belongs to")
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
@@ -66,6 +70,10 @@ func Cmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			check, err := readCheckFlag(cmd)
+			if err != nil {
+				return err
+			}
 			detached, err := readDetachedFlag(cmd)
 			if err != nil {
 				return err
@@ -74,6 +82,10 @@ func Cmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			inferParents, err := readInferParentsFlag(cmd)
+			if err != nil {
+				return err
+			}
 			noPush, err := readNoPushFlag(cmd)
 			if err != nil {
 				return err
@@ -86,47 +98,79 @@ func Cmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return executeSync(allBranches, stack, detached, dryRun, verbose, noPush)
+			return executeSync(allBranches, stack, detached, dryRun, inferParents, verbose, noPush, check)
 		},
 	}
 	addAllFlag(&cmd)
+	addCheckFlag(&cmd)
 	addDetachedFlag(&cmd)
 	addDryRunFlag(&cmd)
+	addInferParentsFlag(&cmd)
 	addNoPushFlag(&cmd)
 	addStackFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeSync(syncAllBranches configdomain.AllBranches, syncStack configdomain.FullStack, detached configdomain.Detached, dryRun configdomain.DryRun, verbose configdomain.Verbose, pushBranches configdomain.PushBranches) error {
+func executeSync(syncAllBranches configdomain.AllBranches, syncStack configdomain.FullStack, detached configdomain.Detached, dryRun configdomain.DryRun, inferParents configdomain.InferParents, verbose configdomain.Verbose, pushBranches configdomain.PushBranches, check configdomain.SyncCheck) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           dryRun,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 dryRun,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
 	}
-	data, exit, err := determineSyncData(syncAllBranches, syncStack, repo, verbose, detached)
+	if inferParents.IsTrue() {
+		repo.UnvalidatedConfig.NormalConfig.InferParents = inferParents
+	}
+	data, exit, err := determineSyncData(syncAllBranches, syncStack, repo, verbose, detached, check)
 	if err != nil || exit {
 		return err
 	}
+	if check.IsTrue() {
+		if err = checkBranches(repo, data); err != nil {
+			return err
+		}
+		print.Footer(verbose, repo.CommandsCounter.Immutable(), repo.FinalMessages.Result())
+		return nil
+	}
 	data.config.CleanupLineage(data.branchInfos, data.nonExistingBranches, repo.FinalMessages)
 	runProgram := NewMutable(&program.Program{})
+	outboxReplayProgram(outboxReplayProgramArgs{
+		BranchInfos: data.branchInfos,
+		Config:      data.config,
+		Connector:   data.connector,
+		Entries:     data.outboxReplay,
+		Program:     runProgram,
+	})
 	branchesToDelete := set.New[gitdomain.LocalBranchName]()
 	BranchesProgram(data.branchesToSync, BranchProgramArgs{
-		BranchInfos:         data.branchInfos,
-		BranchesToDelete:    NewMutable(&branchesToDelete),
-		Config:              data.config,
-		InitialBranch:       data.initialBranch,
-		PrefetchBranchInfos: data.prefetchBranchesSnapshot.Branches,
-		Program:             runProgram,
-		PushBranches:        pushBranches,
-		Remotes:             data.remotes,
+		BranchInfos:              data.branchInfos,
+		BranchesToDelete:         NewMutable(&branchesToDelete),
+		Config:                   data.config,
+		InitialBranch:            data.initialBranch,
+		PrefetchBranchInfos:      data.prefetchBranchesSnapshot.Branches,
+		Program:                  runProgram,
+		PushBranches:             pushBranches,
+		Remotes:                  data.remotes,
+		ShippedBranches:          data.shippedBranches,
+		Worktrees:                data.worktrees,
+		WorktreesWithOpenChanges: data.worktreesWithOpenChanges,
 	})
+	if data.remotes.HasDev(data.config.NormalConfig.DevRemote) && data.config.NormalConfig.IsOnline() && dryRun.IsFalse() {
+		proposalStackProgram(proposalStackProgramArgs{
+			BranchesToSync: data.branchesToSync,
+			Config:         data.config,
+			Connector:      data.connector,
+			Program:        runProgram,
+			ProposalFinder: data.proposalFinder,
+		})
+	}
 	previousbranchCandidates := []Option[gitdomain.LocalBranchName]{data.previousBranch}
 	finalBranchCandidates := gitdomain.LocalBranchNames{data.initialBranch}
 	if previousBranch, hasPreviousBranch := data.previousBranch.Get(); hasPreviousBranch {
@@ -139,6 +183,9 @@ func executeSync(syncAllBranches configdomain.AllBranches, syncStack configdomai
 	if data.remotes.HasDev(data.config.NormalConfig.DevRemote) && data.shouldPushTags && data.config.NormalConfig.IsOnline() {
 		runProgram.Value.Add(&opcodes.PushTags{})
 	}
+	if shouldSyncMetadata(data.config.NormalConfig, data.remotes, dryRun) {
+		runProgram.Value.Add(&opcodes.SharedMetadataPush{})
+	}
 	cmdhelpers.Wrap(runProgram, cmdhelpers.WrapOptions{
 		DryRun:                   dryRun,
 		RunInGitRoot:             true,
@@ -163,7 +210,7 @@ func executeSync(syncAllBranches configdomain.AllBranches, syncStack configdomai
 		Backend:                 repo.Backend,
 		CommandsCounter:         repo.CommandsCounter,
 		Config:                  data.config,
-		Connector:               None[hostingdomain.Connector](),
+		Connector:               data.connector,
 		DialogTestInputs:        data.dialogTestInputs,
 		FinalMessages:           repo.FinalMessages,
 		Frontend:                repo.Frontend,
@@ -184,19 +231,25 @@ type syncData struct {
 	branchesSnapshot         gitdomain.BranchesSnapshot
 	branchesToSync           []configdomain.BranchToSync
 	config                   config.ValidatedConfig
+	connector                Option[hostingdomain.Connector]
 	detached                 configdomain.Detached
 	dialogTestInputs         components.TestInputs
 	hasOpenChanges           bool
 	initialBranch            gitdomain.LocalBranchName
 	nonExistingBranches      gitdomain.LocalBranchNames
+	outboxReplay             []outboxReplayEntry
 	prefetchBranchesSnapshot gitdomain.BranchesSnapshot
 	previousBranch           Option[gitdomain.LocalBranchName]
+	proposalFinder           hosting.ProposalFinder
 	remotes                  gitdomain.Remotes
+	shippedBranches          ShippedBranches
 	shouldPushTags           bool
 	stashSize                gitdomain.StashSize
+	worktrees                gitdomain.Worktrees // the other worktrees in which to sync branches
+	worktreesWithOpenChanges gitdomain.Worktrees // the other worktrees whose branches cannot get synced because they have uncommitted changes
 }
 
-func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack configdomain.FullStack, repo execute.OpenRepoResult, verbose configdomain.Verbose, detached configdomain.Detached) (data syncData, exit bool, err error) {
+func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack configdomain.FullStack, repo execute.OpenRepoResult, verbose configdomain.Verbose, detached configdomain.Detached, check configdomain.SyncCheck) (data syncData, exit bool, err error) {
 	dialogTestInputs := components.LoadTestInputs(os.Environ())
 	preFetchBranchesSnapshot, err := repo.Git.BranchesSnapshot(repo.Backend)
 	if err != nil {
@@ -211,7 +264,7 @@ func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack confi
 		CommandsCounter:       repo.CommandsCounter,
 		ConfigSnapshot:        repo.ConfigSnapshot,
 		DialogTestInputs:      dialogTestInputs,
-		Fetch:                 true,
+		Fetch:                 check.IsFalse(),
 		FinalMessages:         repo.FinalMessages,
 		Frontend:              repo.Frontend,
 		Git:                   repo.Git,
@@ -252,6 +305,11 @@ func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack confi
 	if err != nil {
 		return data, false, err
 	}
+	if check.IsFalse() && shouldSyncMetadata(repo.UnvalidatedConfig.NormalConfig, remotes, repo.UnvalidatedConfig.NormalConfig.DryRun) {
+		if err = pullSharedMetadata(repo, &repo.UnvalidatedConfig); err != nil {
+			return data, false, err
+		}
+	}
 	initialBranch, hasInitialBranch := branchesSnapshot.Active.Get()
 	if !hasInitialBranch {
 		return data, false, errors.New(messages.CurrentBranchCannotDetermine)
@@ -260,6 +318,13 @@ func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack confi
 	if err != nil {
 		return data, false, err
 	}
+	outboxReplay := []outboxReplayEntry{}
+	if check.IsFalse() && repo.IsOffline.IsFalse() && repo.UnvalidatedConfig.NormalConfig.DryRun.IsFalse() {
+		outboxReplay, err = determineOutboxReplay(repo, connector)
+		if err != nil {
+			return data, false, err
+		}
+	}
 	localBranches := branchesSnapshot.Branches.LocalBranches().Names()
 	branchesAndTypes := repo.UnvalidatedConfig.UnvalidatedBranchesAndTypes(branchesSnapshot.Branches.LocalBranches().Names())
 	validatedConfig, exit, err := validate.Config(validate.ConfigArgs{
@@ -319,26 +384,56 @@ func determineSyncData(syncAllBranches configdomain.AllBranches, syncStack confi
 	if detached {
 		allBranchNamesToSync = validatedConfig.RemovePerennials(allBranchNamesToSync)
 	}
+	// the outbox replay removes the branches that it ships
+	allBranchNamesToSync = allBranchNamesToSync.Remove(outboxShippedBranches(outboxReplay)...)
 	branchInfosToSync, nonExistingBranches := branchesSnapshot.Branches.Select(repo.UnvalidatedConfig.NormalConfig.DevRemote, allBranchNamesToSync...)
 	branchesToSync, err := BranchesToSync(branchInfosToSync, branchesSnapshot.Branches, repo, validatedConfig.ValidatedConfigData.MainBranch)
 	if err != nil {
 		return data, false, err
 	}
+	worktrees, worktreesWithOpenChanges, err := determineWorktrees(branchInfosToSync, repo)
+	if err != nil {
+		return data, false, err
+	}
+	proposalFinder, err := hosting.NewProposalFinder(repo.UnvalidatedConfig, repo.UnvalidatedConfig.NormalConfig.DevRemote, connector, print.Logger{})
+	if err != nil {
+		return data, false, err
+	}
+	shippedBranches := ShippedBranches{}
+	if check.IsFalse() {
+		shippedBranches, exit, err = determineShippedBranches(determineShippedBranchesArgs{
+			BranchInfos:      branchesSnapshot.Branches,
+			BranchesToSync:   branchesToSync,
+			Config:           validatedConfig,
+			DialogTestInputs: dialogTestInputs,
+			ProposalFinder:   proposalFinder,
+			Repo:             repo,
+		})
+		if err != nil || exit {
+			return data, exit, err
+		}
+	}
 	return syncData{
 		branchInfos:              branchesSnapshot.Branches,
 		branchesSnapshot:         branchesSnapshot,
 		branchesToSync:           branchesToSync,
 		config:                   validatedConfig,
+		connector:                connector,
 		detached:                 detached,
 		dialogTestInputs:         dialogTestInputs,
 		hasOpenChanges:           repoStatus.OpenChanges,
 		initialBranch:            initialBranch,
 		nonExistingBranches:      nonExistingBranches,
+		outboxReplay:             outboxReplay,
 		prefetchBranchesSnapshot: preFetchBranchesSnapshot,
 		previousBranch:           previousBranchOpt,
+		proposalFinder:           proposalFinder,
 		remotes:                  remotes,
+		shippedBranches:          shippedBranches,
 		shouldPushTags:           shouldPushTags,
 		stashSize:                stashSize,
+		worktrees:                worktrees,
+		worktreesWithOpenChanges: worktreesWithOpenChanges,
 	}, false, err
 }
 
@@ -377,3 +472,43 @@ func BranchesToSync(branchInfosToSync gitdomain.BranchInfos, allBranchInfos gitd
 	}
 	return result, nil
 }
+
+// determineWorktrees provides the other worktrees in which the given branches are active,
+// separated into the worktrees that can get synced and the ones that have uncommitted changes.
+func determineWorktrees(branchInfosToSync gitdomain.BranchInfos, repo execute.OpenRepoResult) (clean, withOpenChanges gitdomain.Worktrees, err error) {
+	clean = gitdomain.Worktrees{}
+	withOpenChanges = gitdomain.Worktrees{}
+	if !slices.ContainsFunc(branchInfosToSync, func(branchInfo gitdomain.BranchInfo) bool {
+		return branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree
+	}) {
+		return clean, withOpenChanges, nil
+	}
+	allWorktrees, err := repo.Git.Worktrees(repo.Backend)
+	if err != nil {
+		return clean, withOpenChanges, err
+	}
+	for _, branchInfo := range branchInfosToSync {
+		branch, hasLocalBranch := branchInfo.LocalName.Get()
+		if !hasLocalBranch || branchInfo.SyncStatus != gitdomain.SyncStatusOtherWorktree {
+			continue
+		}
+		worktree, hasWorktree := allWorktrees.FindByBranch(branch).Get()
+		if !hasWorktree {
+			continue
+		}
+		backend, _, err := shared.RunnersInWorktree(repo.Backend, repo.Frontend, Some(worktree))
+		if err != nil {
+			return clean, withOpenChanges, err
+		}
+		repoStatus, err := repo.Git.RepoStatus(backend)
+		if err != nil {
+			return clean, withOpenChanges, err
+		}
+		if repoStatus.OpenChanges {
+			withOpenChanges.Add(worktree)
+		} else {
+			clean.Add(worktree)
+		}
+	}
+	return clean, withOpenChanges, nil
+}
@@ -0,0 +1,147 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// outboxReplayEntry is an action that a previous command stored in the outbox while offline
+// and that this sync performs at the code hosting platform.
+type outboxReplayEntry struct {
+	entry    outbox.Entry
+	proposal Option[hostingdomain.Proposal] // the proposal that the action updates
+}
+
+// determineOutboxReplay provides the actions in the outbox that this sync can perform.
+// Stops at the first action it cannot perform because the later actions might depend on it.
+// That action and the remaining ones stay in the outbox for the next sync.
+func determineOutboxReplay(repo execute.OpenRepoResult, connector Option[hostingdomain.Connector]) ([]outboxReplayEntry, error) {
+	entries, err := outbox.Load(repo.RootDir)
+	if err != nil {
+		return []outboxReplayEntry{}, err
+	}
+	result := make([]outboxReplayEntry, 0, len(entries))
+	for _, entry := range entries {
+		proposal, err := findOutboxProposal(entry, connector)
+		if err != nil {
+			repo.FinalMessages.Add(fmt.Sprintf(messages.OutboxReplayProblem, entry, err))
+			break
+		}
+		result = append(result, outboxReplayEntry{
+			entry:    entry,
+			proposal: proposal,
+		})
+	}
+	return result, nil
+}
+
+// findOutboxProposal provides the proposal that the given outbox entry updates
+func findOutboxProposal(entry outbox.Entry, connectorOpt Option[hostingdomain.Connector]) (Option[hostingdomain.Proposal], error) {
+	switch entry.Action {
+	case outbox.ActionProposalMerge, outbox.ActionProposalRetarget:
+	case outbox.ActionTrackingBranchDelete:
+		return None[hostingdomain.Proposal](), nil
+	}
+	connector, hasConnector := connectorOpt.Get()
+	if !hasConnector {
+		return None[hostingdomain.Proposal](), errors.New(messages.ShipAPIConnectorRequired)
+	}
+	findProposal, canFindProposal := connector.FindProposalFn().Get()
+	if !canFindProposal {
+		return None[hostingdomain.Proposal](), hostingdomain.UnsupportedServiceError()
+	}
+	proposal, err := findProposal(entry.Branch, entry.Target.GetOrDefault())
+	if err != nil {
+		return None[hostingdomain.Proposal](), err
+	}
+	if proposal.IsNone() && entry.Action == outbox.ActionProposalMerge {
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.OutboxProposalNotFound, entry.Branch, entry.Target)
+	}
+	return proposal, nil
+}
+
+// outboxReplayProgram adds the opcodes that perform the given outbox actions to the given program.
+// Each action leaves the outbox once the code hosting platform has performed it.
+// The branches whose proposals the replay merges leave the local repository only after the merge.
+func outboxReplayProgram(args outboxReplayProgramArgs) {
+	// The outbox entries of actions that the code hosting platform has performed but that an automatic undo might still revert.
+	// They leave the outbox together with the next merge, which cannot get undone.
+	performed := []outbox.Entry{}
+	removePerformed := func() {
+		for _, entry := range performed {
+			args.Program.Value.Add(&opcodes.OutboxEntryRemove{Entry: entry})
+		}
+		performed = []outbox.Entry{}
+	}
+	for _, replayEntry := range args.Entries {
+		entry := replayEntry.entry
+		switch entry.Action {
+		case outbox.ActionProposalMerge:
+			// determineOutboxReplay has found the proposal through the connector
+			proposal := replayEntry.proposal.GetOrPanic()
+			proposalMessage := args.Connector.GetOrPanic().DefaultProposalMessage(proposal)
+			args.Program.Value.Add(&opcodes.ConnectorProposalMerge{
+				Branch:          entry.Branch,
+				CommitMessage:   Some(entry.CommitMessage.GetOrElse(gitdomain.CommitMessage(proposalMessage))),
+				ProposalMessage: proposalMessage,
+				ProposalNumber:  proposal.Number,
+			})
+			performed = append(performed, entry)
+			removePerformed()
+			if args.BranchInfos.HasLocalBranch(entry.Branch) {
+				RemoveBranchConfiguration(RemoveBranchConfigurationArgs{
+					Branch:  entry.Branch,
+					Lineage: args.Config.NormalConfig.Lineage,
+					Program: args.Program,
+				})
+				args.Program.Value.Add(
+					&opcodes.CheckoutParentOrMain{Branch: entry.Branch},
+					&opcodes.BranchLocalDelete{Branch: entry.Branch},
+				)
+			}
+		case outbox.ActionProposalRetarget:
+			if proposal, hasProposal := replayEntry.proposal.Get(); hasProposal {
+				args.Program.Value.Add(&opcodes.ProposalUpdateTarget{
+					NewBranch:      entry.NewTarget.GetOrDefault(),
+					OldBranch:      proposal.Target,
+					ProposalNumber: proposal.Number,
+				})
+			}
+			performed = append(performed, entry)
+		case outbox.ActionTrackingBranchDelete:
+			// the code hosting platform might have deleted the tracking branch already when merging the proposal
+			args.Program.Value.Add(&opcodes.BranchTrackingDelete{Branch: entry.Branch.TrackingBranch(args.Config.NormalConfig.DevRemote)})
+			performed = append(performed, entry)
+		}
+	}
+	removePerformed()
+}
+
+type outboxReplayProgramArgs struct {
+	BranchInfos gitdomain.BranchInfos
+	Config      config.ValidatedConfig
+	Connector   Option[hostingdomain.Connector]
+	Entries     []outboxReplayEntry
+	Program     Mutable[program.Program]
+}
+
+// outboxShippedBranches provides the branches whose proposals the given outbox actions merge.
+func outboxShippedBranches(entries []outboxReplayEntry) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, replayEntry := range entries {
+		if replayEntry.entry.Action == outbox.ActionProposalMerge {
+			result = append(result, replayEntry.entry.Branch)
+		}
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package sync
+
+import (
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// proposalStackProgram adds the opcodes that update the stack section
+// in the bodies of the proposals for the given synced branches.
+func proposalStackProgram(args proposalStackProgramArgs) {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return
+	}
+	if connector.FindProposalFn().IsNone() || connector.UpdateProposalBodyFn().IsNone() {
+		return
+	}
+	proposals := proposalCache{
+		config:         args.Config,
+		proposalFinder: args.ProposalFinder,
+	}
+	proposals.preload(args.BranchesToSync)
+	for _, branchToSync := range args.BranchesToSync {
+		branch, hasBranch := branchToSync.BranchInfo.LocalName.Get()
+		if !hasBranch || !branchToSync.BranchInfo.HasTrackingBranch() || !ownsProposal(args.Config, branch) {
+			continue
+		}
+		proposal, hasProposal := proposals.lookup(branch).Get()
+		if !hasProposal {
+			continue
+		}
+		section := ""
+		entries := proposals.stackEntries(branch)
+		if len(entries) > 1 {
+			section = hostingdomain.ProposalStackSection(entries, proposal.Number)
+		}
+		newBody := hostingdomain.UpdateProposalStackSection(proposal.Body, section)
+		if newBody == proposal.Body {
+			continue
+		}
+		args.Program.Value.Add(&opcodes.ProposalUpdateBody{
+			NewBody:        newBody,
+			OldBody:        proposal.Body,
+			ProposalNumber: proposal.Number,
+		})
+	}
+}
+
+type proposalStackProgramArgs struct {
+	BranchesToSync []configdomain.BranchToSync
+	Config         config.ValidatedConfig
+	Connector      Option[hostingdomain.Connector]
+	Program        Mutable[program.Program]
+	ProposalFinder hosting.ProposalFinder
+}
+
+// proposalCache loads the proposals of branches at the code hosting platform.
+type proposalCache struct {
+	config         config.ValidatedConfig
+	proposalFinder hosting.ProposalFinder
+}
+
+// lookup provides the proposal of the given branch into its parent branch.
+func (self proposalCache) lookup(branch gitdomain.LocalBranchName) Option[hostingdomain.Proposal] {
+	parent, hasParent := self.config.NormalConfig.Lineage.Parent(branch).Get()
+	if !hasParent {
+		return None[hostingdomain.Proposal]()
+	}
+	proposal, err := self.proposalFinder.Find(branch, parent)
+	if err != nil {
+		print.Error(err)
+		return None[hostingdomain.Proposal]()
+	}
+	return proposal
+}
+
+// preload loads the proposals of the given branches to sync and the branches in their stacks all at once.
+func (self proposalCache) preload(branchesToSync []configdomain.BranchToSync) {
+	lineage := self.config.NormalConfig.Lineage
+	lookups := []hostingdomain.ProposalLookup{}
+	for _, branchToSync := range branchesToSync {
+		branch, hasBranch := branchToSync.BranchInfo.LocalName.Get()
+		if !hasBranch || !branchToSync.BranchInfo.HasTrackingBranch() || !ownsProposal(self.config, branch) {
+			continue
+		}
+		for _, stackBranch := range lineage.BranchLineageWithoutRoot(branch) {
+			parent, hasParent := lineage.Parent(stackBranch).Get()
+			if hasParent && ownsProposal(self.config, stackBranch) {
+				lookups = append(lookups, hostingdomain.ProposalLookup{Branch: stackBranch, Target: parent})
+			}
+		}
+	}
+	self.proposalFinder.Preload(lookups)
+}
+
+// stackEntries provides the proposals of all branches in the lineage of the given branch.
+func (self proposalCache) stackEntries(branch gitdomain.LocalBranchName) []hostingdomain.ProposalStackEntry {
+	lineage := self.config.NormalConfig.Lineage
+	stack := lineage.BranchLineageWithoutRoot(branch)
+	result := []hostingdomain.ProposalStackEntry{}
+	for _, stackBranch := range stack {
+		if !ownsProposal(self.config, stackBranch) {
+			continue
+		}
+		proposal, hasProposal := self.lookup(stackBranch).Get()
+		if !hasProposal {
+			continue
+		}
+		depth := 0
+		for _, ancestor := range lineage.Ancestors(stackBranch) {
+			if stack.Contains(ancestor) && ownsProposal(self.config, ancestor) && self.lookup(ancestor).IsSome() {
+				depth++
+			}
+		}
+		result = append(result, hostingdomain.ProposalStackEntry{
+			Depth:    depth,
+			Proposal: proposal,
+		})
+	}
+	return result
+}
+
+// ownsProposal indicates whether the proposal of the given branch belongs to the user,
+// i.e. whether Git Town may update it.
+func ownsProposal(validatedConfig config.ValidatedConfig, branch gitdomain.LocalBranchName) bool {
+	switch validatedConfig.BranchType(branch) {
+	case configdomain.BranchTypeFeatureBranch, configdomain.BranchTypeParkedBranch, configdomain.BranchTypePrototypeBranch:
+		return true
+	case configdomain.BranchTypeMainBranch, configdomain.BranchTypePerennialBranch, configdomain.BranchTypeContributionBranch, configdomain.BranchTypeObservedBranch:
+		return false
+	}
+	return false
+}
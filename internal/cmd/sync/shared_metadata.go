@@ -0,0 +1,99 @@
+package sync
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+// pullSharedMetadata merges the branch metadata that other people have shared at the development remote
+// into the local Git configuration.
+func pullSharedMetadata(repo execute.OpenRepoResult, unvalidatedConfig *config.UnvalidatedConfig) error {
+	remote := unvalidatedConfig.NormalConfig.DevRemote
+	if err := repo.Git.FetchSharedMetadata(repo.Frontend, remote); err != nil {
+		return err
+	}
+	_, base, err := repo.Git.SharedMetadata(repo.Backend, gitdomain.SharedMetadataRef)
+	if err != nil {
+		return err
+	}
+	_, remoteMetadata, err := repo.Git.SharedMetadata(repo.Backend, gitdomain.RemoteSharedMetadataRef(remote))
+	if err != nil {
+		return err
+	}
+	localMetadata := configdomain.NewSharedMetadata(unvalidatedConfig.NormalConfig.NormalConfigData)
+	merged, conflicts := configdomain.MergeSharedMetadata(base, localMetadata, remoteMetadata)
+	for _, conflict := range conflicts {
+		repo.FinalMessages.Add(fmt.Sprintf(messages.SharedMetadataConflict, conflict.Branch, conflict.Name, formatSharedMetadataValue(conflict.Local), formatSharedMetadataValue(conflict.Remote)))
+	}
+	return applySharedMetadata(unvalidatedConfig, localMetadata, merged)
+}
+
+// applySharedMetadata updates the local Git configuration from the given local to the given merged metadata.
+func applySharedMetadata(unvalidatedConfig *config.UnvalidatedConfig, local, merged configdomain.SharedMetadata) error {
+	normalConfig := &unvalidatedConfig.NormalConfig
+	for _, branch := range configdomain.SharedMetadataBranches(local, merged) {
+		if unvalidatedConfig.IsMainOrPerennialBranch(branch) {
+			continue
+		}
+		localBranch, mergedBranch := local.Branches[branch], merged.Branches[branch]
+		if mergedBranch.Parent != localBranch.Parent {
+			switch {
+			case mergedBranch.Parent == "":
+				normalConfig.RemoveParent(branch)
+				normalConfig.Lineage = normalConfig.Lineage.RemoveBranch(branch)
+			case mergedBranch.Parent == branch, normalConfig.Lineage.IsAncestor(branch, mergedBranch.Parent):
+				// ignore parents that would create a cycle in the lineage
+			default:
+				if err := normalConfig.SetParent(branch, mergedBranch.Parent); err != nil {
+					return err
+				}
+			}
+		}
+		if mergedBranch.Type != localBranch.Type {
+			if err := setSharedBranchType(normalConfig, branch, localBranch.Type, mergedBranch.Type); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatSharedMetadataValue provides a human-readable version of the given metadata value.
+func formatSharedMetadataValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return strconv.Quote(value)
+}
+
+// shouldSyncMetadata indicates whether sync should exchange the branch metadata with the development remote.
+func shouldSyncMetadata(normalConfig config.NormalConfig, remotes gitdomain.Remotes, dryRun configdomain.DryRun) bool {
+	return normalConfig.SyncMetadata.IsTrue() && remotes.HasDev(normalConfig.DevRemote) && normalConfig.IsOnline() && dryRun.IsFalse()
+}
+
+// setSharedBranchType changes the type of the given branch from the given old to the given new shared branch type.
+func setSharedBranchType(normalConfig *config.NormalConfig, branch gitdomain.LocalBranchName, oldType, newType string) error {
+	var err error
+	switch oldType {
+	case configdomain.BranchTypeParkedBranch.String():
+		err = normalConfig.RemoveFromParkedBranches(branch)
+	case configdomain.BranchTypePrototypeBranch.String():
+		err = normalConfig.RemoveFromPrototypeBranches(branch)
+	}
+	if err != nil {
+		return err
+	}
+	switch newType {
+	case configdomain.BranchTypeParkedBranch.String():
+		return normalConfig.AddToParkedBranches(branch)
+	case configdomain.BranchTypePrototypeBranch.String():
+		return normalConfig.AddToPrototypeBranches(branch)
+	}
+	return nil
+}
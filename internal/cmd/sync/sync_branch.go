@@ -1,9 +1,13 @@
 package sync
 
 import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/cmd/cmdhelpers"
 	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
 	"github.com/git-town/git-town/v17/internal/vm/opcodes"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
@@ -21,7 +25,8 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 		}
 	}
 	trackingBranchGone := branchInfo.SyncStatus == gitdomain.SyncStatusDeletedAtRemote
-	rebaseSyncStrategy := args.Config.NormalConfig.SyncFeatureStrategy == configdomain.SyncFeatureStrategyRebase
+	_, shipped := args.ShippedBranches[localName]
+	rebaseSyncStrategy := args.Config.NormalConfig.BranchSyncStrategy(localName).GetOrElse(args.Config.NormalConfig.SyncFeatureStrategy.SyncStrategy()) == configdomain.SyncStrategyRebase
 	hasDescendents := args.Config.NormalConfig.Lineage.HasDescendents(localName)
 	parentToRemove, hasParentToRemove := args.Config.NormalConfig.Lineage.LatestAncestor(localName, args.BranchesToDelete.Value.Values()).Get()
 	if hasParentToRemove && rebaseSyncStrategy {
@@ -40,10 +45,24 @@ func BranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomain.Bra
 		// nothing to do here, we already synced with the parent
 	case rebaseSyncStrategy && trackingBranchGone && hasDescendents:
 		args.BranchesToDelete.Value.Add(localName)
+	case rebaseSyncStrategy && shipped && hasDescendents:
+		shippedTrackingBranchProgram(args.Program, localName, branchInfo, args)
+		args.BranchesToDelete.Value.Add(localName)
+	case shipped:
+		shippedBranchProgram(args.Program, localName, branchInfo, args)
 	case trackingBranchGone:
 		deletedBranchProgram(args.Program, localName, originalParentName, originalParentSHA, args)
 	case branchInfo.SyncStatus == gitdomain.SyncStatusOtherWorktree:
-		// cannot sync branches that are active in another worktree
+		if worktree, hasWorktree := args.Worktrees.FindByBranch(localName).Get(); hasWorktree {
+			args.Program.Value.Add(&opcodes.WorktreeEnter{Worktree: worktree})
+			LocalBranchProgram(localName, branchInfo, originalParentName, originalParentSHA, firstCommitMessage, args)
+			// leave the worktree after the end of the branch program so that skipping this branch leaves it as well
+			args.Program.Value.Add(&opcodes.ProgramEndOfBranch{}, &opcodes.WorktreeLeave{})
+			return
+		}
+		if worktree, hasOpenChanges := args.WorktreesWithOpenChanges.FindByBranch(localName).Get(); hasOpenChanges {
+			args.Program.Value.Add(&opcodes.MessageQueue{Message: fmt.Sprintf(messages.SyncWorktreeOpenChanges, localName, worktree.Dir)})
+		}
 	default:
 		LocalBranchProgram(localName, branchInfo, originalParentName, originalParentSHA, firstCommitMessage, args)
 	}
@@ -59,6 +78,11 @@ type BranchProgramArgs struct {
 	Program             Mutable[program.Program]
 	PushBranches        configdomain.PushBranches
 	Remotes             gitdomain.Remotes
+	ShippedBranches     ShippedBranches // branches that somebody squash-merged or rebase-merged into their parent and that sync should delete
+	// the other worktrees in which to sync the branches that are active there
+	Worktrees gitdomain.Worktrees
+	// the other worktrees that have uncommitted changes, their branches don't get synced
+	WorktreesWithOpenChanges gitdomain.Worktrees
 }
 
 // LocalBranchProgram provides the program to sync a local branch.
@@ -69,10 +93,11 @@ func LocalBranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomai
 		return
 	}
 	args.Program.Value.Add(&opcodes.CheckoutIfNeeded{Branch: localName})
+	cmdhelpers.AddHook(args.Program, args.Config.NormalConfig.NormalConfigData, configdomain.HookBeforeSyncBranch, localName)
 	branchType := args.Config.BranchType(localName)
 	switch branchType {
 	case configdomain.BranchTypeFeatureBranch:
-		FeatureBranchProgram(args.Config.NormalConfig.SyncFeatureStrategy.SyncStrategy(), featureBranchArgs{
+		FeatureBranchProgram(FeatureBranchSyncStrategy(localName, branchType, args.Config.NormalConfig.NormalConfigData), featureBranchArgs{
 			firstCommitMessage: firstCommitMessage,
 			localName:          localName,
 			offline:            args.Config.NormalConfig.Offline,
@@ -87,7 +112,7 @@ func LocalBranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomai
 		configdomain.BranchTypeMainBranch:
 		PerennialBranchProgram(branchInfo, args)
 	case configdomain.BranchTypeParkedBranch:
-		ParkedBranchProgram(args.Config.NormalConfig.SyncFeatureStrategy.SyncStrategy(), args.InitialBranch, featureBranchArgs{
+		ParkedBranchProgram(FeatureBranchSyncStrategy(localName, branchType, args.Config.NormalConfig.NormalConfigData), args.InitialBranch, featureBranchArgs{
 			firstCommitMessage: firstCommitMessage,
 			localName:          localName,
 			offline:            args.Config.NormalConfig.Offline,
@@ -102,7 +127,7 @@ func LocalBranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomai
 	case configdomain.BranchTypeObservedBranch:
 		ObservedBranchProgram(branchInfo.RemoteName, args.Program)
 	case configdomain.BranchTypePrototypeBranch:
-		FeatureBranchProgram(args.Config.NormalConfig.SyncPrototypeStrategy.SyncStrategy(), featureBranchArgs{
+		FeatureBranchProgram(FeatureBranchSyncStrategy(localName, branchType, args.Config.NormalConfig.NormalConfigData), featureBranchArgs{
 			firstCommitMessage: firstCommitMessage,
 			localName:          localName,
 			offline:            args.Config.NormalConfig.Offline,
@@ -120,7 +145,7 @@ func LocalBranchProgram(localName gitdomain.LocalBranchName, branchInfo gitdomai
 		case isMainOrPerennialBranch:
 			args.Program.Value.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: localName})
 		default:
-			pushFeatureBranchProgram(args.Program, localName, args.Config.NormalConfig.SyncFeatureStrategy)
+			pushFeatureBranchProgram(args.Program, localName, FeatureBranchSyncStrategy(localName, branchType, args.Config.NormalConfig.NormalConfigData))
 		}
 	}
 }
@@ -155,13 +180,13 @@ type pullParentBranchOfCurrentFeatureBranchOpcodeArgs struct {
 	syncStrategy       configdomain.SyncFeatureStrategy
 }
 
-func pushFeatureBranchProgram(prog Mutable[program.Program], branch gitdomain.LocalBranchName, syncFeatureStrategy configdomain.SyncFeatureStrategy) {
-	switch syncFeatureStrategy {
-	case configdomain.SyncFeatureStrategyMerge:
+func pushFeatureBranchProgram(prog Mutable[program.Program], branch gitdomain.LocalBranchName, syncStrategy configdomain.SyncStrategy) {
+	switch syncStrategy {
+	case configdomain.SyncStrategyMerge:
 		prog.Value.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branch})
-	case configdomain.SyncFeatureStrategyRebase:
+	case configdomain.SyncStrategyRebase:
 		prog.Value.Add(&opcodes.PushCurrentBranchForceIfNeeded{ForceIfIncludes: true})
-	case configdomain.SyncFeatureStrategyCompress:
+	case configdomain.SyncStrategyCompress:
 		prog.Value.Add(&opcodes.PushCurrentBranchForceIfNeeded{ForceIfIncludes: false})
 	}
 }
@@ -16,6 +16,7 @@ func BranchesProgram(branchesToSync []configdomain.BranchToSync, args BranchProg
 		args.Program.Value.Add(
 			&opcodes.BranchLocalDelete{Branch: branchToDelete},
 			&opcodes.LineageBranchRemove{Branch: branchToDelete},
+			&opcodes.BranchSyncStrategyRemove{Branch: branchToDelete},
 		)
 	}
 }
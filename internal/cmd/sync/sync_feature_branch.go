@@ -22,6 +22,18 @@ func FeatureBranchProgram(syncStrategy configdomain.SyncStrategy, args featureBr
 	}
 }
 
+// FeatureBranchSyncStrategy provides the sync strategy for the given feature, parked, or prototype branch.
+// The sync strategy configured for this particular branch takes precedence over the one for its branch type.
+func FeatureBranchSyncStrategy(branch gitdomain.LocalBranchName, branchType configdomain.BranchType, config configdomain.NormalConfigData) configdomain.SyncStrategy {
+	if syncStrategy, hasSyncStrategy := config.BranchSyncStrategy(branch).Get(); hasSyncStrategy {
+		return syncStrategy
+	}
+	if branchType == configdomain.BranchTypePrototypeBranch {
+		return config.SyncPrototypeStrategy.SyncStrategy()
+	}
+	return config.SyncFeatureStrategy.SyncStrategy()
+}
+
 type featureBranchArgs struct {
 	firstCommitMessage Option[gitdomain.CommitMessage]
 	localName          gitdomain.LocalBranchName
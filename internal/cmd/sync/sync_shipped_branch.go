@@ -0,0 +1,135 @@
+package sync
+
+import (
+	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/cli/dialog/components"
+	"github.com/git-town/git-town/v17/internal/cmd/ship"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/execute"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/program"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// ShippedBranches contains the branches that somebody squash-merged or rebase-merged into their parent branch
+// and that sync should delete, together with the proposals of their child branches.
+type ShippedBranches map[gitdomain.LocalBranchName][]hostingdomain.Proposal
+
+// determineShippedBranches finds the given branches whose changes already exist in their parent branch
+// and lets the user select which of them to delete.
+func determineShippedBranches(args determineShippedBranchesArgs) (ShippedBranches, bool, error) {
+	result := ShippedBranches{}
+	if !args.Config.NormalConfig.GitVersion.HasMergeTreeWriteTree() {
+		return result, false, nil
+	}
+	candidates := gitdomain.LocalBranchNames{}
+	for _, branchToSync := range args.BranchesToSync {
+		branch, hasLocalBranch := branchToSync.BranchInfo.LocalName.Get()
+		if !hasLocalBranch || !isShippedBranchCandidate(args.Config, branch, branchToSync.BranchInfo.SyncStatus) {
+			continue
+		}
+		parent, hasParent := args.Config.NormalConfig.Lineage.Parent(branch).Get()
+		if !hasParent {
+			continue
+		}
+		parentBranchInfo, hasParentBranchInfo := args.BranchInfos.FindLocalOrRemote(parent, args.Config.NormalConfig.DevRemote).Get()
+		if !hasParentBranchInfo {
+			continue
+		}
+		// compare against the tracking branch of the parent because it contains the latest updates from origin
+		parentToCompare := parent.BranchName()
+		if hasParentRemoteBranch, parentRemoteBranch, _ := parentBranchInfo.HasRemoteBranch(); hasParentRemoteBranch {
+			parentToCompare = parentRemoteBranch.BranchName()
+		}
+		shipped, err := args.Repo.Git.BranchShippedInto(args.Repo.Backend, branch, parentToCompare)
+		if err != nil {
+			return result, false, err
+		}
+		if shipped {
+			candidates = append(candidates, branch)
+		}
+	}
+	if len(candidates) == 0 {
+		return result, false, nil
+	}
+	branchesToDelete, aborted, err := dialog.ShippedBranches(candidates, args.DialogTestInputs.Next())
+	if err != nil || aborted {
+		return result, aborted, err
+	}
+	for _, branchToDelete := range branchesToDelete {
+		hasTrackingBranch := false
+		if branchInfo, hasBranchInfo := args.BranchInfos.FindByLocalName(branchToDelete).Get(); hasBranchInfo {
+			hasTrackingBranch = branchInfo.HasTrackingBranch()
+		}
+		result[branchToDelete] = ship.LoadProposalsOfChildBranches(ship.LoadProposalsOfChildBranchesArgs{
+			Lineage:                    args.Config.NormalConfig.Lineage,
+			Offline:                    args.Config.NormalConfig.Offline,
+			OldBranch:                  branchToDelete,
+			OldBranchHasTrackingBranch: hasTrackingBranch,
+			ProposalFinder:             args.ProposalFinder,
+		})
+	}
+	return result, false, nil
+}
+
+type determineShippedBranchesArgs struct {
+	BranchInfos      gitdomain.BranchInfos
+	BranchesToSync   []configdomain.BranchToSync
+	Config           config.ValidatedConfig
+	DialogTestInputs components.TestInputs
+	ProposalFinder   hosting.ProposalFinder
+	Repo             execute.OpenRepoResult
+}
+
+// isShippedBranchCandidate indicates whether sync should check if the given branch was shipped.
+// Branches that aren't in sync with their tracking branch need to get synced first.
+func isShippedBranchCandidate(config config.ValidatedConfig, branch gitdomain.LocalBranchName, syncStatus gitdomain.SyncStatus) bool {
+	switch config.BranchType(branch) {
+	case
+		configdomain.BranchTypeFeatureBranch,
+		configdomain.BranchTypeParkedBranch,
+		configdomain.BranchTypePrototypeBranch:
+	case
+		configdomain.BranchTypeMainBranch,
+		configdomain.BranchTypePerennialBranch,
+		configdomain.BranchTypeContributionBranch,
+		configdomain.BranchTypeObservedBranch:
+		return false
+	}
+	switch syncStatus {
+	case
+		gitdomain.SyncStatusUpToDate,
+		gitdomain.SyncStatusAhead,
+		gitdomain.SyncStatusLocalOnly:
+		return true
+	case
+		gitdomain.SyncStatusBehind,
+		gitdomain.SyncStatusNotInSync,
+		gitdomain.SyncStatusDeletedAtRemote,
+		gitdomain.SyncStatusRemoteOnly,
+		gitdomain.SyncStatusOtherWorktree:
+		return false
+	}
+	return false
+}
+
+// shippedBranchProgram adds opcodes that delete the given shipped branch and its tracking branch to the given program.
+func shippedBranchProgram(prog Mutable[program.Program], branch gitdomain.LocalBranchName, branchInfo gitdomain.BranchInfo, args BranchProgramArgs) {
+	shippedTrackingBranchProgram(prog, branch, branchInfo, args)
+	syncDeleteLocalBranchProgram(prog, branch, args)
+}
+
+// shippedTrackingBranchProgram adds opcodes that delete the tracking branch of the given shipped branch to the given program
+// and retarget the proposals of its child branches.
+func shippedTrackingBranchProgram(prog Mutable[program.Program], branch gitdomain.LocalBranchName, branchInfo gitdomain.BranchInfo, args BranchProgramArgs) {
+	hasTrackingBranch, trackingBranch, _ := branchInfo.HasRemoteBranch()
+	if !hasTrackingBranch || !args.Config.NormalConfig.IsOnline() {
+		return
+	}
+	ship.UpdateChildBranchProposalsToGrandParent(prog.Value, args.ShippedBranches[branch])
+	prog.Value.Add(&opcodes.BranchTrackingDelete{Branch: trackingBranch})
+}
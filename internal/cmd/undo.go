@@ -24,34 +24,45 @@ import (
 
 const undoDesc = "Undo the most recent Git Town command"
 
+const undoHelp = `
+With the --to flag, undoes the given number of most recent commands
+as listed by "git town history", the most recent command first.`
+
 func undoCmd() *cobra.Command {
+	addToFlag, readToFlag := flags.UndoTo()
 	addVerboseFlag, readVerboseFlag := flags.Verbose()
 	cmd := cobra.Command{
 		Use:     "undo",
 		GroupID: "errors",
 		Args:    cobra.NoArgs,
 		Short:   undoDesc,
-		Long:    cmdhelpers.Long(undoDesc),
+		Long:    cmdhelpers.Long(undoDesc, undoHelp),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			to, err := readToFlag(cmd)
+			if err != nil {
+				return err
+			}
 			verbose, err := readVerboseFlag(cmd)
 			if err != nil {
 				return err
 			}
-			return executeUndo(verbose)
+			return executeUndo(to, verbose)
 		},
 	}
+	addToFlag(&cmd)
 	addVerboseFlag(&cmd)
 	return &cmd
 }
 
-func executeUndo(verbose configdomain.Verbose) error {
+func executeUndo(to Option[int], verbose configdomain.Verbose) error {
 	repo, err := execute.OpenRepo(execute.OpenRepoArgs{
-		DryRun:           false,
-		PrintBranchNames: true,
-		PrintCommands:    true,
-		ValidateGitRepo:  true,
-		ValidateIsOnline: false,
-		Verbose:          verbose,
+		DryRun:                 false,
+		PrintBranchNames:       true,
+		PrintCommands:          true,
+		UpdateOutdatedSettings: true,
+		ValidateGitRepo:        true,
+		ValidateIsOnline:       false,
+		Verbose:                verbose,
 	})
 	if err != nil {
 		return err
@@ -65,7 +76,11 @@ func executeUndo(verbose configdomain.Verbose) error {
 		return fmt.Errorf(messages.RunstateLoadProblem, err)
 	}
 	runState, hasRunState := runStateOpt.Get()
-	if !hasRunState {
+	count, hasCount := to.Get()
+	if hasCount && hasRunState && !runState.IsFinished() {
+		return fmt.Errorf(messages.UndoToUnfinished, runState.Command)
+	}
+	if !hasRunState && !hasCount {
 		fmt.Println(messages.UndoNothingToDo)
 		return nil
 	}
@@ -73,7 +88,7 @@ func executeUndo(verbose configdomain.Verbose) error {
 	if err != nil {
 		return err
 	}
-	return undo.Execute(undo.ExecuteArgs{
+	args := undo.ExecuteArgs{
 		Backend:          repo.Backend,
 		CommandsCounter:  repo.CommandsCounter,
 		Config:           data.config,
@@ -86,7 +101,11 @@ func executeUndo(verbose configdomain.Verbose) error {
 		RootDir:          repo.RootDir,
 		RunState:         runState,
 		Verbose:          verbose,
-	})
+	}
+	if hasCount {
+		return undo.ExecuteJournal(args, count)
+	}
+	return undo.Execute(args)
 }
 
 type undoData struct {
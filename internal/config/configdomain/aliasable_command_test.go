@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
 	"github.com/shoenig/test/must"
 )
 
@@ -16,6 +17,20 @@ func TestAliasableCommand(t *testing.T) {
 		must.EqOp(t, configdomain.AliasableCommandSync.Key().Key(), configdomain.KeyAliasSync)
 	})
 
+	t.Run("LookupCommand", func(t *testing.T) {
+		t.Parallel()
+		t.Run("known command", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.AllAliasableCommands().LookupCommand("diff-parent")
+			must.Eq(t, Some(configdomain.AliasableCommandDiffParent), have)
+		})
+		t.Run("unknown command", func(t *testing.T) {
+			t.Parallel()
+			have := configdomain.AllAliasableCommands().LookupCommand("zonk")
+			must.Eq(t, None[configdomain.AliasableCommand](), have)
+		})
+	})
+
 	t.Run("Strings", func(t *testing.T) {
 		t.Parallel()
 		give := configdomain.AliasableCommands{
@@ -4,6 +4,16 @@ import . "github.com/git-town/git-town/v17/pkg/prelude"
 
 type AliasableCommands []AliasableCommand
 
+// provides the AliasableCommand with the given name
+func (self AliasableCommands) LookupCommand(name string) Option[AliasableCommand] {
+	for _, aliasableCommand := range self {
+		if aliasableCommand.String() == name {
+			return Some(aliasableCommand)
+		}
+	}
+	return None[AliasableCommand]()
+}
+
 // provides the AliasKey matching the given key name
 func (self AliasableCommands) LookupKey(name string) Option[AliasKey] {
 	for _, aliasableCommand := range self {
@@ -0,0 +1,22 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// AzureDevOpsToken is a personal access token to use with the Azure DevOps API.
+type AzureDevOpsToken string
+
+func (self AzureDevOpsToken) String() string {
+	return string(self)
+}
+
+func ParseAzureDevOpsToken(value string) Option[AzureDevOpsToken] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[AzureDevOpsToken]()
+	}
+	return Some(AzureDevOpsToken(value))
+}
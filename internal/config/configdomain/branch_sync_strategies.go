@@ -0,0 +1,36 @@
+package configdomain
+
+import (
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"golang.org/x/exp/maps"
+)
+
+// BranchSyncStrategies contains the sync strategies that the user has configured for individual branches.
+type BranchSyncStrategies map[gitdomain.LocalBranchName]SyncStrategy
+
+func NewBranchSyncStrategiesFromSnapshot(snapshot SingleSnapshot) (BranchSyncStrategies, error) {
+	result := BranchSyncStrategies{}
+	for key, value := range snapshot.BranchSyncStrategyEntries() {
+		branchName := key.BranchName()
+		if branchName == "" {
+			continue
+		}
+		syncStrategyOpt, err := ParseSyncStrategy(value)
+		if err != nil {
+			return result, err
+		}
+		if syncStrategy, hasSyncStrategy := syncStrategyOpt.Get(); hasSyncStrategy {
+			result[gitdomain.NewLocalBranchName(branchName)] = syncStrategy
+		}
+	}
+	return result, nil
+}
+
+// Branches provides the names of all branches that have a sync strategy, sorted alphabetically.
+func (self BranchSyncStrategies) Branches() gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames(maps.Keys(self))
+	slices.Sort(result)
+	return result
+}
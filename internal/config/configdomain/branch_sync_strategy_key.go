@@ -0,0 +1,42 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// a Key that contains the sync strategy of an individual branch
+type BranchSyncStrategyKey Key
+
+// NewBranchSyncStrategyKey indicates using the returned option whether this key is a branch sync strategy key.
+func NewBranchSyncStrategyKey(key Key) Option[BranchSyncStrategyKey] {
+	if isBranchSyncStrategyKey(key.String()) {
+		return Some(BranchSyncStrategyKey(key))
+	}
+	return None[BranchSyncStrategyKey]()
+}
+
+// provides the name of the branch encoded in this BranchSyncStrategyKey
+func (self BranchSyncStrategyKey) BranchName() string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(self.String(), BranchSyncStrategyKeyPrefix), BranchSyncStrategyKeySuffix))
+}
+
+// converts this BranchSyncStrategyKey into a generic Key
+func (self BranchSyncStrategyKey) Key() Key {
+	return Key(self)
+}
+
+func (self BranchSyncStrategyKey) String() string {
+	return string(self)
+}
+
+const (
+	BranchSyncStrategyKeyPrefix = "git-town-branch."
+	BranchSyncStrategyKeySuffix = ".sync-strategy"
+)
+
+// indicates whether the given key value is for a BranchSyncStrategyKey
+func isBranchSyncStrategyKey(key string) bool {
+	return strings.HasPrefix(key, BranchSyncStrategyKeyPrefix) && strings.HasSuffix(key, BranchSyncStrategyKeySuffix)
+}
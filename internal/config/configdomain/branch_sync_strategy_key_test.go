@@ -0,0 +1,35 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchSyncStrategyKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("BranchName", func(t *testing.T) {
+		t.Parallel()
+		key := configdomain.BranchSyncStrategyKey("git-town-branch.foo.sync-strategy")
+		have := key.BranchName()
+		want := "foo"
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("NewBranchSyncStrategyKey", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]Option[configdomain.BranchSyncStrategyKey]{
+			"git-town-branch.branch.sync-strategy": Some(configdomain.BranchSyncStrategyKey("git-town-branch.branch.sync-strategy")), // valid key
+			"git-town-branch.branch.parent":        None[configdomain.BranchSyncStrategyKey](),                                       // lineage key
+			"git-town.sync-feature-strategy":       None[configdomain.BranchSyncStrategyKey](),                                       // not a branch key
+		}
+		for give, want := range tests {
+			key := configdomain.Key(give)
+			have := configdomain.NewBranchSyncStrategyKey(key)
+			must.Eq(t, want, have)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package configdomain
+
+import (
+	"path"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// BranchSyncStrategyPattern defines the sync strategy for all branches whose name matches the given glob pattern.
+type BranchSyncStrategyPattern struct {
+	Pattern      string
+	SyncStrategy SyncStrategy
+}
+
+// Matches indicates whether the given branch name matches the pattern of this BranchSyncStrategyPattern.
+func (self BranchSyncStrategyPattern) Matches(branch gitdomain.LocalBranchName) bool {
+	matches, err := path.Match(self.Pattern, branch.String())
+	return err == nil && matches
+}
+
+// BranchSyncStrategyPatterns contains the sync strategies that the user has configured for branch name patterns.
+type BranchSyncStrategyPatterns []BranchSyncStrategyPattern
+
+// Lookup provides the sync strategy of the longest pattern that matches the given branch.
+func (self BranchSyncStrategyPatterns) Lookup(branch gitdomain.LocalBranchName) Option[SyncStrategy] {
+	result := None[SyncStrategy]()
+	longestPattern := -1
+	for _, pattern := range self {
+		if len(pattern.Pattern) > longestPattern && pattern.Matches(branch) {
+			result = Some(pattern.SyncStrategy)
+			longestPattern = len(pattern.Pattern)
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestBranchSyncStrategyPatterns(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Lookup", func(t *testing.T) {
+		t.Parallel()
+		patterns := configdomain.BranchSyncStrategyPatterns{
+			{Pattern: "personal/*", SyncStrategy: configdomain.SyncStrategyRebase},
+			{Pattern: "personal/shared-*", SyncStrategy: configdomain.SyncStrategyMerge},
+			{Pattern: "release", SyncStrategy: configdomain.SyncStrategyCompress},
+		}
+		tests := map[gitdomain.LocalBranchName]Option[configdomain.SyncStrategy]{
+			"personal/feature":    Some(configdomain.SyncStrategyRebase),   // matches one pattern
+			"personal/shared-one": Some(configdomain.SyncStrategyMerge),    // the longest matching pattern wins
+			"release":             Some(configdomain.SyncStrategyCompress), // exact branch name
+			"personal/sub/branch": None[configdomain.SyncStrategy](),       // * doesn't match slashes
+			"other":               None[configdomain.SyncStrategy](),       // no match
+		}
+		for give, want := range tests {
+			have := patterns.Lookup(give)
+			must.Eq(t, want, have)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package configdomain
+
+import . "github.com/git-town/git-town/v17/pkg/prelude"
+
+// ConfigSource describes where the value of a configuration setting comes from.
+type ConfigSource string
+
+const (
+	ConfigSourceConfigFile ConfigSource = "config-file" // the setting is defined in the config file
+	ConfigSourceDefault    ConfigSource = "default"     // the setting isn't defined anywhere, Git Town uses its default value
+	ConfigSourceGlobal     ConfigSource = "global"      // the setting is defined in the global Git metadata
+	ConfigSourceLocal      ConfigSource = "local"       // the setting is defined in the local Git metadata
+)
+
+func (self ConfigSource) String() string {
+	return string(self)
+}
+
+// DetermineConfigSource provides where the setting that the given function checks for is defined.
+// Local Git metadata overrides global Git metadata, which overrides the config file.
+func DetermineConfigSource(configFile Option[PartialConfig], global, local PartialConfig, isSet func(PartialConfig) bool) ConfigSource {
+	if isSet(local) {
+		return ConfigSourceLocal
+	}
+	if isSet(global) {
+		return ConfigSourceGlobal
+	}
+	if file, hasFile := configFile.Get(); hasFile && isSet(file) {
+		return ConfigSourceConfigFile
+	}
+	return ConfigSourceDefault
+}
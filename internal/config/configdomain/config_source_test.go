@@ -0,0 +1,43 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestDetermineConfigSource(t *testing.T) {
+	t.Parallel()
+	hasOffline := func(config configdomain.PartialConfig) bool {
+		return config.Offline.IsSome()
+	}
+	empty := configdomain.EmptyPartialConfig()
+	offline := configdomain.EmptyPartialConfig()
+	offline.Offline = Some(configdomain.Offline(true))
+
+	t.Run("local overrides everything else", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.DetermineConfigSource(Some(offline), offline, offline, hasOffline)
+		must.EqOp(t, configdomain.ConfigSourceLocal, have)
+	})
+
+	t.Run("global overrides the config file", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.DetermineConfigSource(Some(offline), offline, empty, hasOffline)
+		must.EqOp(t, configdomain.ConfigSourceGlobal, have)
+	})
+
+	t.Run("config file", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.DetermineConfigSource(Some(offline), empty, empty, hasOffline)
+		must.EqOp(t, configdomain.ConfigSourceConfigFile, have)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+		have := configdomain.DetermineConfigSource(None[configdomain.PartialConfig](), empty, empty, hasOffline)
+		must.EqOp(t, configdomain.ConfigSourceDefault, have)
+	})
+}
@@ -0,0 +1,36 @@
+package configdomain
+
+import (
+	"strings"
+
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// Hook is a well-defined point in the execution of Git Town commands
+// at which Git Town runs a user-provided shell command.
+type Hook string
+
+const (
+	HookAfterCreateBranch Hook = "after-create-branch" // after hack, append, or prepend created a new branch
+	HookAfterShip         Hook = "after-ship"          // after a branch got shipped successfully
+	HookBeforeSyncBranch  Hook = "before-sync-branch"  // before Git Town syncs a branch
+)
+
+func (self Hook) String() string {
+	return string(self)
+}
+
+// HookCommand is the shell command that the user wants to run at a Hook.
+type HookCommand string
+
+func (self HookCommand) String() string {
+	return string(self)
+}
+
+func ParseHookCommand(value string) Option[HookCommand] {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return None[HookCommand]()
+	}
+	return Some(HookCommand(value))
+}
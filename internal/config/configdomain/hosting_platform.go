@@ -14,6 +14,7 @@ type HostingPlatform string
 func (self HostingPlatform) String() string { return string(self) }
 
 const (
+	HostingPlatformAzureDevOps         = HostingPlatform("azuredevops")
 	HostingPlatformBitbucket           = HostingPlatform("bitbucket")
 	HostingPlatformBitbucketDatacenter = HostingPlatform("bitbucket-datacenter")
 	HostingPlatformGitHub              = HostingPlatform("github")
@@ -38,6 +39,7 @@ func ParseHostingPlatform(platformName string) (Option[HostingPlatform], error)
 // hostingPlatforms provides all legal values for HostingPlatform.
 func hostingPlatforms() []HostingPlatform {
 	return []HostingPlatform{
+		HostingPlatformAzureDevOps,
 		HostingPlatformBitbucket,
 		HostingPlatformBitbucketDatacenter,
 		HostingPlatformGitHub,
@@ -0,0 +1,67 @@
+package configdomain
+
+import (
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/gohacks/slice"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// InferParent provides the most likely parent of the given branch.
+// This is the candidate branch whose fork point with the given branch is the closest to the tip of the given branch.
+// If several candidates fork at the same point, the one with the fewest own commits wins,
+// and after that the main branch wins over perennial branches, which win over feature branches.
+func InferParent(args InferParentArgs) (Option[gitdomain.LocalBranchName], error) {
+	result := None[gitdomain.LocalBranchName]()
+	bestAhead := 0
+	bestBehind := 0
+	for _, candidate := range InferParentCandidates(args) {
+		ahead, behind, err := args.AheadBehind(args.Branch, candidate)
+		if err != nil {
+			return None[gitdomain.LocalBranchName](), err
+		}
+		isRoot := candidate == args.MainBranch || slices.Contains(args.PerennialBranches, candidate)
+		if ahead == 0 && behind > 0 && !isRoot {
+			// the candidate contains all commits of the branch, so it is most likely a child of the branch
+			continue
+		}
+		if result.IsSome() && (ahead > bestAhead || (ahead == bestAhead && behind >= bestBehind)) {
+			continue
+		}
+		result = Some(candidate)
+		bestAhead = ahead
+		bestBehind = behind
+	}
+	return result, nil
+}
+
+type InferParentArgs struct {
+	AheadBehind       func(branch, candidate gitdomain.LocalBranchName) (ahead, behind int, err error)
+	Branch            gitdomain.LocalBranchName
+	Lineage           Lineage
+	LocalBranches     gitdomain.LocalBranchNames
+	MainBranch        gitdomain.LocalBranchName
+	PerennialBranches gitdomain.LocalBranchNames
+}
+
+// InferParentCandidates provides the branches that could be the parent of the given branch,
+// in the order of preference when they fork from the branch at the same point.
+func InferParentCandidates(args InferParentArgs) gitdomain.LocalBranchNames {
+	excluded := append(gitdomain.LocalBranchNames{args.Branch}, args.Lineage.Descendants(args.Branch)...)
+	candidates := gitdomain.LocalBranchNames{}
+	if slices.Contains(args.LocalBranches, args.MainBranch) {
+		candidates = append(candidates, args.MainBranch)
+	}
+	for _, perennial := range args.PerennialBranches {
+		if slices.Contains(args.LocalBranches, perennial) && !slices.Contains(candidates, perennial) {
+			candidates = append(candidates, perennial)
+		}
+	}
+	for _, branch := range slice.NaturalSort(slices.Clone(args.LocalBranches)) {
+		if !slices.Contains(candidates, branch) {
+			candidates = append(candidates, branch)
+		}
+	}
+	return candidates.Remove(excluded...)
+}
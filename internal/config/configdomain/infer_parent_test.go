@@ -0,0 +1,144 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestInferParent(t *testing.T) {
+	t.Parallel()
+
+	// aheadBehind provides a fake implementation of the ahead-behind query that returns the given values
+	aheadBehind := func(values map[gitdomain.LocalBranchName][2]int) func(gitdomain.LocalBranchName, gitdomain.LocalBranchName) (int, int, error) {
+		return func(_, candidate gitdomain.LocalBranchName) (int, int, error) {
+			value := values[candidate]
+			return value[0], value[1], nil
+		}
+	}
+
+	t.Run("InferParent", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("closest fork point wins", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind: aheadBehind(map[gitdomain.LocalBranchName][2]int{
+					"main":  {3, 0},
+					"alpha": {1, 0},
+					"beta":  {3, 1},
+				}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("alpha", "beta", "branch", "main"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.LocalBranchNames{},
+			})
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.LocalBranchName("alpha")), have)
+		})
+
+		t.Run("same fork point, fewer own commits wins", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind: aheadBehind(map[gitdomain.LocalBranchName][2]int{
+					"main":  {2, 4},
+					"alpha": {2, 1},
+				}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("alpha", "branch", "main"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.LocalBranchNames{},
+			})
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.LocalBranchName("alpha")), have)
+		})
+
+		t.Run("complete tie, main branch wins", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind: aheadBehind(map[gitdomain.LocalBranchName][2]int{
+					"main":      {0, 0},
+					"alpha":     {0, 0},
+					"perennial": {0, 0},
+				}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("alpha", "branch", "main", "perennial"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.NewLocalBranchNames("perennial"),
+			})
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.LocalBranchName("main")), have)
+		})
+
+		t.Run("ignores branches that contain the entire branch", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind: aheadBehind(map[gitdomain.LocalBranchName][2]int{
+					"main":  {2, 0},
+					"child": {0, 1},
+				}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("branch", "child", "main"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.LocalBranchNames{},
+			})
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.LocalBranchName("main")), have)
+		})
+
+		t.Run("main branch that moved ahead of a branch without commits", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind: aheadBehind(map[gitdomain.LocalBranchName][2]int{
+					"main": {0, 2},
+				}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("branch", "main"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.LocalBranchNames{},
+			})
+			must.NoError(t, err)
+			must.Eq(t, Some(gitdomain.LocalBranchName("main")), have)
+		})
+
+		t.Run("no candidates", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.InferParent(configdomain.InferParentArgs{
+				AheadBehind:       aheadBehind(map[gitdomain.LocalBranchName][2]int{}),
+				Branch:            "branch",
+				Lineage:           configdomain.NewLineage(),
+				LocalBranches:     gitdomain.NewLocalBranchNames("branch"),
+				MainBranch:        "main",
+				PerennialBranches: gitdomain.LocalBranchNames{},
+			})
+			must.NoError(t, err)
+			must.Eq(t, None[gitdomain.LocalBranchName](), have)
+		})
+	})
+
+	t.Run("InferParentCandidates", func(t *testing.T) {
+		t.Parallel()
+		lineage := configdomain.NewLineage().
+			Set("branch", "main").
+			Set("child", "branch").
+			Set("grandchild", "child")
+		have := configdomain.InferParentCandidates(configdomain.InferParentArgs{
+			AheadBehind:       nil,
+			Branch:            "branch",
+			Lineage:           lineage,
+			LocalBranches:     gitdomain.NewLocalBranchNames("beta", "branch", "child", "alpha", "grandchild", "main", "perennial"),
+			MainBranch:        "main",
+			PerennialBranches: gitdomain.NewLocalBranchNames("perennial", "remote-perennial"),
+		})
+		want := gitdomain.NewLocalBranchNames("main", "perennial", "alpha", "beta")
+		must.Eq(t, want, have)
+	})
+}
@@ -0,0 +1,32 @@
+package configdomain
+
+import (
+	"strconv"
+
+	"github.com/git-town/git-town/v17/internal/gohacks"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// InferParents contains the configuration setting whether Git Town should automatically
+// apply the inferred parent of branches with unknown lineage instead of asking the user.
+type InferParents bool
+
+func (self InferParents) IsFalse() bool {
+	return !self.IsTrue()
+}
+
+func (self InferParents) IsTrue() bool {
+	return bool(self)
+}
+
+func (self InferParents) String() string {
+	return strconv.FormatBool(self.IsTrue())
+}
+
+func ParseInferParents(value string, source Key) (Option[InferParents], error) {
+	parsedOpt, err := gohacks.ParseBool(value, source.String())
+	if parsed, has := parsedOpt.Get(); has {
+		return Some(InferParents(parsed)), err
+	}
+	return None[InferParents](), err
+}
@@ -54,6 +54,7 @@ const (
 	KeyAliasSetParent                      = Key("alias.set-parent")
 	KeyAliasShip                           = Key("alias.ship")
 	KeyAliasSync                           = Key("alias.sync")
+	KeyAzureDevOpsToken                    = Key("git-town.azure-devops-token")
 	KeyBitbucketAppPassword                = Key("git-town.bitbucket-app-password")
 	KeyBitbucketUsername                   = Key("git-town.bitbucket-username")
 	KeyContributionBranches                = Key("git-town.contribution-branches")
@@ -77,8 +78,12 @@ const (
 	KeyGiteaToken                          = Key("git-town.gitea-token")
 	KeyGithubToken                         = Key(pkg.KeyGithubToken)
 	KeyGitlabToken                         = Key("git-town.gitlab-token")
+	KeyHookAfterCreateBranch               = Key("git-town.hook-after-create-branch")
+	KeyHookAfterShip                       = Key("git-town.hook-after-ship")
+	KeyHookBeforeSyncBranch                = Key("git-town.hook-before-sync-branch")
 	KeyHostingOriginHostname               = Key("git-town.hosting-origin-hostname")
 	KeyHostingPlatform                     = Key("git-town.hosting-platform")
+	KeyInferParents                        = Key("git-town.infer-parents")
 	KeyMainBranch                          = Key("git-town.main-branch")
 	KeyNewBranchType                       = Key("git-town.new-branch-type")
 	KeyObservedBranches                    = Key("git-town.observed-branches")
@@ -91,13 +96,16 @@ const (
 	KeyPushHook                            = Key("git-town.push-hook")
 	KeyPushNewBranches                     = Key("git-town.push-new-branches")
 	KeyShipDeleteTrackingBranch            = Key("git-town.ship-delete-tracking-branch")
+	KeyShipSquashCommitTemplate            = Key("git-town.ship-squash-commit-template")
 	KeyShipStrategy                        = Key("git-town.ship-strategy")
 	KeyObsoleteSyncBeforeShip              = Key("git-town.sync-before-ship")
 	KeySyncFeatureStrategy                 = Key("git-town.sync-feature-strategy")
+	KeySyncMetadata                        = Key("git-town.sync-metadata")
 	KeySyncPerennialStrategy               = Key("git-town.sync-perennial-strategy")
 	KeySyncPrototypeStrategy               = Key("git-town.sync-prototype-strategy")
 	KeySyncTags                            = Key("git-town.sync-tags")
 	KeySyncUpstream                        = Key("git-town.sync-upstream")
+	KeyTrustConfigFileHooks                = Key("git-town.trust-config-file-hooks")
 	KeyGitUserEmail                        = Key("user.email")
 	KeyGitUserName                         = Key("user.name")
 )
@@ -105,6 +113,7 @@ const (
 var keys = []Key{ //nolint:gochecknoglobals
 	KeyHostingOriginHostname,
 	KeyHostingPlatform,
+	KeyAzureDevOpsToken,
 	KeyBitbucketAppPassword,
 	KeyBitbucketUsername,
 	KeyContributionBranches,
@@ -130,6 +139,10 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyGitlabToken,
 	KeyGitUserEmail,
 	KeyGitUserName,
+	KeyHookAfterCreateBranch,
+	KeyHookAfterShip,
+	KeyHookBeforeSyncBranch,
+	KeyInferParents,
 	KeyMainBranch,
 	KeyNewBranchType,
 	KeyObservedBranches,
@@ -142,19 +155,26 @@ var keys = []Key{ //nolint:gochecknoglobals
 	KeyPushHook,
 	KeyPushNewBranches,
 	KeyShipDeleteTrackingBranch,
+	KeyShipSquashCommitTemplate,
 	KeyShipStrategy,
 	KeyObsoleteSyncBeforeShip,
 	KeySyncFeatureStrategy,
+	KeySyncMetadata,
 	KeySyncPerennialStrategy,
 	KeySyncPrototypeStrategy,
 	KeySyncTags,
 	KeySyncUpstream,
+	KeyTrustConfigFileHooks,
 }
 
 func NewParentKey(branch gitdomain.LocalBranchName) Key {
 	return Key(LineageKeyPrefix + branch + LineageKeySuffix)
 }
 
+func NewSyncStrategyKey(branch gitdomain.LocalBranchName) Key {
+	return Key(BranchSyncStrategyKeyPrefix + branch + BranchSyncStrategyKeySuffix)
+}
+
 func ParseKey(name string) Option[Key] {
 	for _, configKey := range keys {
 		if configKey.String() == name {
@@ -164,6 +184,9 @@ func ParseKey(name string) Option[Key] {
 	if isLineageKey(name) {
 		return Some(Key(name))
 	}
+	if isBranchSyncStrategyKey(name) {
+		return Some(Key(name))
+	}
 	if aliasKey, isAliasKey := AllAliasableCommands().LookupKey(name).Get(); isAliasKey {
 		return Some(aliasKey.Key())
 	}
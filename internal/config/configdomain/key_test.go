@@ -40,6 +40,14 @@ func TestKey(t *testing.T) {
 				must.True(t, have.IsNone())
 			})
 		})
+		t.Run("branch sync strategy key", func(t *testing.T) {
+			t.Parallel()
+			give := "git-town-branch.branch-1.sync-strategy"
+			have, has := configdomain.ParseKey(give).Get()
+			must.True(t, has)
+			want := configdomain.Key(give)
+			must.EqOp(t, want, have)
+		})
 		t.Run("alias key", func(t *testing.T) {
 			t.Parallel()
 			t.Run("valid alias", func(t *testing.T) {
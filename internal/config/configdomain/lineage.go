@@ -138,6 +138,28 @@ func (self Lineage) Children(branch gitdomain.LocalBranchName) gitdomain.LocalBr
 	return result
 }
 
+// Cycles provides the branches that form circular parent relationships in this lineage.
+// Each cycle starts with its alphabetically first branch.
+func (self Lineage) Cycles() []gitdomain.LocalBranchNames {
+	result := []gitdomain.LocalBranchNames{}
+	visited := map[gitdomain.LocalBranchName]bool{}
+	for _, branch := range self.BranchNames() {
+		path := gitdomain.LocalBranchNames{}
+		current, hasCurrent := branch, true
+		for hasCurrent && !visited[current] {
+			visited[current] = true
+			path = append(path, current)
+			current, hasCurrent = self.data[current]
+		}
+		if index := slices.Index(path, current); hasCurrent && index >= 0 {
+			cycle := path[index:]
+			first := slices.Index(cycle, slices.Min(cycle))
+			result = append(result, append(cycle[first:], cycle[:first]...))
+		}
+	}
+	return result
+}
+
 // Descendants provides all branches that depend on the given branch in its lineage.
 func (self Lineage) Descendants(branch gitdomain.LocalBranchName) gitdomain.LocalBranchNames {
 	result := gitdomain.LocalBranchNames{}
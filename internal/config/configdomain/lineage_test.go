@@ -259,6 +259,52 @@ func TestLineage(t *testing.T) {
 		})
 	})
 
+	t.Run("Cycles", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no cycles", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.NewLineageWith(configdomain.LineageData{
+				two: one,
+				one: main,
+			})
+			have := lineage.Cycles()
+			want := []gitdomain.LocalBranchNames{}
+			must.Eq(t, want, have)
+		})
+		t.Run("branch is its own parent", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.NewLineageWith(configdomain.LineageData{
+				one: one,
+			})
+			have := lineage.Cycles()
+			want := []gitdomain.LocalBranchNames{{one}}
+			must.Eq(t, want, have)
+		})
+		t.Run("cycle of multiple branches", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.NewLineageWith(configdomain.LineageData{
+				one:   three,
+				three: two,
+				two:   one,
+				main:  two,
+			})
+			have := lineage.Cycles()
+			want := []gitdomain.LocalBranchNames{{one, three, two}}
+			must.Eq(t, want, have)
+		})
+		t.Run("multiple cycles", func(t *testing.T) {
+			t.Parallel()
+			lineage := configdomain.NewLineageWith(configdomain.LineageData{
+				one:   two,
+				two:   one,
+				three: three,
+			})
+			have := lineage.Cycles()
+			want := []gitdomain.LocalBranchNames{{one, two}, {three}}
+			must.Eq(t, want, have)
+		})
+	})
+
 	t.Run("Descendants", func(t *testing.T) {
 		t.Parallel()
 		t.Run("branch has no children", func(t *testing.T) {
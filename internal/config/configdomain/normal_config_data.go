@@ -9,37 +9,55 @@ import (
 
 // configuration settings that exist in both UnvalidatedConfig and ValidatedConfig
 type NormalConfigData struct {
-	Aliases                  Aliases
-	BitbucketAppPassword     Option[BitbucketAppPassword]
-	BitbucketUsername        Option[BitbucketUsername]
-	ContributionBranches     gitdomain.LocalBranchNames
-	ContributionRegex        Option[ContributionRegex]
-	DefaultBranchType        BranchType
-	DevRemote                gitdomain.Remote
-	FeatureRegex             Option[FeatureRegex]
-	GitHubToken              Option[GitHubToken]
-	GitLabToken              Option[GitLabToken]
-	GiteaToken               Option[GiteaToken]
-	HostingOriginHostname    Option[HostingOriginHostname]
-	HostingPlatform          Option[HostingPlatform] // Some = override by user, None = auto-detect
-	Lineage                  Lineage
-	NewBranchType            BranchType
-	ObservedBranches         gitdomain.LocalBranchNames
-	ObservedRegex            Option[ObservedRegex]
-	Offline                  Offline
-	ParkedBranches           gitdomain.LocalBranchNames
-	PerennialBranches        gitdomain.LocalBranchNames
-	PerennialRegex           Option[PerennialRegex]
-	PrototypeBranches        gitdomain.LocalBranchNames
-	PushHook                 PushHook
-	PushNewBranches          PushNewBranches
-	ShipDeleteTrackingBranch ShipDeleteTrackingBranch
-	ShipStrategy             ShipStrategy
-	SyncFeatureStrategy      SyncFeatureStrategy
-	SyncPerennialStrategy    SyncPerennialStrategy
-	SyncPrototypeStrategy    SyncPrototypeStrategy
-	SyncTags                 SyncTags
-	SyncUpstream             SyncUpstream
+	Aliases                    Aliases
+	AzureDevOpsToken           Option[AzureDevOpsToken]
+	BitbucketAppPassword       Option[BitbucketAppPassword]
+	BitbucketUsername          Option[BitbucketUsername]
+	BranchSyncStrategies       BranchSyncStrategies
+	BranchSyncStrategyPatterns BranchSyncStrategyPatterns
+	ContributionBranches       gitdomain.LocalBranchNames
+	ContributionRegex          Option[ContributionRegex]
+	DefaultBranchType          BranchType
+	DevRemote                  gitdomain.Remote
+	FeatureRegex               Option[FeatureRegex]
+	GitHubToken                Option[GitHubToken]
+	GitLabToken                Option[GitLabToken]
+	GiteaToken                 Option[GiteaToken]
+	HookAfterCreateBranch      Option[HookCommand]
+	HookAfterShip              Option[HookCommand]
+	HookBeforeSyncBranch       Option[HookCommand]
+	HostingOriginHostname      Option[HostingOriginHostname]
+	HostingPlatform            Option[HostingPlatform] // Some = override by user, None = auto-detect
+	InferParents               InferParents
+	Lineage                    Lineage
+	NewBranchType              BranchType
+	ObservedBranches           gitdomain.LocalBranchNames
+	ObservedRegex              Option[ObservedRegex]
+	Offline                    Offline
+	ParkedBranches             gitdomain.LocalBranchNames
+	PerennialBranches          gitdomain.LocalBranchNames
+	PerennialRegex             Option[PerennialRegex]
+	PrototypeBranches          gitdomain.LocalBranchNames
+	PushHook                   PushHook
+	PushNewBranches            PushNewBranches
+	ShipDeleteTrackingBranch   ShipDeleteTrackingBranch
+	ShipSquashCommitTemplate   Option[ShipSquashCommitTemplate]
+	ShipStrategy               ShipStrategy
+	SyncFeatureStrategy        SyncFeatureStrategy
+	SyncMetadata               SyncMetadata
+	SyncPerennialStrategy      SyncPerennialStrategy
+	SyncPrototypeStrategy      SyncPrototypeStrategy
+	SyncTags                   SyncTags
+	SyncUpstream               SyncUpstream
+}
+
+// BranchSyncStrategy provides the sync strategy that the user has configured for the given branch individually.
+// Sync strategies configured for a branch name take precedence over the ones configured for branch name patterns.
+func (self *NormalConfigData) BranchSyncStrategy(branch gitdomain.LocalBranchName) Option[SyncStrategy] {
+	if syncStrategy, has := self.BranchSyncStrategies[branch]; has {
+		return Some(syncStrategy)
+	}
+	return self.BranchSyncStrategyPatterns.Lookup(branch)
 }
 
 // ContainsLineage indicates whether this configuration contains any lineage entries.
@@ -47,6 +65,19 @@ func (self *NormalConfigData) ContainsLineage() bool {
 	return self.Lineage.Len() > 0
 }
 
+// HookCommand provides the shell command that the user has configured for the given hook.
+func (self *NormalConfigData) HookCommand(hook Hook) Option[HookCommand] {
+	switch hook {
+	case HookAfterCreateBranch:
+		return self.HookAfterCreateBranch
+	case HookAfterShip:
+		return self.HookAfterShip
+	case HookBeforeSyncBranch:
+		return self.HookBeforeSyncBranch
+	}
+	return None[HookCommand]()
+}
+
 func (self *NormalConfigData) IsOnline() bool {
 	return self.Online().IsTrue()
 }
@@ -144,6 +175,7 @@ func (self *NormalConfigData) SetByKey(key Key, value string) {
 	case KeyAliasSetParent:
 	case KeyAliasShip:
 	case KeyAliasSync:
+	case KeyAzureDevOpsToken:
 	case KeyBitbucketAppPassword:
 	case KeyBitbucketUsername:
 	case KeyContributionBranches:
@@ -168,8 +200,12 @@ func (self *NormalConfigData) SetByKey(key Key, value string) {
 	case KeyGiteaToken:
 	case KeyGithubToken:
 	case KeyGitlabToken:
+	case KeyHookAfterCreateBranch:
+	case KeyHookAfterShip:
+	case KeyHookBeforeSyncBranch:
 	case KeyHostingOriginHostname:
 	case KeyHostingPlatform:
+	case KeyInferParents:
 	case KeyMainBranch:
 	case KeyNewBranchType:
 	case KeyObservedBranches:
@@ -183,12 +219,15 @@ func (self *NormalConfigData) SetByKey(key Key, value string) {
 	case KeyPushHook:
 	case KeyPushNewBranches:
 	case KeyShipDeleteTrackingBranch:
+	case KeyShipSquashCommitTemplate:
 	case KeyShipStrategy:
 	case KeySyncFeatureStrategy:
+	case KeySyncMetadata:
 	case KeySyncPerennialStrategy:
 	case KeySyncPrototypeStrategy:
 	case KeySyncTags:
 	case KeySyncUpstream:
+	case KeyTrustConfigFileHooks:
 	}
 }
 
@@ -198,36 +237,45 @@ func (self *NormalConfigData) ShouldPushNewBranches() bool {
 
 func DefaultNormalConfig() NormalConfigData {
 	return NormalConfigData{
-		Aliases:                  Aliases{},
-		BitbucketAppPassword:     None[BitbucketAppPassword](),
-		BitbucketUsername:        None[BitbucketUsername](),
-		ContributionBranches:     gitdomain.LocalBranchNames{},
-		ContributionRegex:        None[ContributionRegex](),
-		DefaultBranchType:        BranchTypeFeatureBranch,
-		DevRemote:                gitdomain.RemoteOrigin,
-		FeatureRegex:             None[FeatureRegex](),
-		GitHubToken:              None[GitHubToken](),
-		GitLabToken:              None[GitLabToken](),
-		GiteaToken:               None[GiteaToken](),
-		HostingOriginHostname:    None[HostingOriginHostname](),
-		HostingPlatform:          None[HostingPlatform](),
-		Lineage:                  NewLineage(),
-		NewBranchType:            BranchTypeFeatureBranch,
-		ObservedBranches:         gitdomain.LocalBranchNames{},
-		ObservedRegex:            None[ObservedRegex](),
-		Offline:                  false,
-		ParkedBranches:           gitdomain.LocalBranchNames{},
-		PerennialBranches:        gitdomain.LocalBranchNames{},
-		PerennialRegex:           None[PerennialRegex](),
-		PrototypeBranches:        gitdomain.LocalBranchNames{},
-		PushHook:                 true,
-		PushNewBranches:          false,
-		ShipDeleteTrackingBranch: true,
-		ShipStrategy:             ShipStrategyAPI,
-		SyncFeatureStrategy:      SyncFeatureStrategyMerge,
-		SyncPerennialStrategy:    SyncPerennialStrategyRebase,
-		SyncPrototypeStrategy:    SyncPrototypeStrategyRebase,
-		SyncTags:                 true,
-		SyncUpstream:             true,
+		Aliases:                    Aliases{},
+		AzureDevOpsToken:           None[AzureDevOpsToken](),
+		BitbucketAppPassword:       None[BitbucketAppPassword](),
+		BitbucketUsername:          None[BitbucketUsername](),
+		BranchSyncStrategies:       BranchSyncStrategies{},
+		BranchSyncStrategyPatterns: BranchSyncStrategyPatterns{},
+		ContributionBranches:       gitdomain.LocalBranchNames{},
+		ContributionRegex:          None[ContributionRegex](),
+		DefaultBranchType:          BranchTypeFeatureBranch,
+		DevRemote:                  gitdomain.RemoteOrigin,
+		FeatureRegex:               None[FeatureRegex](),
+		GitHubToken:                None[GitHubToken](),
+		GitLabToken:                None[GitLabToken](),
+		GiteaToken:                 None[GiteaToken](),
+		HookAfterCreateBranch:      None[HookCommand](),
+		HookAfterShip:              None[HookCommand](),
+		HookBeforeSyncBranch:       None[HookCommand](),
+		HostingOriginHostname:      None[HostingOriginHostname](),
+		HostingPlatform:            None[HostingPlatform](),
+		InferParents:               false,
+		Lineage:                    NewLineage(),
+		NewBranchType:              BranchTypeFeatureBranch,
+		ObservedBranches:           gitdomain.LocalBranchNames{},
+		ObservedRegex:              None[ObservedRegex](),
+		Offline:                    false,
+		ParkedBranches:             gitdomain.LocalBranchNames{},
+		PerennialBranches:          gitdomain.LocalBranchNames{},
+		PerennialRegex:             None[PerennialRegex](),
+		PrototypeBranches:          gitdomain.LocalBranchNames{},
+		PushHook:                   true,
+		PushNewBranches:            false,
+		ShipDeleteTrackingBranch:   true,
+		ShipSquashCommitTemplate:   None[ShipSquashCommitTemplate](),
+		ShipStrategy:               ShipStrategyAPI,
+		SyncFeatureStrategy:        SyncFeatureStrategyMerge,
+		SyncMetadata:               false,
+		SyncPerennialStrategy:      SyncPerennialStrategyRebase,
+		SyncPrototypeStrategy:      SyncPrototypeStrategyRebase,
+		SyncTags:                   true,
+		SyncUpstream:               true,
 	}
 }
@@ -0,0 +1,40 @@
+package configdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+const (
+	OutputFormatJSON OutputFormat = "json" // machine-readable JSON output
+	OutputFormatText OutputFormat = "text" // human-readable text output
+)
+
+// OutputFormat defines how Git Town commands display information.
+type OutputFormat string
+
+func (self OutputFormat) String() string {
+	return string(self)
+}
+
+func ParseOutputFormat(text string) (OutputFormat, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" {
+		return OutputFormatText, nil
+	}
+	for _, outputFormat := range OutputFormats() {
+		if outputFormat.String() == text {
+			return outputFormat, nil
+		}
+	}
+	return OutputFormatText, fmt.Errorf(messages.OutputFormatUnknown, text)
+}
+
+func OutputFormats() []OutputFormat {
+	return []OutputFormat{
+		OutputFormatJSON,
+		OutputFormatText,
+	}
+}
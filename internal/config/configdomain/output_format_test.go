@@ -0,0 +1,33 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid values", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]configdomain.OutputFormat{
+			"":       configdomain.OutputFormatText,
+			"text":   configdomain.OutputFormatText,
+			"json":   configdomain.OutputFormatJSON,
+			" JSON ": configdomain.OutputFormatJSON,
+		}
+		for give, want := range tests {
+			have, err := configdomain.ParseOutputFormat(give)
+			must.NoError(t, err)
+			must.EqOp(t, want, have)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		_, err := configdomain.ParseOutputFormat("yaml")
+		must.EqError(t, err, `unknown output format: "yaml"`)
+	})
+}
@@ -9,51 +9,64 @@ import (
 
 // PartialConfig contains configuration data as it is stored in the local or global Git configuration.
 type PartialConfig struct {
-	Aliases                  Aliases
-	BitbucketAppPassword     Option[BitbucketAppPassword]
-	BitbucketUsername        Option[BitbucketUsername]
-	ContributionBranches     gitdomain.LocalBranchNames
-	ContributionRegex        Option[ContributionRegex]
-	DefaultBranchType        Option[BranchType]
-	DevRemote                Option[gitdomain.Remote]
-	FeatureRegex             Option[FeatureRegex]
-	GitHubToken              Option[GitHubToken]
-	GitLabToken              Option[GitLabToken]
-	GitUserEmail             Option[GitUserEmail]
-	GitUserName              Option[GitUserName]
-	GiteaToken               Option[GiteaToken]
-	HostingOriginHostname    Option[HostingOriginHostname]
-	HostingPlatform          Option[HostingPlatform]
-	Lineage                  Lineage
-	MainBranch               Option[gitdomain.LocalBranchName]
-	NewBranchType            Option[BranchType]
-	ObservedBranches         gitdomain.LocalBranchNames
-	ObservedRegex            Option[ObservedRegex]
-	Offline                  Option[Offline]
-	ParkedBranches           gitdomain.LocalBranchNames
-	PerennialBranches        gitdomain.LocalBranchNames
-	PerennialRegex           Option[PerennialRegex]
-	PrototypeBranches        gitdomain.LocalBranchNames
-	PushHook                 Option[PushHook]
-	PushNewBranches          Option[PushNewBranches]
-	ShipDeleteTrackingBranch Option[ShipDeleteTrackingBranch]
-	ShipStrategy             Option[ShipStrategy]
-	SyncFeatureStrategy      Option[SyncFeatureStrategy]
-	SyncPerennialStrategy    Option[SyncPerennialStrategy]
-	SyncPrototypeStrategy    Option[SyncPrototypeStrategy]
-	SyncTags                 Option[SyncTags]
-	SyncUpstream             Option[SyncUpstream]
+	Aliases                    Aliases
+	AzureDevOpsToken           Option[AzureDevOpsToken]
+	BitbucketAppPassword       Option[BitbucketAppPassword]
+	BitbucketUsername          Option[BitbucketUsername]
+	BranchSyncStrategies       BranchSyncStrategies
+	BranchSyncStrategyPatterns BranchSyncStrategyPatterns
+	ContributionBranches       gitdomain.LocalBranchNames
+	ContributionRegex          Option[ContributionRegex]
+	DefaultBranchType          Option[BranchType]
+	DevRemote                  Option[gitdomain.Remote]
+	FeatureRegex               Option[FeatureRegex]
+	GitHubToken                Option[GitHubToken]
+	GitLabToken                Option[GitLabToken]
+	GitUserEmail               Option[GitUserEmail]
+	GitUserName                Option[GitUserName]
+	GiteaToken                 Option[GiteaToken]
+	HookAfterCreateBranch      Option[HookCommand]
+	HookAfterShip              Option[HookCommand]
+	HookBeforeSyncBranch       Option[HookCommand]
+	HostingOriginHostname      Option[HostingOriginHostname]
+	HostingPlatform            Option[HostingPlatform]
+	InferParents               Option[InferParents]
+	Lineage                    Lineage
+	MainBranch                 Option[gitdomain.LocalBranchName]
+	NewBranchType              Option[BranchType]
+	ObservedBranches           gitdomain.LocalBranchNames
+	ObservedRegex              Option[ObservedRegex]
+	Offline                    Option[Offline]
+	ParkedBranches             gitdomain.LocalBranchNames
+	PerennialBranches          gitdomain.LocalBranchNames
+	PerennialRegex             Option[PerennialRegex]
+	PrototypeBranches          gitdomain.LocalBranchNames
+	PushHook                   Option[PushHook]
+	PushNewBranches            Option[PushNewBranches]
+	ShipDeleteTrackingBranch   Option[ShipDeleteTrackingBranch]
+	ShipSquashCommitTemplate   Option[ShipSquashCommitTemplate]
+	ShipStrategy               Option[ShipStrategy]
+	SyncFeatureStrategy        Option[SyncFeatureStrategy]
+	SyncMetadata               Option[SyncMetadata]
+	SyncPerennialStrategy      Option[SyncPerennialStrategy]
+	SyncPrototypeStrategy      Option[SyncPrototypeStrategy]
+	SyncTags                   Option[SyncTags]
+	SyncUpstream               Option[SyncUpstream]
+	TrustConfigFileHooks       Option[TrustConfigFileHooks]
 }
 
 func EmptyPartialConfig() PartialConfig {
 	return PartialConfig{
-		Aliases: Aliases{},
+		Aliases:              Aliases{},
+		BranchSyncStrategies: BranchSyncStrategies{},
 	} //exhaustruct:ignore
 }
 
 func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool, removeLocalConfigValue removeLocalConfigValueFunc) (PartialConfig, error) {
 	ec := gohacks.ErrorCollector{}
 	aliases := snapshot.Aliases()
+	branchSyncStrategies, err := NewBranchSyncStrategiesFromSnapshot(snapshot)
+	ec.Check(err)
 	contributionRegex, err := ParseContributionRegex(snapshot[KeyContributionRegex])
 	ec.Check(err)
 	defaultBranchType, err := ParseBranchType(snapshot[KeyDefaultBranchType])
@@ -62,6 +75,8 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	hostingPlatform, err := ParseHostingPlatform(snapshot[KeyHostingPlatform])
 	ec.Check(err)
+	inferParents, err := ParseInferParents(snapshot[KeyInferParents], KeyInferParents)
+	ec.Check(err)
 	lineage, err := NewLineageFromSnapshot(snapshot, updateOutdated, removeLocalConfigValue)
 	ec.Check(err)
 	newBranchType, err := ParseBranchType(snapshot[KeyNewBranchType])
@@ -78,10 +93,14 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	shipDeleteTrackingBranch, err := ParseShipDeleteTrackingBranch(snapshot[KeyShipDeleteTrackingBranch], KeyShipDeleteTrackingBranch)
 	ec.Check(err)
+	shipSquashCommitTemplate, err := ParseShipSquashCommitTemplate(snapshot[KeyShipSquashCommitTemplate], KeyShipSquashCommitTemplate.String())
+	ec.Check(err)
 	shipStrategy, err := ParseShipStrategy(snapshot[KeyShipStrategy])
 	ec.Check(err)
 	syncFeatureStrategy, err := ParseSyncFeatureStrategy(snapshot[KeySyncFeatureStrategy])
 	ec.Check(err)
+	syncMetadata, err := ParseSyncMetadata(snapshot[KeySyncMetadata], KeySyncMetadata)
+	ec.Check(err)
 	syncPerennialStrategy, err := ParseSyncPerennialStrategy(snapshot[KeySyncPerennialStrategy])
 	ec.Check(err)
 	syncPrototypeStrategy, err := ParseSyncPrototypeStrategy(snapshot[KeySyncPrototypeStrategy])
@@ -90,121 +109,157 @@ func NewPartialConfigFromSnapshot(snapshot SingleSnapshot, updateOutdated bool,
 	ec.Check(err)
 	syncUpstream, err := ParseSyncUpstream(snapshot[KeySyncUpstream], KeySyncUpstream)
 	ec.Check(err)
+	trustConfigFileHooks, err := ParseTrustConfigFileHooks(snapshot[KeyTrustConfigFileHooks], KeyTrustConfigFileHooks)
+	ec.Check(err)
 	return PartialConfig{
-		Aliases:                  aliases,
-		BitbucketAppPassword:     ParseBitbucketAppPassword(snapshot[KeyBitbucketAppPassword]),
-		BitbucketUsername:        ParseBitbucketUsername(snapshot[KeyBitbucketUsername]),
-		ContributionBranches:     gitdomain.ParseLocalBranchNames(snapshot[KeyContributionBranches]),
-		ContributionRegex:        contributionRegex,
-		DefaultBranchType:        defaultBranchType,
-		DevRemote:                gitdomain.NewRemote(snapshot[KeyDevRemote]),
-		FeatureRegex:             featureRegex,
-		GitHubToken:              ParseGitHubToken(snapshot[KeyGithubToken]),
-		GitLabToken:              ParseGitLabToken(snapshot[KeyGitlabToken]),
-		GitUserEmail:             ParseGitUserEmail(snapshot[KeyGitUserEmail]),
-		GitUserName:              ParseGitUserName(snapshot[KeyGitUserName]),
-		GiteaToken:               ParseGiteaToken(snapshot[KeyGiteaToken]),
-		HostingOriginHostname:    ParseHostingOriginHostname(snapshot[KeyHostingOriginHostname]),
-		HostingPlatform:          hostingPlatform,
-		Lineage:                  lineage,
-		MainBranch:               gitdomain.NewLocalBranchNameOption(snapshot[KeyMainBranch]),
-		NewBranchType:            newBranchType,
-		ObservedBranches:         gitdomain.ParseLocalBranchNames(snapshot[KeyObservedBranches]),
-		ObservedRegex:            observedRegex,
-		Offline:                  offline,
-		ParkedBranches:           gitdomain.ParseLocalBranchNames(snapshot[KeyParkedBranches]),
-		PerennialBranches:        gitdomain.ParseLocalBranchNames(snapshot[KeyPerennialBranches]),
-		PerennialRegex:           perennialRegex,
-		PrototypeBranches:        gitdomain.ParseLocalBranchNames(snapshot[KeyPrototypeBranches]),
-		PushHook:                 pushHook,
-		PushNewBranches:          pushNewBranches,
-		ShipDeleteTrackingBranch: shipDeleteTrackingBranch,
-		ShipStrategy:             shipStrategy,
-		SyncFeatureStrategy:      syncFeatureStrategy,
-		SyncPerennialStrategy:    syncPerennialStrategy,
-		SyncPrototypeStrategy:    syncPrototypeStrategy,
-		SyncTags:                 syncTags,
-		SyncUpstream:             syncUpstream,
+		Aliases:                    aliases,
+		AzureDevOpsToken:           ParseAzureDevOpsToken(snapshot[KeyAzureDevOpsToken]),
+		BitbucketAppPassword:       ParseBitbucketAppPassword(snapshot[KeyBitbucketAppPassword]),
+		BitbucketUsername:          ParseBitbucketUsername(snapshot[KeyBitbucketUsername]),
+		BranchSyncStrategies:       branchSyncStrategies,
+		BranchSyncStrategyPatterns: BranchSyncStrategyPatterns{},
+		ContributionBranches:       gitdomain.ParseLocalBranchNames(snapshot[KeyContributionBranches]),
+		ContributionRegex:          contributionRegex,
+		DefaultBranchType:          defaultBranchType,
+		DevRemote:                  gitdomain.NewRemote(snapshot[KeyDevRemote]),
+		FeatureRegex:               featureRegex,
+		GitHubToken:                ParseGitHubToken(snapshot[KeyGithubToken]),
+		GitLabToken:                ParseGitLabToken(snapshot[KeyGitlabToken]),
+		GitUserEmail:               ParseGitUserEmail(snapshot[KeyGitUserEmail]),
+		GitUserName:                ParseGitUserName(snapshot[KeyGitUserName]),
+		GiteaToken:                 ParseGiteaToken(snapshot[KeyGiteaToken]),
+		HookAfterCreateBranch:      ParseHookCommand(snapshot[KeyHookAfterCreateBranch]),
+		HookAfterShip:              ParseHookCommand(snapshot[KeyHookAfterShip]),
+		HookBeforeSyncBranch:       ParseHookCommand(snapshot[KeyHookBeforeSyncBranch]),
+		HostingOriginHostname:      ParseHostingOriginHostname(snapshot[KeyHostingOriginHostname]),
+		HostingPlatform:            hostingPlatform,
+		InferParents:               inferParents,
+		Lineage:                    lineage,
+		MainBranch:                 gitdomain.NewLocalBranchNameOption(snapshot[KeyMainBranch]),
+		NewBranchType:              newBranchType,
+		ObservedBranches:           gitdomain.ParseLocalBranchNames(snapshot[KeyObservedBranches]),
+		ObservedRegex:              observedRegex,
+		Offline:                    offline,
+		ParkedBranches:             gitdomain.ParseLocalBranchNames(snapshot[KeyParkedBranches]),
+		PerennialBranches:          gitdomain.ParseLocalBranchNames(snapshot[KeyPerennialBranches]),
+		PerennialRegex:             perennialRegex,
+		PrototypeBranches:          gitdomain.ParseLocalBranchNames(snapshot[KeyPrototypeBranches]),
+		PushHook:                   pushHook,
+		PushNewBranches:            pushNewBranches,
+		ShipDeleteTrackingBranch:   shipDeleteTrackingBranch,
+		ShipSquashCommitTemplate:   shipSquashCommitTemplate,
+		ShipStrategy:               shipStrategy,
+		SyncFeatureStrategy:        syncFeatureStrategy,
+		SyncMetadata:               syncMetadata,
+		SyncPerennialStrategy:      syncPerennialStrategy,
+		SyncPrototypeStrategy:      syncPrototypeStrategy,
+		SyncTags:                   syncTags,
+		SyncUpstream:               syncUpstream,
+		TrustConfigFileHooks:       trustConfigFileHooks,
 	}, ec.Err
 }
 
 // a function that deletes the local Git configuration value with the given key
 type removeLocalConfigValueFunc func(Key) error
 
+// HasHooks indicates whether this configuration defines commands for hooks.
+func (self PartialConfig) HasHooks() bool {
+	return self.HookAfterCreateBranch.IsSome() || self.HookAfterShip.IsSome() || self.HookBeforeSyncBranch.IsSome()
+}
+
 // Merges the given PartialConfig into this configuration object.
 func (self PartialConfig) Merge(other PartialConfig) PartialConfig {
 	return PartialConfig{
-		Aliases:                  mapstools.Merge(other.Aliases, self.Aliases),
-		BitbucketAppPassword:     other.BitbucketAppPassword.Or(self.BitbucketAppPassword),
-		BitbucketUsername:        other.BitbucketUsername.Or(self.BitbucketUsername),
-		ContributionBranches:     append(other.ContributionBranches, self.ContributionBranches...),
-		ContributionRegex:        other.ContributionRegex.Or(self.ContributionRegex),
-		DefaultBranchType:        other.DefaultBranchType.Or(self.DefaultBranchType),
-		DevRemote:                other.DevRemote.Or(self.DevRemote),
-		FeatureRegex:             other.FeatureRegex.Or(self.FeatureRegex),
-		GitHubToken:              other.GitHubToken.Or(self.GitHubToken),
-		GitLabToken:              other.GitLabToken.Or(self.GitLabToken),
-		GitUserEmail:             other.GitUserEmail.Or(self.GitUserEmail),
-		GitUserName:              other.GitUserName.Or(self.GitUserName),
-		GiteaToken:               other.GiteaToken.Or(self.GiteaToken),
-		HostingOriginHostname:    other.HostingOriginHostname.Or(self.HostingOriginHostname),
-		HostingPlatform:          other.HostingPlatform.Or(self.HostingPlatform),
-		Lineage:                  other.Lineage.Merge(self.Lineage),
-		MainBranch:               other.MainBranch.Or(self.MainBranch),
-		NewBranchType:            other.NewBranchType.Or(self.NewBranchType),
-		ObservedBranches:         append(other.ObservedBranches, self.ObservedBranches...),
-		ObservedRegex:            other.ObservedRegex.Or(self.ObservedRegex),
-		Offline:                  other.Offline.Or(self.Offline),
-		ParkedBranches:           append(other.ParkedBranches, self.ParkedBranches...),
-		PerennialBranches:        append(other.PerennialBranches, self.PerennialBranches...),
-		PerennialRegex:           other.PerennialRegex.Or(self.PerennialRegex),
-		PrototypeBranches:        append(other.PrototypeBranches, self.PrototypeBranches...),
-		PushHook:                 other.PushHook.Or(self.PushHook),
-		PushNewBranches:          other.PushNewBranches.Or(self.PushNewBranches),
-		ShipDeleteTrackingBranch: other.ShipDeleteTrackingBranch.Or(self.ShipDeleteTrackingBranch),
-		ShipStrategy:             other.ShipStrategy.Or(self.ShipStrategy),
-		SyncFeatureStrategy:      other.SyncFeatureStrategy.Or(self.SyncFeatureStrategy),
-		SyncPerennialStrategy:    other.SyncPerennialStrategy.Or(self.SyncPerennialStrategy),
-		SyncPrototypeStrategy:    other.SyncPrototypeStrategy.Or(self.SyncPrototypeStrategy),
-		SyncTags:                 other.SyncTags.Or(self.SyncTags),
-		SyncUpstream:             other.SyncUpstream.Or(self.SyncUpstream),
+		Aliases:                    mapstools.Merge(other.Aliases, self.Aliases),
+		AzureDevOpsToken:           other.AzureDevOpsToken.Or(self.AzureDevOpsToken),
+		BitbucketAppPassword:       other.BitbucketAppPassword.Or(self.BitbucketAppPassword),
+		BitbucketUsername:          other.BitbucketUsername.Or(self.BitbucketUsername),
+		BranchSyncStrategies:       mapstools.Merge(self.BranchSyncStrategies, other.BranchSyncStrategies),
+		BranchSyncStrategyPatterns: append(other.BranchSyncStrategyPatterns, self.BranchSyncStrategyPatterns...),
+		ContributionBranches:       other.ContributionBranches.AppendAllMissing(self.ContributionBranches...),
+		ContributionRegex:          other.ContributionRegex.Or(self.ContributionRegex),
+		DefaultBranchType:          other.DefaultBranchType.Or(self.DefaultBranchType),
+		DevRemote:                  other.DevRemote.Or(self.DevRemote),
+		FeatureRegex:               other.FeatureRegex.Or(self.FeatureRegex),
+		GitHubToken:                other.GitHubToken.Or(self.GitHubToken),
+		GitLabToken:                other.GitLabToken.Or(self.GitLabToken),
+		GitUserEmail:               other.GitUserEmail.Or(self.GitUserEmail),
+		GitUserName:                other.GitUserName.Or(self.GitUserName),
+		GiteaToken:                 other.GiteaToken.Or(self.GiteaToken),
+		HookAfterCreateBranch:      other.HookAfterCreateBranch.Or(self.HookAfterCreateBranch),
+		HookAfterShip:              other.HookAfterShip.Or(self.HookAfterShip),
+		HookBeforeSyncBranch:       other.HookBeforeSyncBranch.Or(self.HookBeforeSyncBranch),
+		HostingOriginHostname:      other.HostingOriginHostname.Or(self.HostingOriginHostname),
+		HostingPlatform:            other.HostingPlatform.Or(self.HostingPlatform),
+		InferParents:               other.InferParents.Or(self.InferParents),
+		Lineage:                    other.Lineage.Merge(self.Lineage),
+		MainBranch:                 other.MainBranch.Or(self.MainBranch),
+		NewBranchType:              other.NewBranchType.Or(self.NewBranchType),
+		ObservedBranches:           other.ObservedBranches.AppendAllMissing(self.ObservedBranches...),
+		ObservedRegex:              other.ObservedRegex.Or(self.ObservedRegex),
+		Offline:                    other.Offline.Or(self.Offline),
+		ParkedBranches:             other.ParkedBranches.AppendAllMissing(self.ParkedBranches...),
+		PerennialBranches:          other.PerennialBranches.AppendAllMissing(self.PerennialBranches...),
+		PerennialRegex:             other.PerennialRegex.Or(self.PerennialRegex),
+		PrototypeBranches:          other.PrototypeBranches.AppendAllMissing(self.PrototypeBranches...),
+		PushHook:                   other.PushHook.Or(self.PushHook),
+		PushNewBranches:            other.PushNewBranches.Or(self.PushNewBranches),
+		ShipDeleteTrackingBranch:   other.ShipDeleteTrackingBranch.Or(self.ShipDeleteTrackingBranch),
+		ShipSquashCommitTemplate:   other.ShipSquashCommitTemplate.Or(self.ShipSquashCommitTemplate),
+		ShipStrategy:               other.ShipStrategy.Or(self.ShipStrategy),
+		SyncFeatureStrategy:        other.SyncFeatureStrategy.Or(self.SyncFeatureStrategy),
+		SyncMetadata:               other.SyncMetadata.Or(self.SyncMetadata),
+		SyncPerennialStrategy:      other.SyncPerennialStrategy.Or(self.SyncPerennialStrategy),
+		SyncPrototypeStrategy:      other.SyncPrototypeStrategy.Or(self.SyncPrototypeStrategy),
+		SyncTags:                   other.SyncTags.Or(self.SyncTags),
+		SyncUpstream:               other.SyncUpstream.Or(self.SyncUpstream),
+		TrustConfigFileHooks:       other.TrustConfigFileHooks.Or(self.TrustConfigFileHooks),
 	}
 }
 
 func (self PartialConfig) ToNormalConfig(defaults NormalConfigData) NormalConfigData {
 	syncFeatureStrategy := self.SyncFeatureStrategy.GetOrElse(defaults.SyncFeatureStrategy)
 	return NormalConfigData{
-		Aliases:                  self.Aliases,
-		BitbucketAppPassword:     self.BitbucketAppPassword,
-		BitbucketUsername:        self.BitbucketUsername,
-		ContributionBranches:     self.ContributionBranches,
-		ContributionRegex:        self.ContributionRegex,
-		DefaultBranchType:        self.DefaultBranchType.GetOrElse(BranchTypeFeatureBranch),
-		DevRemote:                self.DevRemote.GetOrElse(defaults.DevRemote),
-		FeatureRegex:             self.FeatureRegex,
-		GitHubToken:              self.GitHubToken,
-		GitLabToken:              self.GitLabToken,
-		GiteaToken:               self.GiteaToken,
-		HostingOriginHostname:    self.HostingOriginHostname,
-		HostingPlatform:          self.HostingPlatform,
-		Lineage:                  self.Lineage,
-		NewBranchType:            self.NewBranchType.GetOrElse(defaults.NewBranchType),
-		ObservedBranches:         self.ObservedBranches,
-		ObservedRegex:            self.ObservedRegex,
-		Offline:                  self.Offline.GetOrElse(defaults.Offline),
-		ParkedBranches:           self.ParkedBranches,
-		PerennialBranches:        self.PerennialBranches,
-		PerennialRegex:           self.PerennialRegex,
-		PrototypeBranches:        self.PrototypeBranches,
-		PushHook:                 self.PushHook.GetOrElse(defaults.PushHook),
-		PushNewBranches:          self.PushNewBranches.GetOrElse(defaults.PushNewBranches),
-		ShipDeleteTrackingBranch: self.ShipDeleteTrackingBranch.GetOrElse(defaults.ShipDeleteTrackingBranch),
-		ShipStrategy:             self.ShipStrategy.GetOrElse(defaults.ShipStrategy),
-		SyncFeatureStrategy:      syncFeatureStrategy,
-		SyncPerennialStrategy:    self.SyncPerennialStrategy.GetOrElse(defaults.SyncPerennialStrategy),
-		SyncPrototypeStrategy:    self.SyncPrototypeStrategy.GetOrElse(NewSyncPrototypeStrategyFromSyncFeatureStrategy(syncFeatureStrategy)),
-		SyncTags:                 self.SyncTags.GetOrElse(defaults.SyncTags),
-		SyncUpstream:             self.SyncUpstream.GetOrElse(defaults.SyncUpstream),
+		Aliases:                    self.Aliases,
+		AzureDevOpsToken:           self.AzureDevOpsToken,
+		BitbucketAppPassword:       self.BitbucketAppPassword,
+		BitbucketUsername:          self.BitbucketUsername,
+		BranchSyncStrategies:       self.BranchSyncStrategies,
+		BranchSyncStrategyPatterns: self.BranchSyncStrategyPatterns,
+		ContributionBranches:       self.ContributionBranches,
+		ContributionRegex:          self.ContributionRegex,
+		DefaultBranchType:          self.DefaultBranchType.GetOrElse(BranchTypeFeatureBranch),
+		DevRemote:                  self.DevRemote.GetOrElse(defaults.DevRemote),
+		FeatureRegex:               self.FeatureRegex,
+		GitHubToken:                self.GitHubToken,
+		GitLabToken:                self.GitLabToken,
+		GiteaToken:                 self.GiteaToken,
+		HookAfterCreateBranch:      self.HookAfterCreateBranch,
+		HookAfterShip:              self.HookAfterShip,
+		HookBeforeSyncBranch:       self.HookBeforeSyncBranch,
+		HostingOriginHostname:      self.HostingOriginHostname,
+		HostingPlatform:            self.HostingPlatform,
+		InferParents:               self.InferParents.GetOrElse(defaults.InferParents),
+		Lineage:                    self.Lineage,
+		NewBranchType:              self.NewBranchType.GetOrElse(defaults.NewBranchType),
+		ObservedBranches:           self.ObservedBranches,
+		ObservedRegex:              self.ObservedRegex,
+		Offline:                    self.Offline.GetOrElse(defaults.Offline),
+		ParkedBranches:             self.ParkedBranches,
+		PerennialBranches:          self.PerennialBranches,
+		PerennialRegex:             self.PerennialRegex,
+		PrototypeBranches:          self.PrototypeBranches,
+		PushHook:                   self.PushHook.GetOrElse(defaults.PushHook),
+		PushNewBranches:            self.PushNewBranches.GetOrElse(defaults.PushNewBranches),
+		ShipDeleteTrackingBranch:   self.ShipDeleteTrackingBranch.GetOrElse(defaults.ShipDeleteTrackingBranch),
+		ShipSquashCommitTemplate:   self.ShipSquashCommitTemplate,
+		ShipStrategy:               self.ShipStrategy.GetOrElse(defaults.ShipStrategy),
+		SyncFeatureStrategy:        syncFeatureStrategy,
+		SyncMetadata:               self.SyncMetadata.GetOrElse(defaults.SyncMetadata),
+		SyncPerennialStrategy:      self.SyncPerennialStrategy.GetOrElse(defaults.SyncPerennialStrategy),
+		SyncPrototypeStrategy:      self.SyncPrototypeStrategy.GetOrElse(NewSyncPrototypeStrategyFromSyncFeatureStrategy(syncFeatureStrategy)),
+		SyncTags:                   self.SyncTags.GetOrElse(defaults.SyncTags),
+		SyncUpstream:               self.SyncUpstream.GetOrElse(defaults.SyncUpstream),
 	}
 }
 
@@ -215,3 +270,12 @@ func (self PartialConfig) ToUnvalidatedConfig() UnvalidatedConfigData {
 		MainBranch:   self.MainBranch,
 	}
 }
+
+// WithoutHooks provides a copy of this configuration that doesn't define commands for hooks.
+func (self PartialConfig) WithoutHooks() PartialConfig {
+	result := self
+	result.HookAfterCreateBranch = None[HookCommand]()
+	result.HookAfterShip = None[HookCommand]()
+	result.HookBeforeSyncBranch = None[HookCommand]()
+	return result
+}
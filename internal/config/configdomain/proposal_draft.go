@@ -0,0 +1,12 @@
+package configdomain
+
+// indicates whether "git town propose" should create the proposal as a draft
+type ProposalDraft bool
+
+func (self ProposalDraft) IsFalse() bool {
+	return !bool(self)
+}
+
+func (self ProposalDraft) IsTrue() bool {
+	return bool(self)
+}
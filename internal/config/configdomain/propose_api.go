@@ -0,0 +1,12 @@
+package configdomain
+
+// indicates whether "git town propose" should create the proposal through the API of the code hosting platform
+type ProposeAPI bool
+
+func (self ProposeAPI) IsFalse() bool {
+	return !bool(self)
+}
+
+func (self ProposeAPI) IsTrue() bool {
+	return bool(self)
+}
@@ -0,0 +1,142 @@
+package configdomain
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+)
+
+// SharedMetadata is the information about branches that Git Town shares with other people
+// through a Git ref at the development remote if the sync-metadata setting is enabled.
+type SharedMetadata struct {
+	Branches map[gitdomain.LocalBranchName]SharedBranchMetadata `json:"branches"`
+}
+
+// SharedBranchMetadata is the information that Git Town shares about a single branch.
+type SharedBranchMetadata struct {
+	Parent gitdomain.LocalBranchName `json:"parent,omitempty"`
+	// Git Town shares only the branch types that describe the branch itself.
+	// Contribution and observed branches describe how a particular person interacts with somebody else's branch.
+	Type string `json:"type,omitempty"`
+}
+
+// SharedMetadataConflict describes a setting of a branch that was changed locally and remotely to different values.
+type SharedMetadataConflict struct {
+	Branch gitdomain.LocalBranchName
+	Local  string
+	Name   string
+	Remote string
+}
+
+func EmptySharedMetadata() SharedMetadata {
+	return SharedMetadata{
+		Branches: map[gitdomain.LocalBranchName]SharedBranchMetadata{},
+	}
+}
+
+// MergeSharedMetadata merges the local and remote changes to the given base version of the shared metadata.
+// Changes made on only one side win over the base version.
+// If both sides change the same setting of a branch to different values, the local value wins
+// and the returned conflicts describe the discarded remote change.
+func MergeSharedMetadata(base, local, remote SharedMetadata) (SharedMetadata, []SharedMetadataConflict) {
+	result := EmptySharedMetadata()
+	conflicts := []SharedMetadataConflict{}
+	for _, branch := range SharedMetadataBranches(base, local, remote) {
+		baseBranch, localBranch, remoteBranch := base.Branches[branch], local.Branches[branch], remote.Branches[branch]
+		parent, parentConflict := mergeSharedMetadataValue(baseBranch.Parent.String(), localBranch.Parent.String(), remoteBranch.Parent.String())
+		if parentConflict {
+			conflicts = append(conflicts, SharedMetadataConflict{
+				Branch: branch,
+				Local:  localBranch.Parent.String(),
+				Name:   "parent",
+				Remote: remoteBranch.Parent.String(),
+			})
+		}
+		branchType, typeConflict := mergeSharedMetadataValue(baseBranch.Type, localBranch.Type, remoteBranch.Type)
+		if typeConflict {
+			conflicts = append(conflicts, SharedMetadataConflict{
+				Branch: branch,
+				Local:  localBranch.Type,
+				Name:   "type",
+				Remote: remoteBranch.Type,
+			})
+		}
+		if parent != "" || branchType != "" {
+			result.Branches[branch] = SharedBranchMetadata{
+				Parent: gitdomain.LocalBranchName(parent),
+				Type:   branchType,
+			}
+		}
+	}
+	return result, conflicts
+}
+
+// NewSharedMetadata provides the metadata that the given configuration shares with other people.
+func NewSharedMetadata(config NormalConfigData) SharedMetadata {
+	result := EmptySharedMetadata()
+	for _, entry := range config.Lineage.Entries() {
+		branchMetadata := result.Branches[entry.Child]
+		branchMetadata.Parent = entry.Parent
+		result.Branches[entry.Child] = branchMetadata
+	}
+	for _, branch := range config.ParkedBranches {
+		branchMetadata := result.Branches[branch]
+		branchMetadata.Type = BranchTypeParkedBranch.String()
+		result.Branches[branch] = branchMetadata
+	}
+	for _, branch := range config.PrototypeBranches {
+		branchMetadata := result.Branches[branch]
+		branchMetadata.Type = BranchTypePrototypeBranch.String()
+		result.Branches[branch] = branchMetadata
+	}
+	return result
+}
+
+// ParseSharedMetadata parses the given serialized metadata.
+func ParseSharedMetadata(text string) (SharedMetadata, error) {
+	result := EmptySharedMetadata()
+	err := json.Unmarshal([]byte(text), &result)
+	if result.Branches == nil {
+		result.Branches = map[gitdomain.LocalBranchName]SharedBranchMetadata{}
+	}
+	return result, err
+}
+
+// SharedMetadataBranches provides the sorted names of all branches in the given metadata.
+func SharedMetadataBranches(metadatas ...SharedMetadata) gitdomain.LocalBranchNames {
+	result := gitdomain.LocalBranchNames{}
+	for _, metadata := range metadatas {
+		for branch := range metadata.Branches {
+			if !slices.Contains(result, branch) {
+				result = append(result, branch)
+			}
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+func (self SharedMetadata) IsEmpty() bool {
+	return len(self.Branches) == 0
+}
+
+// Serialize provides the textual representation of this metadata that Git Town stores in the Git ref.
+func (self SharedMetadata) Serialize() (string, error) {
+	// encoding/json sorts map keys, so the same metadata always results in the same text
+	bytes, err := json.MarshalIndent(self, "", "  ")
+	return string(bytes) + "\n", err
+}
+
+// mergeSharedMetadataValue performs a three-way merge of a single metadata value.
+// Indicates whether both sides changed the value in different ways.
+func mergeSharedMetadataValue(base, local, remote string) (string, bool) {
+	switch {
+	case local == remote, remote == base:
+		return local, false
+	case local == base:
+		return remote, false
+	default:
+		return local, true
+	}
+}
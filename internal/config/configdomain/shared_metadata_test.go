@@ -0,0 +1,102 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestSharedMetadata(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MergeSharedMetadata", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("changes on different branches", func(t *testing.T) {
+			t.Parallel()
+			base := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch-1": {Parent: "main", Type: ""},
+				"branch-2": {Parent: "main", Type: ""},
+			}}
+			local := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch-1": {Parent: "main", Type: "parked"},
+				"branch-2": {Parent: "main", Type: ""},
+			}}
+			remote := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch-1": {Parent: "main", Type: ""},
+				"branch-2": {Parent: "branch-1", Type: ""},
+				"branch-3": {Parent: "main", Type: "prototype"},
+			}}
+			have, conflicts := configdomain.MergeSharedMetadata(base, local, remote)
+			want := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch-1": {Parent: "main", Type: "parked"},
+				"branch-2": {Parent: "branch-1", Type: ""},
+				"branch-3": {Parent: "main", Type: "prototype"},
+			}}
+			must.Eq(t, want, have)
+			must.SliceEmpty(t, conflicts)
+		})
+
+		t.Run("branch removed on one side", func(t *testing.T) {
+			t.Parallel()
+			base := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch": {Parent: "main", Type: ""},
+			}}
+			local := configdomain.EmptySharedMetadata()
+			remote := base
+			have, conflicts := configdomain.MergeSharedMetadata(base, local, remote)
+			must.Eq(t, configdomain.EmptySharedMetadata(), have)
+			must.SliceEmpty(t, conflicts)
+		})
+
+		t.Run("conflicting changes", func(t *testing.T) {
+			t.Parallel()
+			base := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch": {Parent: "main", Type: ""},
+			}}
+			local := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch": {Parent: "local-parent", Type: ""},
+			}}
+			remote := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch": {Parent: "remote-parent", Type: "prototype"},
+			}}
+			have, conflicts := configdomain.MergeSharedMetadata(base, local, remote)
+			want := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+				"branch": {Parent: "local-parent", Type: "prototype"},
+			}}
+			must.Eq(t, want, have)
+			wantConflicts := []configdomain.SharedMetadataConflict{
+				{Branch: "branch", Local: "local-parent", Name: "parent", Remote: "remote-parent"},
+			}
+			must.Eq(t, wantConflicts, conflicts)
+		})
+	})
+
+	t.Run("ParseSharedMetadata and Serialize", func(t *testing.T) {
+		t.Parallel()
+		give := configdomain.SharedMetadata{Branches: map[gitdomain.LocalBranchName]configdomain.SharedBranchMetadata{
+			"branch-1": {Parent: "main", Type: "parked"},
+			"branch-2": {Parent: "branch-1", Type: ""},
+		}}
+		serialized, err := give.Serialize()
+		must.NoError(t, err)
+		want := `{
+  "branches": {
+    "branch-1": {
+      "parent": "main",
+      "type": "parked"
+    },
+    "branch-2": {
+      "parent": "branch-1"
+    }
+  }
+}
+`
+		must.EqOp(t, want, serialized)
+		have, err := configdomain.ParseSharedMetadata(serialized)
+		must.NoError(t, err)
+		must.Eq(t, give, have)
+	})
+}
@@ -0,0 +1,54 @@
+package configdomain
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// ShipSquashCommitTemplate is the Go template that creates the message of squash-merge commits.
+type ShipSquashCommitTemplate struct {
+	template *template.Template
+	text     string
+}
+
+// Render provides the commit message for the given data.
+func (self ShipSquashCommitTemplate) Render(data ShipSquashCommitTemplateData) (gitdomain.CommitMessage, error) {
+	var result strings.Builder
+	if err := self.template.Execute(&result, data); err != nil {
+		return "", fmt.Errorf(messages.SquashCommitTemplateRender, err)
+	}
+	return gitdomain.CommitMessage(strings.TrimSpace(result.String())), nil
+}
+
+func (self ShipSquashCommitTemplate) String() string {
+	return self.text
+}
+
+// ShipSquashCommitTemplateData contains the information that ShipSquashCommitTemplate can use.
+// The proposal fields are empty if the branch has no proposal.
+type ShipSquashCommitTemplateData struct {
+	Authors   []gitdomain.Author // all authors of commits in the branch
+	Body      string             // body of the proposal
+	Branch    string             // name of the shipped branch
+	CoAuthors []gitdomain.Author // authors of commits in the branch other than the author of the squash commit
+	Commits   []string           // subjects of the commits in the branch, oldest first
+	Number    int                // number of the proposal
+	Title     string             // title of the proposal
+	URL       string             // URL of the proposal
+}
+
+func ParseShipSquashCommitTemplate(value string, source string) (Option[ShipSquashCommitTemplate], error) {
+	if strings.TrimSpace(value) == "" {
+		return None[ShipSquashCommitTemplate](), nil
+	}
+	parsed, err := template.New(source).Parse(value)
+	if err != nil {
+		return None[ShipSquashCommitTemplate](), fmt.Errorf(messages.SquashCommitTemplateInvalid, source, err)
+	}
+	return Some(ShipSquashCommitTemplate{template: parsed, text: value}), nil
+}
@@ -0,0 +1,74 @@
+package configdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestShipSquashCommitTemplate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParseShipSquashCommitTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have, err := configdomain.ParseShipSquashCommitTemplate(" ", "test")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("invalid template", func(t *testing.T) {
+			t.Parallel()
+			_, err := configdomain.ParseShipSquashCommitTemplate("{{ .Title", "test")
+			must.ErrorContains(t, err, "invalid squash commit template in test")
+		})
+	})
+
+	t.Run("Render", func(t *testing.T) {
+		t.Parallel()
+		data := configdomain.ShipSquashCommitTemplateData{
+			Authors:   []gitdomain.Author{"me <me@acme.com>", "coworker <coworker@acme.com>"},
+			Body:      "proposal body",
+			Branch:    "feature",
+			CoAuthors: []gitdomain.Author{"coworker <coworker@acme.com>"},
+			Commits:   []string{"commit 1", "commit 2"},
+			Number:    123,
+			Title:     "proposal title",
+			URL:       "https://github.com/git-town/git-town/pull/123",
+		}
+
+		t.Run("title, number, and co-authors", func(t *testing.T) {
+			t.Parallel()
+			template, err := configdomain.ParseShipSquashCommitTemplate("{{.Title}} (#{{.Number}})\n\n{{range .CoAuthors}}Co-authored-by: {{.}}\n{{end}}", "test")
+			must.NoError(t, err)
+			have, err := template.GetOrPanic().Render(data)
+			must.NoError(t, err)
+			want := gitdomain.CommitMessage("proposal title (#123)\n\nCo-authored-by: coworker <coworker@acme.com>")
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("commits without proposal", func(t *testing.T) {
+			t.Parallel()
+			template, err := configdomain.ParseShipSquashCommitTemplate("{{if .Title}}{{.Title}}{{else}}{{.Branch}}{{end}}\n\n{{range .Commits}}- {{.}}\n{{end}}", "test")
+			must.NoError(t, err)
+			withoutProposal := data
+			withoutProposal.Title = ""
+			have, err := template.GetOrPanic().Render(withoutProposal)
+			must.NoError(t, err)
+			want := gitdomain.CommitMessage("feature\n\n- commit 1\n- commit 2")
+			must.EqOp(t, want, have)
+		})
+
+		t.Run("unknown field", func(t *testing.T) {
+			t.Parallel()
+			template, err := configdomain.ParseShipSquashCommitTemplate("{{.Unknown}}", "test")
+			must.NoError(t, err)
+			_, err = template.GetOrPanic().Render(data)
+			must.ErrorContains(t, err, "cannot render the squash commit template")
+		})
+	})
+}
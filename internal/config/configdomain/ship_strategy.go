@@ -11,6 +11,8 @@ import (
 const (
 	ShipStrategyAPI         ShipStrategy = "api"          // shipping via the code hosting API
 	ShipStragegyFastForward ShipStrategy = "fast-forward" // shipping by doing a local fast-forward
+	ShipStrategyMergeQueue  ShipStrategy = "merge-queue"  // shipping by adding the proposal to the merge queue of the code hosting platform
+	ShipStrategyRebaseMerge ShipStrategy = "rebase-merge" // shipping by rebasing the branch onto its parent and fast-forwarding the parent
 	ShipStrategySquashMerge ShipStrategy = "squash-merge" // shipping by doing a local squash-merge
 )
 
@@ -38,6 +40,8 @@ func ShipStrategies() []ShipStrategy {
 	return []ShipStrategy{
 		ShipStrategyAPI,
 		ShipStragegyFastForward,
+		ShipStrategyMergeQueue,
+		ShipStrategyRebaseMerge,
 		ShipStrategySquashMerge,
 	}
 }
@@ -0,0 +1,8 @@
+package configdomain
+
+// indicates whether "git town ship" should wait until the merge queue has merged the shipped proposal
+type ShipWait bool
+
+func (self ShipWait) Enabled() bool {
+	return bool(self)
+}
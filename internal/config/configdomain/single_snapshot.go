@@ -23,6 +23,17 @@ func (self SingleSnapshot) Aliases() Aliases {
 	return result
 }
 
+// provides all the keys that describe sync strategies of individual branches
+func (self SingleSnapshot) BranchSyncStrategyEntries() map[BranchSyncStrategyKey]string {
+	result := map[BranchSyncStrategyKey]string{}
+	for key, value := range self {
+		if branchSyncStrategyKey, isBranchSyncStrategyKey := NewBranchSyncStrategyKey(key).Get(); isBranchSyncStrategyKey {
+			result[branchSyncStrategyKey] = value
+		}
+	}
+	return result
+}
+
 // provides all the keys that describe lineage entries
 func (self SingleSnapshot) LineageEntries() map[LineageKey]string {
 	result := map[LineageKey]string{}
@@ -0,0 +1,12 @@
+package configdomain
+
+// indicates whether "git town sync" should only predict merge conflicts instead of syncing the branches
+type SyncCheck bool
+
+func (self SyncCheck) IsFalse() bool {
+	return !bool(self)
+}
+
+func (self SyncCheck) IsTrue() bool {
+	return bool(self)
+}
@@ -0,0 +1,32 @@
+package configdomain
+
+import (
+	"strconv"
+
+	"github.com/git-town/git-town/v17/internal/gohacks"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// SyncMetadata contains the configuration setting whether to share the branch lineage and branch types
+// with other people through a Git ref at the development remote.
+type SyncMetadata bool
+
+func (self SyncMetadata) IsFalse() bool {
+	return !self.IsTrue()
+}
+
+func (self SyncMetadata) IsTrue() bool {
+	return bool(self)
+}
+
+func (self SyncMetadata) String() string {
+	return strconv.FormatBool(self.IsTrue())
+}
+
+func ParseSyncMetadata(value string, source Key) (Option[SyncMetadata], error) {
+	parsedOpt, err := gohacks.ParseBool(value, source.String())
+	if parsed, has := parsedOpt.Get(); has {
+		return Some(SyncMetadata(parsed)), err
+	}
+	return None[SyncMetadata](), err
+}
@@ -0,0 +1,30 @@
+package configdomain
+
+import (
+	"strconv"
+
+	"github.com/git-town/git-town/v17/internal/gohacks"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// TrustConfigFileHooks contains the configuration setting whether Git Town should run
+// the hooks that the configuration file of the current repository defines.
+// Git Town reads this setting only from the local Git metadata
+// so that cloning a repository doesn't run the commands that the repository contains.
+type TrustConfigFileHooks bool
+
+func (self TrustConfigFileHooks) IsTrue() bool {
+	return bool(self)
+}
+
+func (self TrustConfigFileHooks) String() string {
+	return strconv.FormatBool(self.IsTrue())
+}
+
+func ParseTrustConfigFileHooks(value string, source Key) (Option[TrustConfigFileHooks], error) {
+	parsedOpt, err := gohacks.ParseBool(value, source.String())
+	if parsed, has := parsedOpt.Get(); has {
+		return Some(TrustConfigFileHooks(parsed)), err
+	}
+	return None[TrustConfigFileHooks](), err
+}
@@ -2,9 +2,11 @@ package configfile
 
 // Data defines the Go equivalent of the TOML file content.
 type Data struct {
+	Aliases                  *Aliases      `toml:"aliases"`
 	Branches                 *Branches     `toml:"branches"`
 	Create                   *Create       `toml:"create"`
 	CreatePrototypeBranches  *bool         `toml:"create-prototype-branches"`
+	Hooks                    *Hooks        `toml:"hooks"`
 	Hosting                  *Hosting      `toml:"hosting"`
 	PushHook                 *bool         `toml:"push-hook"`
 	PushNewbranches          *bool         `toml:"push-new-branches"`
@@ -17,14 +19,32 @@ type Data struct {
 	SyncUpstream             *bool         `toml:"sync-upstream"`
 }
 
+// Aliases defines the Git aliases for Git Town commands.
+// Git only knows aliases that exist in the Git configuration,
+// so these entries take effect only when importing the configuration file into Git metadata.
+type Aliases struct {
+	Commands []string `toml:"commands"`
+}
+
 type Branches struct {
-	ContributionRegex *string  `toml:"contribution-regex"`
-	DefaultType       *string  `toml:"default-type"`
-	FeatureRegex      *string  `toml:"feature-regex"`
-	Main              *string  `toml:"main"`
-	ObservedRegex     *string  `toml:"observed-regex"`
-	PerennialRegex    *string  `toml:"perennial-regex"`
-	Perennials        []string `toml:"perennials"`
+	ContributionRegex *string                  `toml:"contribution-regex"`
+	Contributions     []string                 `toml:"contributions"`
+	DefaultType       *string                  `toml:"default-type"`
+	FeatureRegex      *string                  `toml:"feature-regex"`
+	InferParents      *bool                    `toml:"infer-parents"`
+	Main              *string                  `toml:"main"`
+	Observed          []string                 `toml:"observed"`
+	ObservedRegex     *string                  `toml:"observed-regex"`
+	Parked            []string                 `toml:"parked"`
+	Patterns          map[string]BranchPattern `toml:"-"` // the [branches.<pattern>] sections, decoded separately because the user defines their names
+	PerennialRegex    *string                  `toml:"perennial-regex"`
+	Perennials        []string                 `toml:"perennials"`
+	Prototypes        []string                 `toml:"prototypes"`
+}
+
+// BranchPattern defines the settings in a [branches.<pattern>] section.
+type BranchPattern struct {
+	SyncStrategy *string `toml:"sync-strategy"`
 }
 
 func (self Branches) IsEmpty() bool {
@@ -36,6 +56,12 @@ type Create struct {
 	PushNewbranches *bool   `toml:"push-new-branches"`
 }
 
+type Hooks struct {
+	AfterCreateBranch *string `toml:"after-create-branch"`
+	AfterShip         *string `toml:"after-ship"`
+	BeforeSyncBranch  *string `toml:"before-sync-branch"`
+}
+
 type Hosting struct {
 	DevRemote      *string `toml:"dev-remote"`
 	OriginHostname *string `toml:"origin-hostname"`
@@ -48,16 +74,19 @@ func (self Hosting) IsEmpty() bool {
 
 type Ship struct {
 	DeleteTrackingBranch *bool   `toml:"delete-tracking-branch"`
+	SquashCommitTemplate *string `toml:"squash-commit-template"`
 	Strategy             *string `toml:"strategy"`
 }
 
 type Sync struct {
-	FeatureStrategy   *string `toml:"feature-strategy"`
-	PerennialStrategy *string `toml:"perennial-strategy"`
-	PrototypeStrategy *string `toml:"prototype-strategy"`
-	PushHook          *bool   `toml:"push-hook"`
-	Tags              *bool   `toml:"tags"`
-	Upstream          *bool   `toml:"upstream"`
+	Branches          map[string]string `toml:"branches"` // the sync strategies of individual branches in the [sync.branches] section
+	FeatureStrategy   *string           `toml:"feature-strategy"`
+	Metadata          *bool             `toml:"metadata"`
+	PerennialStrategy *string           `toml:"perennial-strategy"`
+	PrototypeStrategy *string           `toml:"prototype-strategy"`
+	PushHook          *bool             `toml:"push-hook"`
+	Tags              *bool             `toml:"tags"`
+	Upstream          *bool             `toml:"upstream"`
 }
 
 type SyncStrategy struct {
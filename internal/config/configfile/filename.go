@@ -1,5 +1,25 @@
 package configfile
 
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+)
+
 const FileName = ".git-branches.toml"
 
 const AlternativeFileName = ".git-town.toml"
+
+// FilePath provides the path of the configuration file in the given repository.
+// Provides the path of the existing configuration file, or the default path if no configuration file exists.
+func FilePath(rootDir gitdomain.RepoRootDir) string {
+	alternativePath := filepath.Join(rootDir.String(), AlternativeFileName)
+	defaultPath := filepath.Join(rootDir.String(), FileName)
+	if _, err := os.Stat(defaultPath); err != nil {
+		if _, err := os.Stat(alternativePath); err == nil {
+			return alternativePath
+		}
+	}
+	return defaultPath
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 
 	"github.com/BurntSushi/toml"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
@@ -12,13 +14,50 @@ import (
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v17/internal/messages"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"golang.org/x/exp/maps"
 )
 
 // Decode converts the given config file TOML source into Go data.
 func Decode(text string) (*Data, error) {
 	var result Data
 	_, err := toml.Decode(text, &result)
-	return &result, err
+	if err != nil {
+		return &result, err
+	}
+	patterns, err := decodeBranchPatterns(text)
+	if err != nil {
+		return &result, err
+	}
+	if len(patterns) > 0 {
+		if result.Branches == nil {
+			result.Branches = &Branches{} //exhaustruct:ignore
+		}
+		result.Branches.Patterns = patterns
+	}
+	return &result, nil
+}
+
+// decodeBranchPatterns provides the [branches.<pattern>] sections in the given config file TOML source.
+func decodeBranchPatterns(text string) (map[string]BranchPattern, error) {
+	var sections struct {
+		Branches map[string]toml.Primitive `toml:"branches"`
+	}
+	metaData, err := toml.Decode(text, &sections)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]BranchPattern{}
+	for name, primitive := range sections.Branches {
+		if metaData.Type("branches", name) != "Hash" {
+			continue
+		}
+		var pattern BranchPattern
+		if err = metaData.PrimitiveDecode(primitive, &pattern); err != nil {
+			return nil, err
+		}
+		result[name] = pattern
+	}
+	return result, nil
 }
 
 func Load(rootDir gitdomain.RepoRootDir, fileName string, finalMessages stringslice.Collector) (Option[configdomain.PartialConfig], error) {
@@ -43,22 +82,34 @@ func Load(rootDir gitdomain.RepoRootDir, fileName string, finalMessages stringsl
 // Validate converts the given low-level configfile data into high-level config data.
 func Validate(data Data, finalMessages stringslice.Collector) (configdomain.PartialConfig, error) {
 	var err error
+	branchSyncStrategies := configdomain.BranchSyncStrategies{}
+	branchSyncStrategyPatterns := configdomain.BranchSyncStrategyPatterns{}
+	var contributionBranches gitdomain.LocalBranchNames
 	var contributionRegex Option[configdomain.ContributionRegex]
 	var defaultBranchType Option[configdomain.BranchType]
 	var devRemote Option[gitdomain.Remote]
 	var featureRegex Option[configdomain.FeatureRegex]
+	var hookAfterCreateBranch Option[configdomain.HookCommand]
+	var hookAfterShip Option[configdomain.HookCommand]
+	var hookBeforeSyncBranch Option[configdomain.HookCommand]
 	var hostingOriginHostname Option[configdomain.HostingOriginHostname]
 	var hostingPlatform Option[configdomain.HostingPlatform]
+	var inferParents Option[configdomain.InferParents]
 	var mainBranch Option[gitdomain.LocalBranchName]
 	var newBranchType Option[configdomain.BranchType]
+	var observedBranches gitdomain.LocalBranchNames
 	var observedRegex Option[configdomain.ObservedRegex]
+	var parkedBranches gitdomain.LocalBranchNames
 	var perennialBranches gitdomain.LocalBranchNames
 	var perennialRegex Option[configdomain.PerennialRegex]
+	var prototypeBranches gitdomain.LocalBranchNames
 	var pushNewBranches Option[configdomain.PushNewBranches]
 	var pushHook Option[configdomain.PushHook]
 	var shipDeleteTrackingBranch Option[configdomain.ShipDeleteTrackingBranch]
+	var shipSquashCommitTemplate Option[configdomain.ShipSquashCommitTemplate]
 	var shipStrategy Option[configdomain.ShipStrategy]
 	var syncFeatureStrategy Option[configdomain.SyncFeatureStrategy]
+	var syncMetadata Option[configdomain.SyncMetadata]
 	var syncPerennialStrategy Option[configdomain.SyncPerennialStrategy]
 	var syncPrototypeStrategy Option[configdomain.SyncPrototypeStrategy]
 	var syncTags Option[configdomain.SyncTags]
@@ -92,6 +143,10 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			mainBranch = gitdomain.NewLocalBranchNameOption(*data.Branches.Main)
 		}
 		perennialBranches = gitdomain.NewLocalBranchNames(data.Branches.Perennials...)
+		contributionBranches = gitdomain.NewLocalBranchNames(data.Branches.Contributions...)
+		observedBranches = gitdomain.NewLocalBranchNames(data.Branches.Observed...)
+		parkedBranches = gitdomain.NewLocalBranchNames(data.Branches.Parked...)
+		prototypeBranches = gitdomain.NewLocalBranchNames(data.Branches.Prototypes...)
 		if data.Branches.PerennialRegex != nil {
 			perennialRegex, err = configdomain.ParsePerennialRegex(*data.Branches.PerennialRegex)
 			if err != nil {
@@ -104,6 +159,13 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 				return configdomain.EmptyPartialConfig(), err
 			}
 		}
+		branchSyncStrategyPatterns, err = validateBranchPatterns(data.Branches.Patterns)
+		if err != nil {
+			return configdomain.EmptyPartialConfig(), err
+		}
+		if data.Branches.InferParents != nil {
+			inferParents = Some(configdomain.InferParents(*data.Branches.InferParents))
+		}
 		if data.Branches.FeatureRegex != nil {
 			verifiedRegexOpt, err := configdomain.ParseRegex(*data.Branches.FeatureRegex)
 			if err != nil {
@@ -144,6 +206,17 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 			pushNewBranches = Some(configdomain.PushNewBranches(*data.Create.PushNewbranches))
 		}
 	}
+	if data.Hooks != nil {
+		if data.Hooks.AfterCreateBranch != nil {
+			hookAfterCreateBranch = configdomain.ParseHookCommand(*data.Hooks.AfterCreateBranch)
+		}
+		if data.Hooks.AfterShip != nil {
+			hookAfterShip = configdomain.ParseHookCommand(*data.Hooks.AfterShip)
+		}
+		if data.Hooks.BeforeSyncBranch != nil {
+			hookBeforeSyncBranch = configdomain.ParseHookCommand(*data.Hooks.BeforeSyncBranch)
+		}
+	}
 	if data.Hosting != nil {
 		if data.Hosting.DevRemote != nil {
 			devRemote = gitdomain.NewRemote(*data.Hosting.DevRemote)
@@ -162,6 +235,12 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		if data.Ship.DeleteTrackingBranch != nil {
 			shipDeleteTrackingBranch = Some(configdomain.ShipDeleteTrackingBranch(*data.Ship.DeleteTrackingBranch))
 		}
+		if data.Ship.SquashCommitTemplate != nil {
+			shipSquashCommitTemplate, err = configdomain.ParseShipSquashCommitTemplate(*data.Ship.SquashCommitTemplate, "ship.squash-commit-template")
+			if err != nil {
+				return configdomain.EmptyPartialConfig(), err
+			}
+		}
 		if data.Ship.Strategy != nil {
 			shipStrategy = Some(configdomain.ShipStrategy(*data.Ship.Strategy))
 		}
@@ -187,12 +266,19 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		}
 	}
 	if data.Sync != nil {
+		branchSyncStrategies, err = validateBranchSyncStrategies(data.Sync.Branches)
+		if err != nil {
+			return configdomain.EmptyPartialConfig(), err
+		}
 		if data.Sync.FeatureStrategy != nil {
 			syncFeatureStrategy, err = configdomain.ParseSyncFeatureStrategy(*data.Sync.FeatureStrategy)
 			if err != nil {
 				return configdomain.EmptyPartialConfig(), err
 			}
 		}
+		if data.Sync.Metadata != nil {
+			syncMetadata = Some(configdomain.SyncMetadata(*data.Sync.Metadata))
+		}
 		if data.Sync.PerennialStrategy != nil {
 			syncPerennialStrategy, err = configdomain.ParseSyncPerennialStrategy(*data.Sync.PerennialStrategy)
 			if err != nil {
@@ -216,39 +302,110 @@ func Validate(data Data, finalMessages stringslice.Collector) (configdomain.Part
 		}
 	}
 	return configdomain.PartialConfig{
-		Aliases:                  map[configdomain.AliasableCommand]string{},
-		BitbucketAppPassword:     None[configdomain.BitbucketAppPassword](),
-		BitbucketUsername:        None[configdomain.BitbucketUsername](),
-		ContributionBranches:     gitdomain.LocalBranchNames{},
-		ContributionRegex:        contributionRegex,
-		DefaultBranchType:        defaultBranchType,
-		DevRemote:                devRemote,
-		FeatureRegex:             featureRegex,
-		GitHubToken:              None[configdomain.GitHubToken](),
-		GitLabToken:              None[configdomain.GitLabToken](),
-		GitUserEmail:             None[configdomain.GitUserEmail](),
-		GitUserName:              None[configdomain.GitUserName](),
-		GiteaToken:               None[configdomain.GiteaToken](),
-		HostingOriginHostname:    hostingOriginHostname,
-		HostingPlatform:          hostingPlatform,
-		Lineage:                  configdomain.Lineage{},
-		MainBranch:               mainBranch,
-		NewBranchType:            newBranchType,
-		ObservedBranches:         gitdomain.LocalBranchNames{},
-		ObservedRegex:            observedRegex,
-		Offline:                  None[configdomain.Offline](),
-		ParkedBranches:           gitdomain.LocalBranchNames{},
-		PerennialBranches:        perennialBranches,
-		PerennialRegex:           perennialRegex,
-		PrototypeBranches:        gitdomain.LocalBranchNames{},
-		PushHook:                 pushHook,
-		PushNewBranches:          pushNewBranches,
-		ShipDeleteTrackingBranch: shipDeleteTrackingBranch,
-		ShipStrategy:             shipStrategy,
-		SyncFeatureStrategy:      syncFeatureStrategy,
-		SyncPerennialStrategy:    syncPerennialStrategy,
-		SyncPrototypeStrategy:    syncPrototypeStrategy,
-		SyncTags:                 syncTags,
-		SyncUpstream:             syncUpstream,
+		Aliases:                    map[configdomain.AliasableCommand]string{},
+		AzureDevOpsToken:           None[configdomain.AzureDevOpsToken](),
+		BitbucketAppPassword:       None[configdomain.BitbucketAppPassword](),
+		BitbucketUsername:          None[configdomain.BitbucketUsername](),
+		BranchSyncStrategies:       branchSyncStrategies,
+		BranchSyncStrategyPatterns: branchSyncStrategyPatterns,
+		ContributionBranches:       contributionBranches,
+		ContributionRegex:          contributionRegex,
+		DefaultBranchType:          defaultBranchType,
+		DevRemote:                  devRemote,
+		FeatureRegex:               featureRegex,
+		GitHubToken:                None[configdomain.GitHubToken](),
+		GitLabToken:                None[configdomain.GitLabToken](),
+		GitUserEmail:               None[configdomain.GitUserEmail](),
+		GitUserName:                None[configdomain.GitUserName](),
+		GiteaToken:                 None[configdomain.GiteaToken](),
+		HookAfterCreateBranch:      hookAfterCreateBranch,
+		HookAfterShip:              hookAfterShip,
+		HookBeforeSyncBranch:       hookBeforeSyncBranch,
+		HostingOriginHostname:      hostingOriginHostname,
+		HostingPlatform:            hostingPlatform,
+		InferParents:               inferParents,
+		Lineage:                    configdomain.Lineage{},
+		MainBranch:                 mainBranch,
+		NewBranchType:              newBranchType,
+		ObservedBranches:           observedBranches,
+		ObservedRegex:              observedRegex,
+		Offline:                    None[configdomain.Offline](),
+		ParkedBranches:             parkedBranches,
+		PerennialBranches:          perennialBranches,
+		PerennialRegex:             perennialRegex,
+		PrototypeBranches:          prototypeBranches,
+		PushHook:                   pushHook,
+		PushNewBranches:            pushNewBranches,
+		ShipDeleteTrackingBranch:   shipDeleteTrackingBranch,
+		ShipSquashCommitTemplate:   shipSquashCommitTemplate,
+		ShipStrategy:               shipStrategy,
+		SyncFeatureStrategy:        syncFeatureStrategy,
+		SyncMetadata:               syncMetadata,
+		SyncPerennialStrategy:      syncPerennialStrategy,
+		SyncPrototypeStrategy:      syncPrototypeStrategy,
+		SyncTags:                   syncTags,
+		SyncUpstream:               syncUpstream,
 	}, nil
 }
+
+// ValidateAliases provides the Git aliases for Git Town commands defined in the given low-level configfile data.
+func ValidateAliases(data Data) (configdomain.Aliases, error) {
+	result := configdomain.Aliases{}
+	if data.Aliases == nil {
+		return result, nil
+	}
+	for _, command := range data.Aliases.Commands {
+		aliasableCommand, isAliasableCommand := configdomain.AllAliasableCommands().LookupCommand(command).Get()
+		if !isAliasableCommand {
+			return result, fmt.Errorf(messages.ConfigAliasUnknown, command)
+		}
+		result[aliasableCommand] = "town " + aliasableCommand.String()
+	}
+	return result, nil
+}
+
+// validateBranchSyncStrategies converts the given [sync.branches] section into the sync strategies of individual branches.
+func validateBranchSyncStrategies(branches map[string]string) (configdomain.BranchSyncStrategies, error) {
+	result := configdomain.BranchSyncStrategies{}
+	for branchText, syncStrategyText := range branches {
+		branch, hasBranch := gitdomain.NewLocalBranchNameOption(branchText).Get()
+		if !hasBranch {
+			continue
+		}
+		syncStrategyOpt, err := configdomain.ParseSyncStrategy(syncStrategyText)
+		if err != nil {
+			return result, err
+		}
+		if syncStrategy, hasSyncStrategy := syncStrategyOpt.Get(); hasSyncStrategy {
+			result[branch] = syncStrategy
+		}
+	}
+	return result, nil
+}
+
+// validateBranchPatterns converts the given [branches.<pattern>] sections into the sync strategies for branch name patterns, sorted by pattern.
+func validateBranchPatterns(patterns map[string]BranchPattern) (configdomain.BranchSyncStrategyPatterns, error) {
+	result := configdomain.BranchSyncStrategyPatterns{}
+	names := maps.Keys(patterns)
+	slices.Sort(names)
+	for _, name := range names {
+		if _, err := path.Match(name, ""); err != nil {
+			return result, fmt.Errorf(messages.ConfigBranchPatternInvalid, name, err)
+		}
+		syncStrategyText := patterns[name].SyncStrategy
+		if syncStrategyText == nil {
+			continue
+		}
+		syncStrategyOpt, err := configdomain.ParseSyncStrategy(*syncStrategyText)
+		if err != nil {
+			return result, err
+		}
+		if syncStrategy, hasSyncStrategy := syncStrategyOpt.Get(); hasSyncStrategy {
+			result = append(result, configdomain.BranchSyncStrategyPattern{
+				Pattern:      name,
+				SyncStrategy: syncStrategy,
+			})
+		}
+	}
+	return result, nil
+}
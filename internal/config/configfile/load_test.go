@@ -29,6 +29,7 @@ main = "main"
 contribution-regex = "^gittown-"
 default-type = "prototype"
 feature-regex = "^kg-"
+infer-parents = true
 observed-regex = "^dependabot\\/"
 perennials = [ "public", "staging" ]
 perennial-regex = "release-.*"
@@ -37,16 +38,23 @@ perennial-regex = "release-.*"
 new-branch-type = "prototype"
 push-new-branches = true
 
+[hooks]
+after-create-branch = "make lint"
+after-ship = "./notify-chat.sh"
+before-sync-branch = "make generate"
+
 [hosting]
 platform = "github"
 origin-hostname = "github.com"
 
 [ship]
 delete-tracking-branch = false
+squash-commit-template = "{{.Title}} (#{{.Number}})"
 strategy = "api"
 
 [sync]
 feature-strategy = "merge"
+metadata = true
 perennial-strategy = "rebase"
 prototype-strategy = "compress"
 push-hook = true
@@ -65,6 +73,7 @@ prototype-branches = "compress"
 					ContributionRegex: Ptr("^gittown-"),
 					DefaultType:       Ptr("prototype"),
 					FeatureRegex:      Ptr("^kg-"),
+					InferParents:      Ptr(true),
 					Main:              Ptr("main"),
 					ObservedRegex:     Ptr(`^dependabot\/`),
 					PerennialRegex:    Ptr("release-.*"),
@@ -74,16 +83,23 @@ prototype-branches = "compress"
 					NewBranchType:   Ptr("prototype"),
 					PushNewbranches: Ptr(true),
 				},
+				Hooks: &configfile.Hooks{
+					AfterCreateBranch: Ptr("make lint"),
+					AfterShip:         Ptr("./notify-chat.sh"),
+					BeforeSyncBranch:  Ptr("make generate"),
+				},
 				Hosting: &configfile.Hosting{
 					Platform:       Ptr("github"),
 					OriginHostname: Ptr("github.com"),
 				},
 				Ship: &configfile.Ship{
 					DeleteTrackingBranch: Ptr(false),
+					SquashCommitTemplate: Ptr("{{.Title}} (#{{.Number}})"),
 					Strategy:             Ptr("api"),
 				},
 				Sync: &configfile.Sync{
 					FeatureStrategy:   Ptr("merge"),
+					Metadata:          Ptr(true),
 					PerennialStrategy: Ptr("rebase"),
 					PrototypeStrategy: Ptr("compress"),
 					PushHook:          Ptr(true),
@@ -130,6 +146,56 @@ main = "main"
 			must.Eq(t, want, *have)
 		})
 
+		t.Run("branch patterns", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[branches]
+main = "main"
+
+[branches.shared]
+sync-strategy = "merge"
+
+[branches."personal/*"]
+sync-strategy = "rebase"
+`[1:]
+			have, err := configfile.Decode(give)
+			must.NoError(t, err)
+			want := configfile.Data{
+				Branches: &configfile.Branches{
+					Main: Ptr("main"),
+					Patterns: map[string]configfile.BranchPattern{
+						"personal/*": {SyncStrategy: Ptr("rebase")},
+						"shared":     {SyncStrategy: Ptr("merge")},
+					},
+				},
+			}
+			must.Eq(t, want, *have)
+		})
+
+		t.Run("branch sync strategies", func(t *testing.T) {
+			t.Parallel()
+			give := `
+[sync]
+feature-strategy = "rebase"
+
+[sync.branches]
+"shared" = "merge"
+"kg/experiment" = "compress"
+`[1:]
+			have, err := configfile.Decode(give)
+			must.NoError(t, err)
+			want := configfile.Data{
+				Sync: &configfile.Sync{
+					Branches: map[string]string{
+						"kg/experiment": "compress",
+						"shared":        "merge",
+					},
+					FeatureStrategy: Ptr("rebase"),
+				},
+			}
+			must.Eq(t, want, *have)
+		})
+
 		t.Run("dotted keys", func(t *testing.T) {
 			t.Parallel()
 			give := `
@@ -6,26 +6,46 @@ import (
 	"strings"
 
 	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 )
 
-func RenderPerennialBranches(perennials gitdomain.LocalBranchNames) string {
-	if len(perennials) == 0 {
+func RenderBranchNames(branches gitdomain.LocalBranchNames) string {
+	if len(branches) == 0 {
 		return "[]"
 	}
-	return fmt.Sprintf(`["%s"]`, perennials.Join(`", "`))
+	return fmt.Sprintf(`["%s"]`, branches.Join(`", "`))
 }
 
 func RenderTOML(config *config.UnvalidatedConfig) string {
 	result := strings.Builder{}
 	result.WriteString("# More info around this file at https://www.git-town.com/configuration-file\n")
+	// Git only knows aliases that exist in the Git configuration, so they only appear if the user has set them up
+	if aliases := renderAliases(config.NormalConfig.Aliases); aliases != "" {
+		result.WriteString("\n[aliases]\n")
+		result.WriteString(fmt.Sprintf("commands = %s\n", aliases))
+	}
 	result.WriteString("\n[branches]\n")
 	result.WriteString(fmt.Sprintf("main = %q\n", config.UnvalidatedConfig.MainBranch))
-	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderPerennialBranches(config.NormalConfig.PerennialBranches)))
+	result.WriteString(fmt.Sprintf("perennials = %s\n", RenderBranchNames(config.NormalConfig.PerennialBranches)))
 	result.WriteString(fmt.Sprintf("perennial-regex = %q\n", config.NormalConfig.PerennialRegex))
+	result.WriteString(renderBranchTypes(config))
+	// inferring parents cannot be configured via the setup assistant, so it only appears if the user has enabled it
+	if config.NormalConfig.InferParents.IsTrue() {
+		result.WriteString("infer-parents = true\n")
+	}
+	// sync strategies for branch name patterns cannot be configured via the setup assistant, so they only appear if the user has configured them
+	for _, pattern := range config.NormalConfig.BranchSyncStrategyPatterns {
+		result.WriteString(fmt.Sprintf("\n[branches.%q]\n", pattern.Pattern))
+		result.WriteString(fmt.Sprintf("sync-strategy = %q\n", pattern.SyncStrategy))
+	}
 	result.WriteString("\n[create]\n")
 	result.WriteString(fmt.Sprintf("new-branch-type = %q\n", config.NormalConfig.NewBranchType))
 	result.WriteString(fmt.Sprintf("push-new-branches = %t\n", config.NormalConfig.PushNewBranches))
+	if hooks := renderHooks(config); hooks != "" {
+		result.WriteString("\n[hooks]\n")
+		result.WriteString(hooks)
+	}
 	result.WriteString("\n[hosting]\n")
 	result.WriteString(fmt.Sprintf("dev-remote = %q\n", config.NormalConfig.DevRemote.String()))
 	if platform, has := config.NormalConfig.HostingPlatform.Get(); has {
@@ -40,14 +60,91 @@ func RenderTOML(config *config.UnvalidatedConfig) string {
 	}
 	result.WriteString("\n[ship]\n")
 	result.WriteString(fmt.Sprintf("delete-tracking-branch = %t\n", config.NormalConfig.ShipDeleteTrackingBranch))
+	// the squash commit template cannot be configured via the setup assistant, so it only appears if the user has configured it
+	if template, has := config.NormalConfig.ShipSquashCommitTemplate.Get(); has {
+		result.WriteString(fmt.Sprintf("squash-commit-template = %q\n", template))
+	}
 	result.WriteString(fmt.Sprintf("strategy = %q\n", config.NormalConfig.ShipStrategy))
 	result.WriteString("\n[sync]\n")
 	result.WriteString(fmt.Sprintf("feature-strategy = %q\n", config.NormalConfig.SyncFeatureStrategy))
+	// sharing metadata cannot be configured via the setup assistant, so it only appears if the user has enabled it
+	if config.NormalConfig.SyncMetadata.IsTrue() {
+		result.WriteString("metadata = true\n")
+	}
 	result.WriteString(fmt.Sprintf("perennial-strategy = %q\n", config.NormalConfig.SyncPerennialStrategy))
 	result.WriteString(fmt.Sprintf("prototype-strategy = %q\n", config.NormalConfig.SyncPrototypeStrategy))
 	result.WriteString(fmt.Sprintf("push-hook = %t\n", config.NormalConfig.PushHook))
 	result.WriteString(fmt.Sprintf("tags = %t\n", config.NormalConfig.SyncTags))
 	result.WriteString(fmt.Sprintf("upstream = %t\n", config.NormalConfig.SyncUpstream))
+	// sync strategies of individual branches cannot be configured via the setup assistant, so they only appear if the user has configured them
+	if len(config.NormalConfig.BranchSyncStrategies) > 0 {
+		result.WriteString("\n[sync.branches]\n")
+		for _, branch := range config.NormalConfig.BranchSyncStrategies.Branches() {
+			result.WriteString(fmt.Sprintf("%q = %q\n", branch, config.NormalConfig.BranchSyncStrategies[branch]))
+		}
+	}
+	return result.String()
+}
+
+// renderAliases provides the TOML array of the Git Town commands that have an alias.
+// Returns an empty string if there are no such aliases.
+func renderAliases(aliases configdomain.Aliases) string {
+	commands := []string{}
+	for _, aliasableCommand := range configdomain.AllAliasableCommands() {
+		if aliases[aliasableCommand] == "town "+aliasableCommand.String() {
+			commands = append(commands, aliasableCommand.String())
+		}
+	}
+	if len(commands) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`["%s"]`, strings.Join(commands, `", "`))
+}
+
+// renderBranchTypes provides the TOML lines for the configured branch types.
+// Branch types cannot be configured via the setup assistant, so they only appear if the user has configured them.
+func renderBranchTypes(config *config.UnvalidatedConfig) string {
+	result := strings.Builder{}
+	if regex, has := config.NormalConfig.ContributionRegex.Get(); has {
+		result.WriteString(fmt.Sprintf("contribution-regex = %q\n", regex))
+	}
+	if len(config.NormalConfig.ContributionBranches) > 0 {
+		result.WriteString(fmt.Sprintf("contributions = %s\n", RenderBranchNames(config.NormalConfig.ContributionBranches)))
+	}
+	if config.NormalConfig.DefaultBranchType != configdomain.BranchTypeFeatureBranch {
+		result.WriteString(fmt.Sprintf("default-type = %q\n", config.NormalConfig.DefaultBranchType))
+	}
+	if regex, has := config.NormalConfig.FeatureRegex.Get(); has {
+		result.WriteString(fmt.Sprintf("feature-regex = %q\n", regex))
+	}
+	if len(config.NormalConfig.ObservedBranches) > 0 {
+		result.WriteString(fmt.Sprintf("observed = %s\n", RenderBranchNames(config.NormalConfig.ObservedBranches)))
+	}
+	if regex, has := config.NormalConfig.ObservedRegex.Get(); has {
+		result.WriteString(fmt.Sprintf("observed-regex = %q\n", regex))
+	}
+	if len(config.NormalConfig.ParkedBranches) > 0 {
+		result.WriteString(fmt.Sprintf("parked = %s\n", RenderBranchNames(config.NormalConfig.ParkedBranches)))
+	}
+	if len(config.NormalConfig.PrototypeBranches) > 0 {
+		result.WriteString(fmt.Sprintf("prototypes = %s\n", RenderBranchNames(config.NormalConfig.PrototypeBranches)))
+	}
+	return result.String()
+}
+
+// renderHooks provides the TOML lines for the configured hooks.
+// Hooks cannot be configured via the setup assistant, so their section only exists if the user has configured hooks.
+func renderHooks(config *config.UnvalidatedConfig) string {
+	result := strings.Builder{}
+	if command, has := config.NormalConfig.HookAfterCreateBranch.Get(); has {
+		result.WriteString(fmt.Sprintf("after-create-branch = %q\n", command))
+	}
+	if command, has := config.NormalConfig.HookAfterShip.Get(); has {
+		result.WriteString(fmt.Sprintf("after-ship = %q\n", command))
+	}
+	if command, has := config.NormalConfig.HookBeforeSyncBranch.Get(); has {
+		result.WriteString(fmt.Sprintf("before-sync-branch = %q\n", command))
+	}
 	return result.String()
 }
 
@@ -17,26 +17,26 @@ import (
 func TestSave(t *testing.T) {
 	t.Parallel()
 
-	t.Run("RenderPerennialBranches", func(t *testing.T) {
+	t.Run("RenderBranchNames", func(t *testing.T) {
 		t.Parallel()
-		t.Run("no perennial branches", func(t *testing.T) {
+		t.Run("no branches", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.LocalBranchNames{}
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := "[]"
 			must.EqOp(t, want, have)
 		})
-		t.Run("one perennial branch", func(t *testing.T) {
+		t.Run("one branch", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.NewLocalBranchNames("one")
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := `["one"]`
 			must.EqOp(t, want, have)
 		})
-		t.Run("multiple perennial branches", func(t *testing.T) {
+		t.Run("multiple branches", func(t *testing.T) {
 			t.Parallel()
 			give := gitdomain.NewLocalBranchNames("one", "two")
-			have := configfile.RenderPerennialBranches(give)
+			have := configfile.RenderBranchNames(give)
 			want := `["one", "two"]`
 			must.EqOp(t, want, have)
 		})
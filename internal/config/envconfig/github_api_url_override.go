@@ -0,0 +1,15 @@
+package envconfig
+
+import (
+	"os"
+
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// GitHubAPIURLKey is the name of the environment variable that overrides the URL of the GitHub API.
+// End-to-end tests use it to point Git Town at a fake GitHub API.
+const GitHubAPIURLKey = "GIT_TOWN_GITHUB_API_URL"
+
+func GitHubAPIURLOverride() Option[string] {
+	return NewOption(os.Getenv(GitHubAPIURLKey))
+}
@@ -59,7 +59,7 @@ func (self *Access) RemoveLocalConfigValue(key configdomain.Key) error {
 }
 
 // RemoveLocalGitConfiguration removes all Git Town configuration.
-func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) error {
+func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage, branchSyncStrategies configdomain.BranchSyncStrategies) error {
 	err := self.Run("git", "config", "--remove-section", "git-town")
 	if err != nil {
 		var exitErr *exec.ExitError
@@ -79,6 +79,12 @@ func (self *Access) RemoveLocalGitConfiguration(lineage configdomain.Lineage) er
 			return fmt.Errorf(messages.ConfigRemoveError, err)
 		}
 	}
+	for _, branch := range branchSyncStrategies.Branches() {
+		err = self.Run("git", "config", "--unset", configdomain.NewSyncStrategyKey(branch).String())
+		if err != nil {
+			return fmt.Errorf(messages.ConfigRemoveError, err)
+		}
+	}
 	return nil
 }
 
@@ -102,10 +102,39 @@ func (self *NormalConfig) RemoteURLString(remote gitdomain.Remote) Option[string
 	return self.GitConfigAccess.RemoteURL(remote)
 }
 
+// RemoveBranchSyncStrategies removes the sync strategies of all individual branches from the Git configuration.
+func (self *NormalConfig) RemoveBranchSyncStrategies() {
+	for _, branch := range self.LocalGitConfig.BranchSyncStrategies.Branches() {
+		self.RemoveBranchSyncStrategy(branch)
+	}
+}
+
+// RemoveBranchSyncStrategy removes the sync strategy configured for the given branch from the Git configuration.
+func (self *NormalConfig) RemoveBranchSyncStrategy(branch gitdomain.LocalBranchName) {
+	if _, has := self.LocalGitConfig.BranchSyncStrategies[branch]; !has {
+		return
+	}
+	delete(self.LocalGitConfig.BranchSyncStrategies, branch)
+	delete(self.BranchSyncStrategies, branch)
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.NewSyncStrategyKey(branch))
+}
+
+func (self *NormalConfig) RemoveContributionBranches() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyContributionBranches)
+}
+
+func (self *NormalConfig) RemoveContributionRegex() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyContributionRegex)
+}
+
 func (self *NormalConfig) RemoveCreatePrototypeBranches() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyDeprecatedCreatePrototypeBranches)
 }
 
+func (self *NormalConfig) RemoveDefaultBranchType() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyDefaultBranchType)
+}
+
 func (self *NormalConfig) RemoveDevRemote() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyDevRemote)
 }
@@ -144,10 +173,46 @@ func (self *NormalConfig) RemoveFromPrototypeBranches(branch gitdomain.LocalBran
 	return self.SetPrototypeBranches(self.PrototypeBranches)
 }
 
+func (self *NormalConfig) RemoveHookAfterCreateBranch() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyHookAfterCreateBranch)
+}
+
+func (self *NormalConfig) RemoveHookAfterShip() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyHookAfterShip)
+}
+
+func (self *NormalConfig) RemoveHookBeforeSyncBranch() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyHookBeforeSyncBranch)
+}
+
+func (self *NormalConfig) RemoveHostingOriginHostname() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyHostingOriginHostname)
+}
+
+func (self *NormalConfig) RemoveHostingPlatform() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyHostingPlatform)
+}
+
+func (self *NormalConfig) RemoveInferParents() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyInferParents)
+}
+
 func (self *NormalConfig) RemoveNewBranchType() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyNewBranchType)
 }
 
+func (self *NormalConfig) RemoveObservedBranches() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyObservedBranches)
+}
+
+func (self *NormalConfig) RemoveObservedRegex() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyObservedRegex)
+}
+
+func (self *NormalConfig) RemoveParkedBranches() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyParkedBranches)
+}
+
 // RemoveParent removes the parent branch entry for the given branch from the Git configuration.
 func (self *NormalConfig) RemoveParent(branch gitdomain.LocalBranchName) {
 	self.LocalGitConfig.Lineage = self.LocalGitConfig.Lineage.RemoveBranch(branch)
@@ -172,6 +237,10 @@ func (self *NormalConfig) RemovePerennialRegex() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyPerennialRegex)
 }
 
+func (self *NormalConfig) RemovePrototypeBranches() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyPrototypeBranches)
+}
+
 func (self *NormalConfig) RemovePushHook() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyPushHook)
 }
@@ -184,6 +253,10 @@ func (self *NormalConfig) RemoveShipDeleteTrackingBranch() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyShipDeleteTrackingBranch)
 }
 
+func (self *NormalConfig) RemoveShipSquashCommitTemplate() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyShipSquashCommitTemplate)
+}
+
 func (self *NormalConfig) RemoveShipStrategy() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeyShipStrategy)
 }
@@ -192,6 +265,10 @@ func (self *NormalConfig) RemoveSyncFeatureStrategy() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeySyncFeatureStrategy)
 }
 
+func (self *NormalConfig) RemoveSyncMetadata() {
+	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeySyncMetadata)
+}
+
 func (self *NormalConfig) RemoveSyncPerennialStrategy() {
 	_ = self.GitConfigAccess.RemoveLocalConfigValue(configdomain.KeySyncPerennialStrategy)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/git"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/messages"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 )
 
@@ -107,10 +108,11 @@ func NewConfigs(configFile Option[configdomain.PartialConfig], globalGitConfig,
 }
 
 func NewUnvalidatedConfig(args NewUnvalidatedConfigArgs) UnvalidatedConfig {
-	unvalidatedConfig, normalConfig := MergeConfigs(args.ConfigFile, args.GlobalConfig, args.LocalConfig)
+	configFile := trustedConfigFile(args.ConfigFile, args.LocalConfig, args.FinalMessages)
+	unvalidatedConfig, normalConfig := MergeConfigs(configFile, args.GlobalConfig, args.LocalConfig)
 	return UnvalidatedConfig{
 		NormalConfig: NormalConfig{
-			ConfigFile:       args.ConfigFile,
+			ConfigFile:       configFile,
 			DryRun:           args.DryRun,
 			GitConfigAccess:  args.Access,
 			GitVersion:       args.GitVersion,
@@ -131,3 +133,15 @@ type NewUnvalidatedConfigArgs struct {
 	GlobalConfig  configdomain.PartialConfig
 	LocalConfig   configdomain.PartialConfig
 }
+
+// trustedConfigFile provides the parts of the given configuration file that Git Town should apply.
+// Hooks in the configuration file run only if the user has opted into running them in the local Git metadata,
+// so that cloning a repository doesn't execute the commands that the repository contains.
+func trustedConfigFile(configFile Option[configdomain.PartialConfig], localGitConfig configdomain.PartialConfig, finalMessages stringslice.Collector) Option[configdomain.PartialConfig] {
+	file, hasFile := configFile.Get()
+	if !hasFile || !file.HasHooks() || localGitConfig.TrustConfigFileHooks.GetOrDefault().IsTrue() {
+		return configFile
+	}
+	finalMessages.Add(messages.ConfigFileHooksUntrusted)
+	return Some(file.WithoutHooks())
+}
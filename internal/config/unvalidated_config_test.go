@@ -3,8 +3,14 @@ package config_test
 import (
 	"testing"
 
+	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/config/gitconfig"
+	"github.com/git-town/git-town/v17/internal/git"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
 	"github.com/git-town/git-town/v17/test/testruntime"
 	"github.com/shoenig/test/must"
 )
@@ -12,6 +18,48 @@ import (
 func TestUnvalidatedConfig(t *testing.T) {
 	t.Parallel()
 
+	t.Run("NewUnvalidatedConfig", func(t *testing.T) {
+		t.Parallel()
+		newConfig := func(localConfig configdomain.PartialConfig, finalMessages stringslice.Collector) config.UnvalidatedConfig {
+			configFile := configdomain.EmptyPartialConfig()
+			configFile.HookAfterShip = Some(configdomain.HookCommand("make deploy"))
+			configFile.SyncTags = Some(configdomain.SyncTags(false))
+			return config.NewUnvalidatedConfig(config.NewUnvalidatedConfigArgs{
+				Access:        gitconfig.Access{Runner: nil},
+				ConfigFile:    Some(configFile),
+				DryRun:        false,
+				FinalMessages: finalMessages,
+				GitVersion:    git.Version{Major: 2, Minor: 40},
+				GlobalConfig:  configdomain.EmptyPartialConfig(),
+				LocalConfig:   localConfig,
+			})
+		}
+		t.Run("hooks in the config file are not trusted", func(t *testing.T) {
+			t.Parallel()
+			finalMessages := stringslice.NewCollector()
+			have := newConfig(configdomain.EmptyPartialConfig(), finalMessages)
+			must.True(t, have.NormalConfig.HookAfterShip.IsNone())
+			must.False(t, have.NormalConfig.SyncTags.IsTrue())
+			must.Eq(t, []string{messages.ConfigFileHooksUntrusted}, finalMessages.Result())
+		})
+		t.Run("hooks in the config file are trusted", func(t *testing.T) {
+			t.Parallel()
+			localConfig := configdomain.EmptyPartialConfig()
+			localConfig.TrustConfigFileHooks = Some(configdomain.TrustConfigFileHooks(true))
+			finalMessages := stringslice.NewCollector()
+			have := newConfig(localConfig, finalMessages)
+			must.Eq(t, Some(configdomain.HookCommand("make deploy")), have.NormalConfig.HookAfterShip)
+			must.SliceEmpty(t, finalMessages.Result())
+		})
+		t.Run("hooks in the local Git metadata", func(t *testing.T) {
+			t.Parallel()
+			localConfig := configdomain.EmptyPartialConfig()
+			localConfig.HookBeforeSyncBranch = Some(configdomain.HookCommand("make lint"))
+			have := newConfig(localConfig, stringslice.NewCollector())
+			must.Eq(t, Some(configdomain.HookCommand("make lint")), have.NormalConfig.HookBeforeSyncBranch)
+		})
+	})
+
 	t.Run("Reload", func(t *testing.T) {
 		t.Parallel()
 		t.Run("lineage changed", func(t *testing.T) {
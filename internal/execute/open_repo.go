@@ -61,11 +61,11 @@ func OpenRepo(args OpenRepoArgs) (OpenRepoResult, error) {
 		}
 	}
 	configGitAccess := gitconfig.Access{Runner: backendRunner}
-	globalSnapshot, globalConfig, err := configGitAccess.LoadGlobal(true)
+	globalSnapshot, globalConfig, err := configGitAccess.LoadGlobal(args.UpdateOutdatedSettings)
 	if err != nil {
 		return emptyOpenRepoResult(), err
 	}
-	localSnapshot, localConfig, err := configGitAccess.LoadLocal(true)
+	localSnapshot, localConfig, err := configGitAccess.LoadLocal(args.UpdateOutdatedSettings)
 	if err != nil {
 		return emptyOpenRepoResult(), err
 	}
@@ -131,12 +131,13 @@ func OpenRepo(args OpenRepoArgs) (OpenRepoResult, error) {
 }
 
 type OpenRepoArgs struct {
-	DryRun           configdomain.DryRun
-	PrintBranchNames bool
-	PrintCommands    bool
-	ValidateGitRepo  bool
-	ValidateIsOnline bool
-	Verbose          configdomain.Verbose
+	DryRun                 configdomain.DryRun
+	PrintBranchNames       bool
+	PrintCommands          bool
+	UpdateOutdatedSettings bool // whether to migrate deprecated and obsolete settings in the Git metadata
+	ValidateGitRepo        bool
+	ValidateIsOnline       bool
+	Verbose                configdomain.Verbose
 }
 
 type OpenRepoResult struct {
@@ -168,6 +169,7 @@ func newFrontendRunner(args newFrontendRunnerArgs) gitdomain.Runner { //nolint:i
 	}
 	return &subshell.FrontendRunner{
 		Backend:          args.backend,
+		Dir:              None[string](),
 		GetCurrentBranch: args.getCurrentBranch,
 		PrintBranchNames: args.printBranchNames,
 		PrintCommands:    args.printCommands,
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,6 +25,11 @@ type Commands struct {
 	RemotesCache       *cache.Remotes                 // caches Git remotes
 }
 
+// AbortCherryPick cancels a currently ongoing Git cherry-pick operation.
+func (self *Commands) AbortCherryPick(runner gitdomain.Runner) error {
+	return runner.Run("git", "cherry-pick", "--abort")
+}
+
 // AbortMerge cancels a currently ongoing Git merge operation.
 func (self *Commands) AbortMerge(runner gitdomain.Runner) error {
 	return runner.Run("git", "merge", "--abort")
@@ -34,6 +40,67 @@ func (self *Commands) AbortRebase(runner gitdomain.Runner) error {
 	return runner.Run("git", "rebase", "--abort")
 }
 
+// AbortRevert cancels a currently ongoing Git revert operation.
+func (self *Commands) AbortRevert(runner gitdomain.Runner) error {
+	return runner.Run("git", "revert", "--abort")
+}
+
+// AheadBehind provides how many commits the given local branch is ahead and behind the given tracking branch.
+func (self *Commands) AheadBehind(querier gitdomain.Querier, branch gitdomain.LocalBranchName, trackingBranch gitdomain.RemoteBranchName) (int, int, error) {
+	output, err := querier.QueryTrim("git", "rev-list", "--left-right", "--count", branch.String()+"..."+trackingBranch.String())
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseAheadBehind(output)
+}
+
+// AheadBehindLocal provides how many commits the given local branch is ahead and behind the given other local branch,
+// measured from the point where both branches forked.
+func (self *Commands) AheadBehindLocal(querier gitdomain.Querier, branch, other gitdomain.LocalBranchName) (int, int, error) {
+	output, err := querier.QueryTrim("git", "rev-list", "--left-right", "--count", branch.String()+"..."+other.String())
+	if err != nil {
+		return 0, 0, err
+	}
+	return parseAheadBehind(output)
+}
+
+// ApplyPatch applies the given patch to the workspace and the Git index.
+// Applies patches that don't apply cleanly using a three-way merge that leaves conflicts for the user to resolve.
+// The reverse option removes the changes in the given patch from the workspace and the Git index.
+func (self *Commands) ApplyPatch(runner gitdomain.Runner, querier gitdomain.Querier, patch string, reverse bool) error {
+	patchFile, err := querier.QueryTrim("git", "rev-parse", "--git-path", "git-town.patch")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(patchFile, []byte(patch), 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.PatchFileWriteProblem, patchFile, err)
+	}
+	defer os.Remove(patchFile)
+	args := []string{"apply"}
+	if reverse {
+		args = append(args, "--index", "--reverse")
+	} else {
+		args = append(args, "--3way")
+	}
+	return runner.Run("git", append(args, patchFile)...)
+}
+
+// BlameLines provides the SHAs of the commits that last changed the given lines of the given file in the current branch,
+// in the order in which these lines appear in the file.
+func (self *Commands) BlameLines(querier gitdomain.Querier, file string, lines []int) (gitdomain.SHAs, error) {
+	args := []string{"blame", "--porcelain"}
+	for _, line := range lines {
+		args = append(args, "-L", fmt.Sprintf("%d,%d", line, line))
+	}
+	args = append(args, "HEAD", "--", file)
+	output, err := querier.QueryTrim("git", args...)
+	if err != nil {
+		return gitdomain.SHAs{}, err
+	}
+	return ParseBlamePorcelainOutput(output), nil
+}
+
 // BranchAuthors provides the user accounts that contributed to the given branch.
 // Returns lines of "name <email>".
 func (self *Commands) BranchAuthors(querier gitdomain.Querier, branch, parent gitdomain.LocalBranchName) ([]gitdomain.Author, error) {
@@ -71,6 +138,46 @@ func (self *Commands) BranchHasUnmergedChanges(querier gitdomain.Querier, branch
 	return len(out) > 0, nil
 }
 
+// BranchShippedInto indicates whether the changes of the given branch exist in the given parent branch
+// because somebody squash-merged or rebase-merged the branch into it.
+// Branches without commits of their own don't count as shipped.
+// This requires Git 2.38 or higher.
+func (self *Commands) BranchShippedInto(querier gitdomain.Querier, branch gitdomain.LocalBranchName, parent gitdomain.BranchName) (bool, error) {
+	output, err := querier.QueryTrim("git", "cherry", parent.String(), branch.String())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchShippedProblem, branch, err)
+	}
+	lines := stringslice.Lines(output)
+	if len(lines) == 0 {
+		return false, nil
+	}
+	if len(stringslice.LinesWithPrefix(lines, "- ")) == len(lines) {
+		// the parent contains equivalent patches for all commits of the branch --> rebase-merged
+		return true, nil
+	}
+	// squash-merged: merging the branch into the parent doesn't change the parent
+	parentSHA, err := self.SHAForBranch(querier, parent)
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchShippedProblem, branch, err)
+	}
+	branchSHA, err := self.SHAForBranch(querier, branch.BranchName())
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchShippedProblem, branch, err)
+	}
+	mergedTree, conflictingFiles, err := self.mergeTree(querier, parentSHA, branchSHA)
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchShippedProblem, branch, err)
+	}
+	if len(conflictingFiles) > 0 {
+		return false, nil
+	}
+	parentTree, err := querier.QueryTrim("git", "rev-parse", parent.String()+"^{tree}")
+	if err != nil {
+		return false, fmt.Errorf(messages.BranchShippedProblem, branch, err)
+	}
+	return mergedTree.String() == parentTree, nil
+}
+
 // BranchesSnapshot provides detailed information about the sync status of all branches.
 func (self *Commands) BranchesSnapshot(querier gitdomain.Querier) (gitdomain.BranchesSnapshot, error) { //nolint:nonamedreturns
 	output, err := querier.Query("git", "branch", "-vva", "--sort=refname")
@@ -115,6 +222,11 @@ func (self *Commands) CheckoutBranchUncached(runner gitdomain.Runner, name gitdo
 	return nil
 }
 
+// CherryPick applies the commit with the given SHA to the current branch.
+func (self *Commands) CherryPick(runner gitdomain.Runner, sha gitdomain.SHA) error {
+	return runner.Run("git", "cherry-pick", sha.String())
+}
+
 func (self *Commands) CheckoutOurVersion(runner gitdomain.Runner, file string) error {
 	return runner.Run("git", "checkout", "--ours", file)
 }
@@ -154,6 +266,27 @@ func (self *Commands) Commit(runner gitdomain.Runner, message Option[gitdomain.C
 	return runner.Run("git", gitArgs...)
 }
 
+// CommitFixup commits the staged changes as a fixup for the commit with the given SHA.
+func (self *Commands) CommitFixup(runner gitdomain.Runner, sha gitdomain.SHA) error {
+	return runner.Run("git", "commit", "--fixup", sha.String())
+}
+
+// CommitSHAsSince provides the SHAs of the commits that the given branch contains on top of the given commit, oldest first.
+func (self *Commands) CommitSHAsSince(querier gitdomain.Querier, since gitdomain.SHA, branch gitdomain.BranchName) (gitdomain.SHAs, error) {
+	output, err := querier.QueryTrim("git", "log", "--reverse", "--format=%h", since.String()+".."+branch.String())
+	if err != nil {
+		return gitdomain.SHAs{}, err
+	}
+	lines := stringslice.Lines(output)
+	result := make(gitdomain.SHAs, 0, len(lines))
+	for _, line := range lines {
+		if len(line) > 0 {
+			result = append(result, gitdomain.NewSHA(line))
+		}
+	}
+	return result, nil
+}
+
 func (self *Commands) CommitsInBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName, parent Option[gitdomain.LocalBranchName]) (gitdomain.Commits, error) {
 	if parent, hasParent := parent.Get(); hasParent {
 		return self.CommitsInFeatureBranch(querier, branch, parent)
@@ -217,11 +350,23 @@ func (self *Commands) ContentBlobInfo(querier gitdomain.Querier, branch gitdomai
 	return Some(sha), err
 }
 
+// ContinueCherryPick finishes an ongoing cherry-pick operation
+// after the user has resolved all conflicts.
+func (self *Commands) ContinueCherryPick(runner gitdomain.Runner) error {
+	return runner.Run("git", "-c", "core.editor=true", "cherry-pick", "--continue")
+}
+
 // ContinueRebase continues the currently ongoing rebase.
 func (self *Commands) ContinueRebase(runner gitdomain.Runner) error {
 	return runner.Run("git", "-c", "core.editor=true", "rebase", "--continue")
 }
 
+// ContinueRevert finishes an ongoing revert operation
+// after the user has resolved all conflicts.
+func (self *Commands) ContinueRevert(runner gitdomain.Runner) error {
+	return runner.Run("git", "-c", "core.editor=true", "revert", "--continue")
+}
+
 // CreateAndCheckoutBranch creates a new branch with the given name and checks it out using a single Git operation.
 // The created branch is a normal branch.
 // To create feature branches, use CreateFeatureBranch.
@@ -367,6 +512,13 @@ func (self *Commands) Fetch(runner gitdomain.Runner, syncTags configdomain.SyncT
 	return runner.Run("git", "fetch", "--prune", "--no-tags")
 }
 
+// FetchSharedMetadata downloads the branch metadata that other people have shared at the given remote.
+// The wildcard in the refspec avoids an error if nobody has shared metadata yet.
+func (self *Commands) FetchSharedMetadata(runner gitdomain.Runner, remote gitdomain.Remote) error {
+	refspec := fmt.Sprintf("+%s*:%s*", gitdomain.SharedMetadataRef, gitdomain.RemoteSharedMetadataRef(remote))
+	return runner.Run("git", "fetch", remote.String(), refspec)
+}
+
 // FetchUpstream fetches updates from the upstream remote.
 func (self *Commands) FetchUpstream(runner gitdomain.Runner, branch gitdomain.LocalBranchName) error {
 	return runner.Run("git", "fetch", gitdomain.RemoteUpstream.String(), branch.String())
@@ -451,6 +603,12 @@ func (self *Commands) ForcePushBranchSafely(runner gitdomain.Runner, noPushHook
 	return runner.Run("git", args...)
 }
 
+// HasCherryPickInProgress indicates whether this Git repository currently has a cherry-pick in progress.
+func (self *Commands) HasCherryPickInProgress(runner gitdomain.Runner) bool {
+	err := runner.Run("git", "rev-parse", "-q", "--verify", "CHERRY_PICK_HEAD")
+	return err == nil
+}
+
 // HasLocalBranch indicates whether this repo has a local branch with the given name.
 func (self *Commands) HasLocalBranch(runner gitdomain.Runner, name gitdomain.LocalBranchName) bool {
 	return runner.Run("git", "show-ref", "--quiet", "refs/heads/"+name.String()) == nil
@@ -462,6 +620,12 @@ func (self *Commands) HasMergeInProgress(runner gitdomain.Runner) bool {
 	return err == nil
 }
 
+// HasRevertInProgress indicates whether this Git repository currently has a revert in progress.
+func (self *Commands) HasRevertInProgress(runner gitdomain.Runner) bool {
+	err := runner.Run("git", "rev-parse", "-q", "--verify", "REVERT_HEAD")
+	return err == nil
+}
+
 // HasShippableChanges indicates whether the given branch has changes
 // not currently in the main branch.
 func (self *Commands) HasShippableChanges(querier gitdomain.Querier, branch, mainBranch gitdomain.LocalBranchName) (bool, error) {
@@ -472,6 +636,15 @@ func (self *Commands) HasShippableChanges(querier gitdomain.Querier, branch, mai
 	return len(out) > 0, nil
 }
 
+// IsAncestor indicates whether the given ancestor commit is an ancestor of the given descendant commit.
+func (self *Commands) IsAncestor(querier gitdomain.Querier, ancestor, descendant gitdomain.SHA) (bool, error) {
+	output, err := querier.QueryTrim("git", "rev-list", "--count", descendant.String()+".."+ancestor.String())
+	if err != nil {
+		return false, err
+	}
+	return output == "0", nil
+}
+
 // LastCommitMessage provides the commit message for the last commit.
 func (self *Commands) LastCommitMessage(querier gitdomain.Querier) (gitdomain.CommitMessage, error) {
 	out, err := querier.QueryTrim("git", "log", "-1", "--format=%B")
@@ -487,6 +660,25 @@ func (self *Commands) MergeBranchNoEdit(runner gitdomain.Runner, branch gitdomai
 	return runner.Run("git", "merge", "--no-edit", "--ff", branch.String())
 }
 
+// MergeInMemory merges the given other commit into the given commit without touching the working tree or any branch.
+// Returns the SHA of the resulting commit, or the files that have conflicts.
+func (self *Commands) MergeInMemory(querier gitdomain.Querier, commit, other gitdomain.SHA) (gitdomain.SHA, []string, error) {
+	alreadyMerged, err := self.IsAncestor(querier, other, commit)
+	if err != nil || alreadyMerged {
+		return commit, []string{}, err
+	}
+	fastForward, err := self.IsAncestor(querier, commit, other)
+	if err != nil || fastForward {
+		return other, []string{}, err
+	}
+	tree, conflictingFiles, err := self.mergeTree(querier, commit, other)
+	if err != nil || len(conflictingFiles) > 0 {
+		return "", conflictingFiles, err
+	}
+	result, err := self.commitTree(querier, tree.String(), commit, other)
+	return result, []string{}, err
+}
+
 func (self *Commands) MergeFastForward(runner gitdomain.Runner, branch gitdomain.LocalBranchName) error {
 	return runner.Run("git", "merge", "--ff-only", branch.String())
 }
@@ -525,6 +717,19 @@ func (self *Commands) PreviouslyCheckedOutBranch(querier gitdomain.Querier) Opti
 	return Some(gitdomain.NewLocalBranchName(output))
 }
 
+// PrefillSquashCommitMessage makes the given message the default message for the current squash merge.
+// Comments out the message that Git has prepared, so that it remains visible in the editor.
+func (self *Commands) PrefillSquashCommitMessage(message gitdomain.CommitMessage) error {
+	squashMessageFile := ".git/SQUASH_MSG"
+	contentBytes, err := os.ReadFile(squashMessageFile)
+	if err != nil {
+		return fmt.Errorf(messages.SquashCannotReadFile, squashMessageFile, err)
+	}
+	content := regexp.MustCompile("(?m)^").ReplaceAllString(string(contentBytes), "# ")
+	content = message.String() + "\n\n" + content
+	return os.WriteFile(squashMessageFile, []byte(content), 0o600)
+}
+
 // Pull fetches updates from origin and updates the currently checked out branch.
 func (self *Commands) Pull(runner gitdomain.Runner) error {
 	return runner.Run("git", "pull")
@@ -539,6 +744,17 @@ func (self *Commands) PushCurrentBranch(runner gitdomain.Runner, noPushHook conf
 	return runner.Run("git", args...)
 }
 
+// PushSharedMetadata uploads the shared metadata stored in the object with the given SHA to the given remote.
+// To not override metadata that somebody else has shared in the meantime,
+// this succeeds only if the metadata at the remote still has the given expected SHA.
+func (self *Commands) PushSharedMetadata(runner gitdomain.Runner, remote gitdomain.Remote, sha gitdomain.SHA, expected Option[gitdomain.SHA]) error {
+	lease := gitdomain.SharedMetadataRef + ":"
+	if expectedSHA, hasExpectedSHA := expected.Get(); hasExpectedSHA {
+		lease += expectedSHA.String()
+	}
+	return runner.Run("git", "push", "--force-with-lease="+lease, remote.String(), sha.String()+":"+gitdomain.SharedMetadataRef)
+}
+
 // PushTags pushes new the Git tags to origin.
 func (self *Commands) PushTags(runner gitdomain.Runner) error {
 	return runner.Run("git", "push", "--tags")
@@ -553,6 +769,69 @@ func (self *Commands) Rebase(runner gitdomain.Runner, target gitdomain.BranchNam
 	return runner.Run("git", args...)
 }
 
+// RebaseAutosquash squashes the fixup commits in the current branch into the commits they fix.
+// The given SHA is the oldest commit that receives fixups.
+func (self *Commands) RebaseAutosquash(runner gitdomain.Runner, querier gitdomain.Querier, sha gitdomain.SHA, version Version) error {
+	output, err := querier.QueryTrim("git", "rev-list", "--parents", "-n", "1", sha.String())
+	if err != nil {
+		return err
+	}
+	upstream := "--root"
+	if _, _, hasParent := strings.Cut(output, " "); hasParent {
+		upstream = sha.String() + "^"
+	}
+	args := []string{"-c", "sequence.editor=true", "rebase", "--interactive", "--autosquash", upstream}
+	if version.HasRebaseUpdateRefs() {
+		args = append(args, "--no-update-refs")
+	}
+	return runner.Run("git", args...)
+}
+
+// RebaseCommitsSince rebases the commits that the current branch contains on top of the given commit onto the given branch.
+func (self *Commands) RebaseCommitsSince(runner gitdomain.Runner, since gitdomain.SHA, onto gitdomain.BranchName, version Version) error {
+	args := []string{"rebase", "--onto", onto.String(), since.String()}
+	if version.HasRebaseUpdateRefs() {
+		args = append(args, "--no-update-refs")
+	}
+	return runner.Run("git", args...)
+}
+
+// RebaseInMemory rebases the commits of the given commit onto the given other commit
+// without touching the working tree or any branch.
+// Returns the SHA of the resulting commit, or the files that have conflicts.
+func (self *Commands) RebaseInMemory(querier gitdomain.Querier, commit, onto gitdomain.SHA) (gitdomain.SHA, []string, error) {
+	upToDate, err := self.IsAncestor(querier, onto, commit)
+	if err != nil || upToDate {
+		return commit, []string{}, err
+	}
+	output, err := querier.QueryTrim("git", "rev-list", "--reverse", "--right-only", "--cherry-pick", "--no-merges", "--parents", onto.String()+"..."+commit.String())
+	if err != nil {
+		return "", []string{}, err
+	}
+	result := onto
+	for _, line := range stringslice.Lines(output) {
+		commitToApply, parent, hasParent := strings.Cut(line, " ")
+		if !hasParent {
+			continue
+		}
+		// A commit that has the tree of the current result and the parent of the commit to apply
+		// makes "git merge-tree" use that parent as the merge base, which is how a cherry-pick works.
+		base, err := self.commitTree(querier, result.String()+"^{tree}", gitdomain.NewSHA(parent))
+		if err != nil {
+			return "", []string{}, err
+		}
+		tree, conflictingFiles, err := self.mergeTree(querier, base, gitdomain.NewSHA(commitToApply))
+		if err != nil || len(conflictingFiles) > 0 {
+			return "", conflictingFiles, err
+		}
+		result, err = self.commitTree(querier, tree.String(), result)
+		if err != nil {
+			return "", []string{}, err
+		}
+	}
+	return result, []string{}, nil
+}
+
 // Rebase initiates a Git rebase of the current branch against the given branch.
 func (self *Commands) RebaseOnto(runner gitdomain.Runner, branchToRebaseAgainst gitdomain.BranchName, branchToRebaseOnto gitdomain.LocalBranchName, upstream Option[gitdomain.LocalBranchName]) error {
 	args := []string{"rebase", "--onto", branchToRebaseOnto.String()}
@@ -587,6 +866,10 @@ func (self *Commands) RemotesUncached(querier gitdomain.Querier) (gitdomain.Remo
 	return gitdomain.NewRemotes(stringslice.Lines(out)...), nil
 }
 
+func (self *Commands) RemoveAzureDevOpsToken(runner gitdomain.Runner) error {
+	return runner.Run("git", "config", "--unset", configdomain.KeyAzureDevOpsToken.String())
+}
+
 func (self *Commands) RemoveBitbucketAppPassword(runner gitdomain.Runner) error {
 	return runner.Run("git", "config", "--unset", configdomain.KeyBitbucketAppPassword.String())
 }
@@ -595,6 +878,12 @@ func (self *Commands) RemoveBitbucketUsername(runner gitdomain.Runner) error {
 	return runner.Run("git", "config", "--unset", configdomain.KeyBitbucketUsername.String())
 }
 
+// RemoveCommit removes the commit with the given SHA from the current branch
+// by rebasing the commits after it onto its parent commit.
+func (self *Commands) RemoveCommit(runner gitdomain.Runner, sha gitdomain.SHA) error {
+	return runner.Run("git", "rebase", "--onto", sha.String()+"^", sha.String())
+}
+
 func (self *Commands) RemoveFile(runner gitdomain.Runner, fileName string) error {
 	return runner.Run("git", "rm", fileName)
 }
@@ -687,6 +976,11 @@ func (self *Commands) SHAForBranch(querier gitdomain.Querier, name gitdomain.Bra
 	return gitdomain.NewSHA(output), nil
 }
 
+// SetAzureDevOpsToken sets the given personal access token for the Azure DevOps API.
+func (self *Commands) SetAzureDevOpsToken(runner gitdomain.Runner, value configdomain.AzureDevOpsToken) error {
+	return runner.Run("git", "config", configdomain.KeyAzureDevOpsToken.String(), value.String())
+}
+
 func (self *Commands) SetBitbucketAppPassword(runner gitdomain.Runner, value configdomain.BitbucketAppPassword) error {
 	return runner.Run("git", "config", configdomain.KeyBitbucketAppPassword.String(), value.String())
 }
@@ -725,6 +1019,28 @@ func (self *Commands) SetOriginHostname(runner gitdomain.Runner, hostname config
 	return runner.Run("git", "config", configdomain.KeyHostingOriginHostname.String(), hostname.String())
 }
 
+// SharedMetadata provides the SHA and content of the shared branch metadata stored in the given ref.
+// Provides empty metadata if the ref doesn't exist.
+func (self *Commands) SharedMetadata(querier gitdomain.Querier, ref string) (Option[gitdomain.SHA], configdomain.SharedMetadata, error) {
+	output, err := querier.QueryTrim("git", "for-each-ref", "--format=%(objectname)", ref)
+	if err != nil || output == "" {
+		return None[gitdomain.SHA](), configdomain.EmptySharedMetadata(), err
+	}
+	sha, err := gitdomain.NewSHAErr(output)
+	if err != nil {
+		return None[gitdomain.SHA](), configdomain.EmptySharedMetadata(), err
+	}
+	content, err := querier.Query("git", "cat-file", "blob", sha.String())
+	if err != nil {
+		return Some(sha), configdomain.EmptySharedMetadata(), err
+	}
+	metadata, err := configdomain.ParseSharedMetadata(content)
+	if err != nil {
+		return Some(sha), configdomain.EmptySharedMetadata(), fmt.Errorf(messages.SharedMetadataInvalid, ref, err)
+	}
+	return Some(sha), metadata, nil
+}
+
 // ShouldPushBranch returns whether the local branch with the given name
 // contains commits that have not been pushed to its tracking branch.
 func (self *Commands) ShouldPushBranch(querier gitdomain.Querier, branch gitdomain.LocalBranchName, devRemote gitdomain.Remote) (bool, error) {
@@ -746,6 +1062,11 @@ func (self *Commands) StageFiles(runner gitdomain.Runner, names ...string) error
 	return runner.Run("git", args...)
 }
 
+// StagedChanges provides the changes in the Git index as a patch.
+func (self *Commands) StagedChanges(querier gitdomain.Querier) (string, error) {
+	return querier.Query("git", "diff", "--cached", "--no-color", "--no-ext-diff", "--no-renames", "--src-prefix=a/", "--dst-prefix=b/")
+}
+
 // StartCommit starts a commit and stops at asking the user for the commit message.
 func (self *Commands) StartCommit(runner gitdomain.Runner) error {
 	return runner.Run("git", "commit")
@@ -766,10 +1087,54 @@ func (self *Commands) StashSize(querier gitdomain.Querier) (gitdomain.StashSize,
 	return gitdomain.StashSize(len(stringslice.Lines(output))), err
 }
 
+// StoreSharedMetadata stores the given branch metadata in the Git object database
+// and provides the SHA of the stored object.
+func (self *Commands) StoreSharedMetadata(querier gitdomain.Querier, metadata configdomain.SharedMetadata) (gitdomain.SHA, error) {
+	content, err := metadata.Serialize()
+	if err != nil {
+		return gitdomain.SHA(""), err
+	}
+	file, err := os.CreateTemp("", "git-town-metadata-*.json")
+	if err != nil {
+		return gitdomain.SHA(""), err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return gitdomain.SHA(""), err
+	}
+	output, err := querier.QueryTrim("git", "hash-object", "-w", file.Name())
+	if err != nil {
+		return gitdomain.SHA(""), err
+	}
+	return gitdomain.NewSHAErr(output)
+}
+
 func (self *Commands) UndoLastCommit(runner gitdomain.Runner) error {
 	return runner.Run("git", "reset", "--soft", "HEAD~1")
 }
 
+// UnstagedFiles provides the names of the tracked files that have changes that aren't in the Git index.
+func (self *Commands) UnstagedFiles(querier gitdomain.Querier) ([]string, error) {
+	output, err := querier.QueryTrim("git", "diff", "--name-only")
+	if err != nil {
+		return []string{}, err
+	}
+	return stringslice.Lines(output), nil
+}
+
+// UpdateSharedMetadataRefs records that the local repo and the given remote now share the metadata with the given SHA.
+func (self *Commands) UpdateSharedMetadataRefs(runner gitdomain.Runner, remote gitdomain.Remote, sha gitdomain.SHA) error {
+	err := runner.Run("git", "update-ref", gitdomain.SharedMetadataRef, sha.String())
+	if err != nil {
+		return err
+	}
+	return runner.Run("git", "update-ref", gitdomain.RemoteSharedMetadataRef(remote), sha.String())
+}
+
 // Version indicates whether the needed Git version is installed.
 func (self *Commands) Version(querier gitdomain.Querier) (Version, error) {
 	versionRegexp := regexp.MustCompile(`git version (\d+).(\d+).(\w+)`)
@@ -795,6 +1160,42 @@ func (self *Commands) Version(querier gitdomain.Querier) (Version, error) {
 	}, nil
 }
 
+// Worktrees provides the worktrees of this repository that have a branch checked out.
+func (self *Commands) Worktrees(querier gitdomain.Querier) (gitdomain.Worktrees, error) {
+	output, err := querier.QueryTrim("git", "worktree", "list", "--porcelain")
+	if err != nil {
+		return gitdomain.Worktrees{}, err
+	}
+	result := gitdomain.Worktrees{}
+	var dir string
+	for _, line := range stringslice.Lines(output) {
+		if path, isPath := strings.CutPrefix(line, "worktree "); isPath {
+			dir = filepath.FromSlash(path)
+			continue
+		}
+		if ref, isBranch := strings.CutPrefix(line, "branch refs/heads/"); isBranch {
+			result = append(result, gitdomain.Worktree{
+				Branch: gitdomain.NewLocalBranchName(ref),
+				Dir:    dir,
+			})
+		}
+	}
+	return result, nil
+}
+
+// commitTree creates a commit with the given tree and parents without updating any branch.
+func (self *Commands) commitTree(querier gitdomain.Querier, tree string, parents ...gitdomain.SHA) (gitdomain.SHA, error) {
+	args := []string{"commit-tree", tree, "-m", "simulated commit"}
+	for _, parent := range parents {
+		args = append(args, "-p", parent.String())
+	}
+	output, err := querier.QueryTrim("git", args...)
+	if err != nil {
+		return "", err
+	}
+	return gitdomain.NewSHAErr(output)
+}
+
 func (self *Commands) currentBranchDuringRebase(querier gitdomain.Querier) (gitdomain.LocalBranchName, error) {
 	output, err := querier.QueryTrim("git", "branch", "--list")
 	if err != nil {
@@ -812,6 +1213,26 @@ func (self *Commands) currentBranchDuringRebase(querier gitdomain.Querier) (gitd
 	return ParseActiveBranchDuringRebase(lineWithStar), nil
 }
 
+// mergeTree merges the given commits in memory.
+// Returns the tree of the merge result and the files that have conflicts.
+func (self *Commands) mergeTree(querier gitdomain.Querier, commit1, commit2 gitdomain.SHA) (gitdomain.SHA, []string, error) {
+	// "git merge-tree" exits with code 1 if there are conflicts, and prints the tree followed by the conflicting files
+	output, err := querier.QueryTrim("git", "merge-tree", "--write-tree", "--name-only", "--no-messages", commit1.String(), commit2.String())
+	lines := stringslice.Lines(output)
+	if len(lines) == 0 {
+		return "", []string{}, errors.Join(fmt.Errorf(messages.MergeTreeUnexpectedOutput, output), err)
+	}
+	tree, errSHA := gitdomain.NewSHAErr(lines[0])
+	if errSHA != nil {
+		return "", []string{}, errors.Join(fmt.Errorf(messages.MergeTreeUnexpectedOutput, output), err)
+	}
+	conflictingFiles := lines[1:]
+	if err != nil && len(conflictingFiles) == 0 {
+		return "", []string{}, err
+	}
+	return tree, conflictingFiles, nil
+}
+
 func IsAhead(branchName, remoteText string) (bool, Option[gitdomain.RemoteBranchName]) {
 	reText := fmt.Sprintf(`\[(\w+\/%s): ahead \d+\] `, regexp.QuoteMeta(branchName))
 	re := regexp.MustCompile(reText)
@@ -884,6 +1305,19 @@ func ParseActiveBranchDuringRebase(lineWithStar string) gitdomain.LocalBranchNam
 	return gitdomain.NewLocalBranchName(branchNameWithClosingParen[:len(branchNameWithClosingParen)-1])
 }
 
+// ParseBlamePorcelainOutput provides the SHAs of the commits in the given output of "git blame --porcelain",
+// one for each blamed line.
+func ParseBlamePorcelainOutput(output string) gitdomain.SHAs {
+	result := gitdomain.SHAs{}
+	headerRE := regexp.MustCompile(`^([0-9a-f]{40,64}) \d+ \d+`)
+	for _, line := range stringslice.Lines(output) {
+		if match := headerRE.FindStringSubmatch(line); match != nil {
+			result = append(result, gitdomain.NewSHA(match[1]))
+		}
+	}
+	return result
+}
+
 // ParseVerboseBranchesOutput provides the branches in the given Git output as well as the name of the currently checked out branch.
 func ParseVerboseBranchesOutput(output string) (gitdomain.BranchInfos, Option[gitdomain.LocalBranchName]) {
 	result := gitdomain.BranchInfos{}
@@ -1013,3 +1447,19 @@ func outputIndicatesRebaseInProgress(output string) bool {
 func outputIndicatesUntrackedChanges(output string) bool {
 	return strings.Contains(output, "Untracked files:")
 }
+
+func parseAheadBehind(output string) (int, int, error) {
+	aheadText, behindText, ok := strings.Cut(output, "\t")
+	if !ok {
+		return 0, 0, fmt.Errorf(messages.AheadBehindUnexpectedOutput, output)
+	}
+	ahead, err := strconv.Atoi(aheadText)
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.AheadBehindUnexpectedOutput, output)
+	}
+	behind, err := strconv.Atoi(behindText)
+	if err != nil {
+		return 0, 0, fmt.Errorf(messages.AheadBehindUnexpectedOutput, output)
+	}
+	return ahead, behind, nil
+}
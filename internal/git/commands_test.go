@@ -1,6 +1,7 @@
 package git_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/git-town/git-town/v17/internal/git"
@@ -9,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/gohacks/cache"
 	"github.com/git-town/git-town/v17/internal/subshell"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/git-town/git-town/v17/test/commands"
 	testgit "github.com/git-town/git-town/v17/test/git"
 	"github.com/git-town/git-town/v17/test/testruntime"
 	"github.com/shoenig/test/must"
@@ -17,6 +19,35 @@ import (
 func TestBackendCommands(t *testing.T) {
 	t.Parallel()
 	initial := gitdomain.NewLocalBranchName("initial")
+	shaForBranch := func(t *testing.T, runtime commands.TestCommands, branch gitdomain.LocalBranchName) gitdomain.SHA {
+		t.Helper()
+		sha, err := runtime.SHAForBranch(runtime.TestRunner, branch.BranchName())
+		must.NoError(t, err)
+		return sha
+	}
+
+	t.Run("BlameLines", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      initial,
+			FileContent: "line 1\nline 2\n",
+			FileName:    "file",
+			Message:     "first commit",
+		})
+		firstSHA := shaForBranch(t, runtime, initial)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      initial,
+			FileContent: "line 1\nchanged line 2\n",
+			FileName:    "file",
+			Message:     "second commit",
+		})
+		secondSHA := shaForBranch(t, runtime, initial)
+		have, err := runtime.BlameLines(runtime.TestRunner, "file", []int{2, 1})
+		must.NoError(t, err)
+		want := gitdomain.SHAs{firstSHA, secondSHA}
+		must.Eq(t, want, have)
+	})
 
 	t.Run("BranchAuthors", func(t *testing.T) {
 		t.Parallel()
@@ -83,6 +114,78 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("BranchShippedInto", func(t *testing.T) {
+		t.Parallel()
+		looseObjects := func(t *testing.T, runtime commands.TestCommands) string {
+			t.Helper()
+			output, err := runtime.TestRunner.Query("git", "count-objects")
+			must.NoError(t, err)
+			return output
+		}
+		setup := func(t *testing.T) (commands.TestCommands, gitdomain.LocalBranchName) {
+			t.Helper()
+			runtime := testruntime.Create(t)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "original content",
+				FileName:    "file1",
+				Message:     "commit 1",
+			})
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "intermediate content",
+				FileName:    "file1",
+				Message:     "commit 2",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "commit 3",
+			})
+			return runtime, branch
+		}
+		t.Run("squash-merged branch", func(t *testing.T) {
+			t.Parallel()
+			runtime, branch := setup(t)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "branch content",
+				FileName:    "file1",
+				Message:     "squashed branch",
+			})
+			objectsBefore := looseObjects(t, runtime)
+			have, err := runtime.BranchShippedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.True(t, have)
+			must.EqOp(t, objectsBefore, looseObjects(t, runtime))
+		})
+		t.Run("unshipped branch", func(t *testing.T) {
+			t.Parallel()
+			runtime, branch := setup(t)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "other content",
+				FileName:    "file2",
+				Message:     "unrelated commit",
+			})
+			have, err := runtime.BranchShippedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+		t.Run("branch without commits", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			have, err := runtime.BranchShippedInto(runtime.TestRunner, branch, initial.BranchName())
+			must.NoError(t, err)
+			must.False(t, have)
+		})
+	})
+
 	t.Run("CheckoutBranch", func(t *testing.T) {
 		t.Parallel()
 		runtime := testruntime.Create(t)
@@ -454,6 +557,33 @@ func TestBackendCommands(t *testing.T) {
 		must.False(t, runner.Commands.HasLocalBranch(runner, gitdomain.NewLocalBranchName("b3")))
 	})
 
+	t.Run("IsAncestor", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      initial,
+			FileContent: "content",
+			FileName:    "file",
+			Message:     "commit",
+		})
+		branch := gitdomain.NewLocalBranchName("branch")
+		runtime.CreateBranch(branch, initial.BranchName())
+		runtime.CreateCommit(testgit.Commit{
+			Branch:      branch,
+			FileContent: "branch content",
+			FileName:    "file",
+			Message:     "branch commit",
+		})
+		initialSHA := shaForBranch(t, runtime, initial)
+		branchSHA := shaForBranch(t, runtime, branch)
+		have, err := runtime.IsAncestor(runtime.TestRunner, initialSHA, branchSHA)
+		must.NoError(t, err)
+		must.True(t, have)
+		have, err = runtime.IsAncestor(runtime.TestRunner, branchSHA, initialSHA)
+		must.NoError(t, err)
+		must.False(t, have)
+	})
+
 	t.Run("lastBranchInRef", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]string{
@@ -466,6 +596,59 @@ func TestBackendCommands(t *testing.T) {
 		}
 	})
 
+	t.Run("MergeInMemory", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no conflicts", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "initial_file",
+				Message:     "initial commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "branch_file",
+				Message:     "branch commit",
+			})
+			branchSHA := shaForBranch(t, runtime, branch)
+			initialSHA := shaForBranch(t, runtime, initial)
+			have, conflictingFiles, err := runtime.MergeInMemory(runtime.TestRunner, branchSHA, initialSHA)
+			must.NoError(t, err)
+			must.SliceEmpty(t, conflictingFiles)
+			must.EqOp(t, "initial content", runtime.FileContentInCommit(have.Location(), "initial_file"))
+			must.EqOp(t, "branch content", runtime.FileContentInCommit(have.Location(), "branch_file"))
+			must.EqOp(t, branchSHA, shaForBranch(t, runtime, branch))
+		})
+		t.Run("conflicts", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "file",
+				Message:     "initial commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file",
+				Message:     "branch commit",
+			})
+			branchSHA := shaForBranch(t, runtime, branch)
+			initialSHA := shaForBranch(t, runtime, initial)
+			_, conflictingFiles, err := runtime.MergeInMemory(runtime.TestRunner, branchSHA, initialSHA)
+			must.NoError(t, err)
+			must.Eq(t, []string{"file"}, conflictingFiles)
+		})
+	})
+
 	t.Run("NewUnmergedStage", func(t *testing.T) {
 		t.Parallel()
 		tests := map[int]git.UnmergedStage{
@@ -505,6 +688,108 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("RebaseAutosquash", func(t *testing.T) {
+		t.Parallel()
+		t.Run("fixup of a commit with a parent", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "content",
+				FileName:    "file1",
+				Message:     "commit 1",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "fixed content",
+				FileName:    "file1",
+				Message:     "fixup! commit 1",
+			})
+			sha := runtime.SHAsForCommit("commit 1").First()
+			err := runtime.RebaseAutosquash(runtime.TestRunner, runtime.TestRunner, sha, git.Version{Major: 2, Minor: 38})
+			must.NoError(t, err)
+			must.EqOp(t, "commit 1\ninitial commit", runtime.MustQuery("git", "log", "--format=%s"))
+			must.EqOp(t, "fixed content", runtime.MustQuery("git", "show", "HEAD:file1"))
+		})
+		t.Run("fixup of the root commit", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "fixed content",
+				FileName:    "file1",
+				Message:     "fixup! initial commit",
+			})
+			sha := runtime.SHAsForCommit("initial commit").First()
+			err := runtime.RebaseAutosquash(runtime.TestRunner, runtime.TestRunner, sha, git.Version{Major: 2, Minor: 38})
+			must.NoError(t, err)
+			must.EqOp(t, "initial commit", runtime.MustQuery("git", "log", "--format=%s"))
+			must.EqOp(t, "fixed content", runtime.MustQuery("git", "show", "HEAD:file1"))
+		})
+	})
+
+	t.Run("RebaseInMemory", func(t *testing.T) {
+		t.Parallel()
+		t.Run("no conflicts", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "initial_file",
+				Message:     "initial commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content 1",
+				FileName:    "branch_file",
+				Message:     "branch commit 1",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content 2",
+				FileName:    "branch_file",
+				Message:     "branch commit 2",
+			})
+			branchSHA := shaForBranch(t, runtime, branch)
+			initialSHA := shaForBranch(t, runtime, initial)
+			have, conflictingFiles, err := runtime.RebaseInMemory(runtime.TestRunner, branchSHA, initialSHA)
+			must.NoError(t, err)
+			must.SliceEmpty(t, conflictingFiles)
+			must.EqOp(t, "initial content", runtime.FileContentInCommit(have.Location(), "initial_file"))
+			must.EqOp(t, "branch content 2", runtime.FileContentInCommit(have.Location(), "branch_file"))
+			isAncestor, err := runtime.IsAncestor(runtime.TestRunner, initialSHA, have)
+			must.NoError(t, err)
+			must.True(t, isAncestor)
+			must.EqOp(t, branchSHA, shaForBranch(t, runtime, branch))
+		})
+		t.Run("conflicts", func(t *testing.T) {
+			t.Parallel()
+			runtime := testruntime.Create(t)
+			branch := gitdomain.NewLocalBranchName("branch")
+			runtime.CreateBranch(branch, initial.BranchName())
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      initial,
+				FileContent: "initial content",
+				FileName:    "file",
+				Message:     "initial commit",
+			})
+			runtime.CreateCommit(testgit.Commit{
+				Branch:      branch,
+				FileContent: "branch content",
+				FileName:    "file",
+				Message:     "branch commit",
+			})
+			branchSHA := shaForBranch(t, runtime, branch)
+			initialSHA := shaForBranch(t, runtime, initial)
+			_, conflictingFiles, err := runtime.RebaseInMemory(runtime.TestRunner, branchSHA, initialSHA)
+			must.NoError(t, err)
+			must.Eq(t, []string{"file"}, conflictingFiles)
+		})
+	})
+
 	t.Run("RepoStatus", func(t *testing.T) {
 		t.Run("HasOpenChanges", func(t *testing.T) {
 			t.Parallel()
@@ -644,6 +929,33 @@ func TestBackendCommands(t *testing.T) {
 		})
 	})
 
+	t.Run("ParseBlamePorcelainOutput", func(t *testing.T) {
+		t.Parallel()
+		give := `
+4c9de0b6c8a1e3bd62c8ec7a5d91c4f4e0b2a1f3 2 2 1
+author user
+author-mail <user@acme.com>
+summary first commit
+filename file
+	line two
+80e7ea6e1b3a2c4d5f6a7b8c9d0e1f2a3b4c5d6e 5 6 1
+author user
+author-mail <user@acme.com>
+previous 4c9de0b6c8a1e3bd62c8ec7a5d91c4f4e0b2a1f3 file
+summary second commit
+filename file
+	line six
+4c9de0b6c8a1e3bd62c8ec7a5d91c4f4e0b2a1f3 7 8 1
+	line eight`[1:]
+		have := git.ParseBlamePorcelainOutput(give)
+		want := gitdomain.SHAs{
+			"4c9de0b6c8a1e3bd62c8ec7a5d91c4f4e0b2a1f3",
+			"80e7ea6e1b3a2c4d5f6a7b8c9d0e1f2a3b4c5d6e",
+			"4c9de0b6c8a1e3bd62c8ec7a5d91c4f4e0b2a1f3",
+		}
+		must.Eq(t, want, have)
+	})
+
 	t.Run("ParseVerboseBranchesOutput", func(t *testing.T) {
 		t.Parallel()
 		t.Run("recognizes the branch names", func(t *testing.T) {
@@ -1158,4 +1470,16 @@ func TestBackendCommands(t *testing.T) {
 			must.EqOp(t, want, have)
 		})
 	})
+
+	t.Run("Worktrees", func(t *testing.T) {
+		t.Parallel()
+		runtime := testruntime.Create(t)
+		runtime.CreateBranch("feature", "initial")
+		worktreeDir := filepath.Join(t.TempDir(), "worktree")
+		runtime.AddWorktree(worktreeDir, "feature")
+		have, err := runtime.Worktrees(runtime.TestRunner)
+		must.NoError(t, err)
+		must.Eq(t, Some(gitdomain.Worktree{Branch: "feature", Dir: worktreeDir}), have.FindByBranch("feature"))
+		must.True(t, have.FindByBranch("initial").IsSome())
+	})
 }
@@ -0,0 +1,127 @@
+package gitdomain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FilePatch describes the changes to a single file in the output of "git diff".
+type FilePatch struct {
+	Binary  bool        // whether Git considers this file binary, binary changes contain no hunks
+	Header  string      // the lines before the first hunk
+	Hunks   []PatchHunk // the hunks of this file, in the order of the diff
+	NewFile bool        // whether this patch creates the file
+	Path    string      // path of the changed file
+}
+
+// Text provides a patch that contains the header of this file and the given hunks.
+func (self FilePatch) Text(hunks []PatchHunk) string {
+	result := strings.Builder{}
+	result.WriteString(self.Header)
+	result.WriteString("\n")
+	for _, hunk := range hunks {
+		result.WriteString(hunk.Text())
+	}
+	return result.String()
+}
+
+// PatchHunk describes a single hunk of changes in the output of "git diff".
+type PatchHunk struct {
+	Header   string   // the "@@ -1,2 +1,3 @@" line
+	Lines    []string // the context, removed, and added lines of the hunk
+	OldStart int      // the first line number of this hunk in the old version of the file
+}
+
+// BlameLines provides the line numbers in the old version of the file
+// whose last change determines which commit this hunk belongs to.
+// These are the removed lines, or the context lines around the additions if this hunk only adds lines.
+func (self PatchHunk) BlameLines() []int {
+	removed := []int{}
+	surrounding := []int{}
+	oldLine := self.OldStart
+	for l, line := range self.Lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			removed = append(removed, oldLine)
+			oldLine++
+		case strings.HasPrefix(line, "+"):
+			if l > 0 && strings.HasPrefix(self.Lines[l-1], " ") {
+				surrounding = append(surrounding, oldLine-1)
+			}
+			if l < len(self.Lines)-1 && strings.HasPrefix(self.Lines[l+1], " ") {
+				surrounding = append(surrounding, oldLine)
+			}
+		case strings.HasPrefix(line, " "):
+			oldLine++
+		}
+	}
+	if len(removed) > 0 {
+		return removed
+	}
+	return surrounding
+}
+
+// Text provides the content of this hunk in patch format.
+func (self PatchHunk) Text() string {
+	return self.Header + "\n" + strings.Join(self.Lines, "\n") + "\n"
+}
+
+// ParsePatch parses the output of "git diff" into the changes per file.
+func ParsePatch(text string) []FilePatch {
+	result := []FilePatch{}
+	var headerLines []string
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			result = append(result, FilePatch{
+				Binary:  false,
+				Header:  "",
+				Hunks:   []PatchHunk{},
+				NewFile: false,
+				Path:    "",
+			})
+			headerLines = []string{line}
+		case len(result) == 0:
+			continue
+		case strings.HasPrefix(line, "@@ "):
+			filePatch := &result[len(result)-1]
+			if len(filePatch.Hunks) == 0 {
+				filePatch.Header = strings.Join(headerLines, "\n")
+			}
+			filePatch.Hunks = append(filePatch.Hunks, PatchHunk{
+				Header:   line,
+				Lines:    []string{},
+				OldStart: parseHunkOldStart(line),
+			})
+		case len(result[len(result)-1].Hunks) > 0:
+			hunk := &result[len(result)-1].Hunks[len(result[len(result)-1].Hunks)-1]
+			hunk.Lines = append(hunk.Lines, line)
+		default:
+			filePatch := &result[len(result)-1]
+			headerLines = append(headerLines, line)
+			filePatch.Header = strings.Join(headerLines, "\n")
+			switch {
+			case strings.HasPrefix(line, "+++ b/"):
+				filePatch.Path = strings.TrimPrefix(line, "+++ b/")
+			case line == "--- /dev/null":
+				filePatch.NewFile = true
+			case strings.HasPrefix(line, "--- a/") && filePatch.Path == "":
+				filePatch.Path = strings.TrimPrefix(line, "--- a/")
+			case strings.HasPrefix(line, "Binary files "), line == "GIT binary patch":
+				filePatch.Binary = true
+			}
+		}
+	}
+	return result
+}
+
+// parses the old start line out of a hunk header like "@@ -12,3 +12,4 @@"
+func parseHunkOldStart(header string) int {
+	oldRange, _, _ := strings.Cut(strings.TrimPrefix(header, "@@ -"), " ")
+	startText, _, _ := strings.Cut(oldRange, ",")
+	start, err := strconv.Atoi(startText)
+	if err != nil {
+		return 0
+	}
+	return start
+}
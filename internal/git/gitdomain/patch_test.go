@@ -0,0 +1,132 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ParsePatch", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("changed and new files", func(t *testing.T) {
+			t.Parallel()
+			give := `diff --git a/file1 b/file1
+index 1111111..2222222 100644
+--- a/file1
++++ b/file1
+@@ -1,3 +1,3 @@
+ line 1
+-line 2
++line two
+ line 3
+@@ -10,2 +10,3 @@ func main() {
+ line 10
++line 10.5
+ line 11
+diff --git a/file2 b/file2
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/file2
+@@ -0,0 +1 @@
++content
+`
+			have := gitdomain.ParsePatch(give)
+			want := []gitdomain.FilePatch{
+				{
+					Binary: false,
+					Header: "diff --git a/file1 b/file1\nindex 1111111..2222222 100644\n--- a/file1\n+++ b/file1",
+					Hunks: []gitdomain.PatchHunk{
+						{
+							Header:   "@@ -1,3 +1,3 @@",
+							Lines:    []string{" line 1", "-line 2", "+line two", " line 3"},
+							OldStart: 1,
+						},
+						{
+							Header:   "@@ -10,2 +10,3 @@ func main() {",
+							Lines:    []string{" line 10", "+line 10.5", " line 11"},
+							OldStart: 10,
+						},
+					},
+					NewFile: false,
+					Path:    "file1",
+				},
+				{
+					Binary: false,
+					Header: "diff --git a/file2 b/file2\nnew file mode 100644\nindex 0000000..3333333\n--- /dev/null\n+++ b/file2",
+					Hunks: []gitdomain.PatchHunk{
+						{
+							Header:   "@@ -0,0 +1 @@",
+							Lines:    []string{"+content"},
+							OldStart: 0,
+						},
+					},
+					NewFile: true,
+					Path:    "file2",
+				},
+			}
+			must.Eq(t, want, have)
+		})
+
+		t.Run("binary file", func(t *testing.T) {
+			t.Parallel()
+			give := "diff --git a/image.png b/image.png\nindex 1111111..2222222 100644\nBinary files a/image.png and b/image.png differ\n"
+			have := gitdomain.ParsePatch(give)
+			must.SliceLen(t, 1, have)
+			must.True(t, have[0].Binary)
+			must.SliceEmpty(t, have[0].Hunks)
+		})
+
+		t.Run("empty", func(t *testing.T) {
+			t.Parallel()
+			have := gitdomain.ParsePatch("")
+			must.SliceEmpty(t, have)
+		})
+	})
+
+	t.Run("PatchHunk.BlameLines", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("removed lines", func(t *testing.T) {
+			t.Parallel()
+			hunk := gitdomain.PatchHunk{
+				Header:   "@@ -4,4 +4,3 @@",
+				Lines:    []string{" line 4", "-line 5", "-line 6", "+line five", " line 7"},
+				OldStart: 4,
+			}
+			must.Eq(t, []int{5, 6}, hunk.BlameLines())
+		})
+
+		t.Run("only additions", func(t *testing.T) {
+			t.Parallel()
+			hunk := gitdomain.PatchHunk{
+				Header:   "@@ -4,2 +4,3 @@",
+				Lines:    []string{" line 4", "+line 4.5", " line 5"},
+				OldStart: 4,
+			}
+			must.Eq(t, []int{4, 5}, hunk.BlameLines())
+		})
+	})
+
+	t.Run("FilePatch.Text", func(t *testing.T) {
+		t.Parallel()
+		filePatch := gitdomain.FilePatch{
+			Binary: false,
+			Header: "diff --git a/file b/file\n--- a/file\n+++ b/file",
+			Hunks: []gitdomain.PatchHunk{
+				{Header: "@@ -1 +1 @@", Lines: []string{"-one", "+1"}, OldStart: 1},
+				{Header: "@@ -9 +9 @@", Lines: []string{"-nine", "+9"}, OldStart: 9},
+			},
+			NewFile: false,
+			Path:    "file",
+		}
+		have := filePatch.Text(filePatch.Hunks[1:])
+		want := "diff --git a/file b/file\n--- a/file\n+++ b/file\n@@ -9 +9 @@\n-nine\n+9\n"
+		must.EqOp(t, want, have)
+	})
+}
@@ -1,5 +1,10 @@
 package gitdomain
 
+import (
+	"regexp"
+	"strings"
+)
+
 // RepoRootDir represents the root directory of a Git repository.
 type RepoRootDir string
 
@@ -10,6 +15,19 @@ func NewRepoRootDir(dir string) RepoRootDir {
 	return RepoRootDir(dir)
 }
 
+// Sanitized provides a version of this directory that can be used as a filename.
+func (self RepoRootDir) Sanitized() string {
+	replaceCharacterRE := regexp.MustCompile("[[:^alnum:]]")
+	sanitized := replaceCharacterRE.ReplaceAllString(self.String(), "-")
+	sanitized = strings.ToLower(sanitized)
+	replaceDoubleMinusRE := regexp.MustCompile("--+") // two or more dashes
+	sanitized = replaceDoubleMinusRE.ReplaceAllString(sanitized, "-")
+	for strings.HasPrefix(sanitized, "-") {
+		sanitized = sanitized[1:]
+	}
+	return sanitized
+}
+
 func (self RepoRootDir) String() string {
 	return string(self)
 }
@@ -1,17 +1,16 @@
-package statefile_test
+package gitdomain_test
 
 import (
 	"testing"
 
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
-	"github.com/git-town/git-town/v17/internal/vm/statefile"
 	"github.com/shoenig/test/must"
 )
 
-func TestSanitizePath(t *testing.T) {
+func TestRepoRootDir(t *testing.T) {
 	t.Parallel()
 
-	t.Run("SanitizePath", func(t *testing.T) {
+	t.Run("Sanitized", func(t *testing.T) {
 		t.Parallel()
 		tests := map[string]string{
 			"/home/user/development/git-town":        "home-user-development-git-town",
@@ -19,7 +18,7 @@ func TestSanitizePath(t *testing.T) {
 		}
 		for give, want := range tests {
 			rootDir := gitdomain.NewRepoRootDir(give)
-			have := statefile.SanitizePath(rootDir)
+			have := rootDir.Sanitized()
 			must.EqOp(t, want, have)
 		}
 	})
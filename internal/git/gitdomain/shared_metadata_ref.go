@@ -0,0 +1,10 @@
+package gitdomain
+
+// SharedMetadataRef is the Git ref in which Git Town stores the branch metadata that it shares with other people.
+// Locally, this ref contains the metadata that Git Town has last exchanged with the development remote.
+const SharedMetadataRef = "refs/git-town/metadata"
+
+// RemoteSharedMetadataRef provides the Git ref that contains the local copy of the metadata shared at the given remote.
+func RemoteSharedMetadataRef(remote Remote) string {
+	return "refs/git-town/remotes/" + remote.String() + "/metadata"
+}
@@ -0,0 +1,12 @@
+package gitdomain
+
+// UndoableCommit is a commit on a perennial branch that Git Town created and can therefore safely revert.
+type UndoableCommit struct {
+	Branch LocalBranchName // empty for commits loaded from runstate files of older Git Town versions
+	SHA    SHA
+}
+
+// indicates whether this commit exists on the given branch
+func (self UndoableCommit) isOn(branch LocalBranchName) bool {
+	return self.Branch == branch || self.Branch == ""
+}
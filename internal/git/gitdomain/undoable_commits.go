@@ -0,0 +1,46 @@
+package gitdomain
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+type UndoableCommits []UndoableCommit
+
+// Contains indicates whether this list contains the given commit on the given branch.
+func (self UndoableCommits) Contains(branch LocalBranchName, sha SHA) bool {
+	return slices.ContainsFunc(self, func(commit UndoableCommit) bool {
+		return commit.SHA == sha && commit.isOn(branch)
+	})
+}
+
+// OnBranch provides the SHAs of the undoable commits on the given branch, in the order they were registered.
+func (self UndoableCommits) OnBranch(branch LocalBranchName) SHAs {
+	result := SHAs{}
+	for _, commit := range self {
+		if commit.isOn(branch) {
+			result = append(result, commit.SHA)
+		}
+	}
+	return result
+}
+
+// UnmarshalJSON also accepts the plain list of SHAs that runstate files of older Git Town versions contain.
+// Commits loaded from such a list don't know their branch.
+func (self *UndoableCommits) UnmarshalJSON(data []byte) error {
+	var commits []UndoableCommit
+	err := json.Unmarshal(data, &commits)
+	if err == nil {
+		*self = commits
+		return nil
+	}
+	var shas []SHA
+	if json.Unmarshal(data, &shas) != nil {
+		return err
+	}
+	*self = make(UndoableCommits, len(shas))
+	for s, sha := range shas {
+		(*self)[s] = UndoableCommit{Branch: "", SHA: sha}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package gitdomain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestUndoableCommits(t *testing.T) {
+	t.Parallel()
+
+	commits := gitdomain.UndoableCommits{
+		{Branch: "main", SHA: "111111"},
+		{Branch: "qa", SHA: "222222"},
+		{Branch: "main", SHA: "333333"},
+	}
+
+	t.Run("Contains", func(t *testing.T) {
+		t.Parallel()
+		must.True(t, commits.Contains("main", "111111"))
+		must.True(t, commits.Contains("qa", "222222"))
+		must.False(t, commits.Contains("qa", "111111"))
+		must.False(t, commits.Contains("main", "444444"))
+	})
+
+	t.Run("OnBranch", func(t *testing.T) {
+		t.Parallel()
+		must.Eq(t, gitdomain.NewSHAs("111111", "333333"), commits.OnBranch("main"))
+		must.Eq(t, gitdomain.NewSHAs("222222"), commits.OnBranch("qa"))
+		must.Eq(t, gitdomain.SHAs{}, commits.OnBranch("other"))
+	})
+
+	t.Run("Contains and OnBranch with commits without branch", func(t *testing.T) {
+		t.Parallel()
+		commits := gitdomain.UndoableCommits{
+			{Branch: "", SHA: "111111"},
+		}
+		must.True(t, commits.Contains("main", "111111"))
+		must.Eq(t, gitdomain.NewSHAs("111111"), commits.OnBranch("qa"))
+	})
+
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		t.Parallel()
+		t.Run("commits with branches", func(t *testing.T) {
+			t.Parallel()
+			give := `[{"Branch": "main", "SHA": "111111"}, {"Branch": "qa", "SHA": "222222"}]`
+			var have gitdomain.UndoableCommits
+			err := json.Unmarshal([]byte(give), &have)
+			must.NoError(t, err)
+			want := gitdomain.UndoableCommits{
+				{Branch: "main", SHA: "111111"},
+				{Branch: "qa", SHA: "222222"},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("SHAs from older runstate files", func(t *testing.T) {
+			t.Parallel()
+			give := `["111111", "222222"]`
+			var have gitdomain.UndoableCommits
+			err := json.Unmarshal([]byte(give), &have)
+			must.NoError(t, err)
+			want := gitdomain.UndoableCommits{
+				{Branch: "", SHA: "111111"},
+				{Branch: "", SHA: "222222"},
+			}
+			must.Eq(t, want, have)
+		})
+		t.Run("invalid content", func(t *testing.T) {
+			t.Parallel()
+			var have gitdomain.UndoableCommits
+			err := json.Unmarshal([]byte(`{"SHA": 1}`), &have)
+			must.Error(t, err)
+		})
+	})
+}
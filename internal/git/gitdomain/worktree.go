@@ -0,0 +1,7 @@
+package gitdomain
+
+// Worktree is a Git worktree that has a branch checked out.
+type Worktree struct {
+	Branch LocalBranchName // the branch checked out in this worktree
+	Dir    string          // the directory of this worktree
+}
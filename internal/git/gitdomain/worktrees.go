@@ -0,0 +1,23 @@
+package gitdomain
+
+import . "github.com/git-town/git-town/v17/pkg/prelude"
+
+// Worktrees are the Git worktrees of a repository.
+type Worktrees []Worktree
+
+// Add adds the given worktree if this collection doesn't contain it yet.
+func (self *Worktrees) Add(worktree Worktree) {
+	if self.FindByBranch(worktree.Branch).IsNone() {
+		*self = append(*self, worktree)
+	}
+}
+
+// FindByBranch provides the worktree that has the given branch checked out.
+func (self Worktrees) FindByBranch(branch LocalBranchName) Option[Worktree] {
+	for _, worktree := range self {
+		if worktree.Branch == branch {
+			return Some(worktree)
+		}
+	}
+	return None[Worktree]()
+}
@@ -0,0 +1,38 @@
+package gitdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestWorktrees(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		t.Run("new worktree", func(t *testing.T) {
+			t.Parallel()
+			worktrees := gitdomain.Worktrees{{Branch: "alpha", Dir: "/alpha"}}
+			worktrees.Add(gitdomain.Worktree{Branch: "beta", Dir: "/beta"})
+			want := gitdomain.Worktrees{{Branch: "alpha", Dir: "/alpha"}, {Branch: "beta", Dir: "/beta"}}
+			must.Eq(t, want, worktrees)
+		})
+		t.Run("existing worktree", func(t *testing.T) {
+			t.Parallel()
+			worktrees := gitdomain.Worktrees{{Branch: "alpha", Dir: "/alpha"}}
+			worktrees.Add(gitdomain.Worktree{Branch: "alpha", Dir: "/alpha"})
+			want := gitdomain.Worktrees{{Branch: "alpha", Dir: "/alpha"}}
+			must.Eq(t, want, worktrees)
+		})
+	})
+
+	t.Run("FindByBranch", func(t *testing.T) {
+		t.Parallel()
+		worktrees := gitdomain.Worktrees{{Branch: "alpha", Dir: "/alpha"}, {Branch: "beta", Dir: "/beta"}}
+		must.Eq(t, Some(gitdomain.Worktree{Branch: "beta", Dir: "/beta"}), worktrees.FindByBranch("beta"))
+		must.Eq(t, None[gitdomain.Worktree](), worktrees.FindByBranch("gamma"))
+	})
+}
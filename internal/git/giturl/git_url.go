@@ -3,6 +3,7 @@ package giturl
 
 import (
 	"regexp"
+	"strings"
 
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 )
@@ -39,12 +40,33 @@ func Parse(url string) Option[Parts] {
 		// Remotes on the filesystem are not an error condition.
 		return None[Parts]()
 	}
-	return Some(Parts{
+	return Some(normalizeAzureDevOps(Parts{
 		Host: trimLast(matches[2]),
 		Org:  trimLast(matches[3]),
 		Repo: matches[4],
 		User: NewOption(trimLast(matches[1])),
-	})
+	}))
+}
+
+const (
+	AzureDevOpsHost    = "dev.azure.com"     // hostname of Azure DevOps Services
+	AzureDevOpsSSHHost = "ssh.dev.azure.com" // hostname for SSH access to Azure DevOps Services
+)
+
+// normalizeAzureDevOps converts the parts of the special URL formats used by Azure DevOps
+// into the regular format, i.e. organization/project as the Org and the repo name as the Repo.
+//
+// HTTPS URLs look like https://org@dev.azure.com/org/project/_git/repo.
+// SSH URLs look like git@ssh.dev.azure.com:v3/org/project/repo.
+func normalizeAzureDevOps(parts Parts) Parts {
+	switch parts.Host {
+	case AzureDevOpsHost:
+		parts.Org = strings.TrimSuffix(parts.Org, "/_git")
+	case AzureDevOpsSSHHost:
+		parts.Host = AzureDevOpsHost
+		parts.Org = strings.TrimPrefix(parts.Org, "v3/")
+	}
+	return parts
 }
 
 // trimLast trims the last character of the given text.
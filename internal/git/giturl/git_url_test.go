@@ -39,6 +39,10 @@ func TestParse(t *testing.T) {
 		"ssh://git@github.com/git-town/git-town":               {User: Some("git"), Host: "github.com", Org: "git-town", Repo: "git-town"},
 		"ssh://git@git.example.com:4022/a/b.git":               {User: Some("git"), Host: "git.example.com", Org: "a", Repo: "b"},
 		"ssh://git@git.example.com:4022/a/b":                   {User: Some("git"), Host: "git.example.com", Org: "a", Repo: "b"},
+		"https://org@dev.azure.com/org/project/_git/repo":      {User: Some("org"), Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
+		"https://dev.azure.com/org/project/_git/repo":          {User: None[string](), Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
+		"git@ssh.dev.azure.com:v3/org/project/repo":            {User: Some("git"), Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
+		"ssh://git@ssh.dev.azure.com/v3/org/project/repo":      {User: Some("git"), Host: "dev.azure.com", Org: "org/project", Repo: "repo"},
 	}
 	for give, want := range tests {
 		have, has := giturl.Parse(give).Get()
@@ -6,6 +6,11 @@ type Version struct {
 	Minor int
 }
 
+// indicates whether the installed Git version supports "git merge-tree --write-tree"
+func (self Version) HasMergeTreeWriteTree() bool {
+	return self.Major > 2 || (self.Major == 2 && self.Minor >= 38)
+}
+
 // indicates whether the installed Git version supports the rebase.updateRefs config option
 func (self Version) HasRebaseUpdateRefs() bool {
 	return self.Major > 2 || (self.Major == 2 && self.Minor >= 38)
@@ -0,0 +1,29 @@
+package cache
+
+import "sync"
+
+// Map is a cache for arbitrary values identified by keys.
+// It is safe for concurrent use.
+// The zero value is an empty cache.
+type Map[K comparable, V any] struct {
+	mutex  sync.Mutex
+	values map[K]V
+}
+
+// Get provides the value cached for the given key and whether such a value exists.
+func (c *Map[K, V]) Get(key K) (V, bool) { //nolint:ireturn
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	value, has := c.values[key]
+	return value, has
+}
+
+// Set caches the given value for the given key.
+func (c *Map[K, V]) Set(key K, value V) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.values == nil {
+		c.values = map[K]V{}
+	}
+	c.values[key] = value
+}
@@ -0,0 +1,48 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/gohacks/cache"
+	"github.com/shoenig/test/must"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		cache := cache.Map[string, int]{}
+		_, has := cache.Get("one")
+		must.False(t, has)
+	})
+
+	t.Run("Set and Get", func(t *testing.T) {
+		t.Parallel()
+		cache := cache.Map[string, int]{}
+		cache.Set("one", 1)
+		have, has := cache.Get("one")
+		must.True(t, has)
+		must.EqOp(t, 1, have)
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		t.Parallel()
+		cache := cache.Map[int, int]{}
+		var waitGroup sync.WaitGroup
+		for i := range 10 {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				cache.Set(i, i*i)
+			}()
+		}
+		waitGroup.Wait()
+		for i := range 10 {
+			have, has := cache.Get(i)
+			must.True(t, has)
+			must.EqOp(t, i*i, have)
+		}
+	})
+}
@@ -0,0 +1,315 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/carlmjohnson/requests"
+	"github.com/git-town/git-town/v17/internal/cli/colors"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/git/giturl"
+	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// the version of the Azure DevOps REST API that this connector talks to
+const apiVersion = "7.1"
+
+// Connector provides access to the API of Azure DevOps.
+// The Organization field contains the Azure DevOps organization and project, separated by a slash.
+type Connector struct {
+	hostingdomain.Data
+	APIToken Option[configdomain.AzureDevOpsToken]
+	apiURL   string
+	log      hostingdomain.Log
+}
+
+// NewConnector provides a connector for the Azure DevOps API.
+func NewConnector(args NewConnectorArgs) Connector {
+	return Connector{
+		APIToken: args.APIToken,
+		Data: hostingdomain.Data{
+			Hostname:     args.RemoteURL.Host,
+			Organization: args.RemoteURL.Org,
+			Repository:   args.RemoteURL.Repo,
+		},
+		apiURL: args.APIURL.GetOrElse("https://" + args.RemoteURL.Host),
+		log:    args.Log,
+	}
+}
+
+type NewConnectorArgs struct {
+	APIToken  Option[configdomain.AzureDevOpsToken]
+	APIURL    Option[string] // base URL of the REST API, defaults to the host of the remote
+	Log       hostingdomain.Log
+	RemoteURL giturl.Parts
+}
+
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	return None[func(number int) error]()
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	return None[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)]()
+}
+
+func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
+	return fmt.Sprintf("Merged PR %d: %s", proposal.Number, proposal.Title)
+}
+
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	return None[func(number int) error]()
+}
+
+func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
+	if len(hostingdomain.ReadProposalOverride()) > 0 {
+		return Some(self.findProposalViaOverride)
+	}
+	if self.APIToken.IsSome() {
+		return Some(self.findProposalViaAPI)
+	}
+	return None[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+}
+
+func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
+	return fmt.Sprintf("%s/pullrequestcreate?sourceRef=%s&targetRef=%s",
+			self.RepositoryURL(),
+			url.QueryEscape(branch.String()),
+			url.QueryEscape(parentBranch.String())),
+		nil
+}
+
+func (self Connector) RepositoryURL() string {
+	return fmt.Sprintf("https://%s/%s/_git/%s", self.HostnameWithStandardPort(), self.Organization, self.Repository)
+}
+
+func (self Connector) SearchProposalFn() Option[func(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
+	if self.APIToken.IsSome() {
+		return Some(self.searchProposal)
+	}
+	return None[func(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) SquashMergeProposalFn() Option[func(number int, message gitdomain.CommitMessage) error] {
+	if self.APIToken.IsSome() {
+		return Some(self.squashMergeProposal)
+	}
+	return None[func(number int, message gitdomain.CommitMessage) error]()
+}
+
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.APIToken.IsSome() {
+		return Some(self.updateProposalBody)
+	}
+	return None[func(number int, body gitdomain.ProposalBody) error]()
+}
+
+// UpdateProposalSourceFn is not supported because the Azure DevOps API
+// does not allow changing the source branch of an existing pull request.
+func (self Connector) UpdateProposalSourceFn() Option[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error] {
+	return None[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error]()
+}
+
+func (self Connector) UpdateProposalTargetFn() Option[func(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error] {
+	if self.APIToken.IsSome() {
+		return Some(self.updateProposalTarget)
+	}
+	return None[func(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error]()
+}
+
+// apiPullRequestURL provides the URL of the API endpoint for the pull request with the given number.
+func (self Connector) apiPullRequestURL(number int) string {
+	return fmt.Sprintf("%s/%d", self.apiPullRequestsURL(), number)
+}
+
+// apiPullRequestsURL provides the URL of the API endpoint for the pull requests of the current repository.
+func (self Connector) apiPullRequestsURL() string {
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests", self.apiURL, self.Organization, self.Repository)
+}
+
+func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	pullRequests, err := self.loadPullRequests(branch, Some(target))
+	if err != nil {
+		self.log.Failed(err.Error())
+		return None[hostingdomain.Proposal](), err
+	}
+	switch len(pullRequests) {
+	case 0:
+		self.log.Success("none")
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		proposal := self.parsePullRequest(pullRequests[0])
+		self.log.Success(fmt.Sprintf("#%d", proposal.Number))
+		return Some(proposal), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromToFound, len(pullRequests), branch, target)
+	}
+}
+
+func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIProposalLookupStart)
+	self.log.Ok()
+	proposalURLOverride := hostingdomain.ReadProposalOverride()
+	if proposalURLOverride == hostingdomain.OverrideNoProposal {
+		return None[hostingdomain.Proposal](), nil
+	}
+	return Some(hostingdomain.Proposal{
+		Body:         "",
+		MergeWithAPI: true,
+		Number:       123,
+		Source:       branch,
+		Target:       target,
+		Title:        "title",
+		URL:          proposalURLOverride,
+	}), nil
+}
+
+// loadPullRequests provides the active pull requests from the given source branch,
+// optionally limited to the given target branch.
+func (self Connector) loadPullRequests(source gitdomain.LocalBranchName, target Option[gitdomain.LocalBranchName]) ([]PullRequest, error) {
+	request := self.request(self.apiPullRequestsURL()).
+		Param("searchCriteria.status", "active").
+		Param("searchCriteria.sourceRefName", branchRef(source))
+	if targetBranch, hasTarget := target.Get(); hasTarget {
+		request = request.Param("searchCriteria.targetRefName", branchRef(targetBranch))
+	}
+	var response PullRequestList
+	err := request.ToJSON(&response).Fetch(context.Background())
+	return response.Value, err
+}
+
+func (self Connector) parsePullRequest(pullRequest PullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Description),
+		MergeWithAPI: pullRequest.MergeStatus == "succeeded",
+		Number:       pullRequest.PullRequestID,
+		Source:       gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.SourceRefName, "refs/heads/")),
+		Target:       gitdomain.NewLocalBranchName(strings.TrimPrefix(pullRequest.TargetRefName, "refs/heads/")),
+		Title:        pullRequest.Title,
+		URL:          fmt.Sprintf("%s/pullrequest/%d", self.RepositoryURL(), pullRequest.PullRequestID),
+	}
+}
+
+// request provides a request builder for the given API URL that is preconfigured
+// with the API version and the personal access token of this connector.
+func (self Connector) request(apiURL string) *requests.Builder {
+	return requests.URL(apiURL).
+		BasicAuth("", self.APIToken.String()).
+		Param("api-version", apiVersion)
+}
+
+func (self Connector) searchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	self.log.Start(messages.APIParentBranchLookupStart, branch.String())
+	pullRequests, err := self.loadPullRequests(branch, None[gitdomain.LocalBranchName]())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return None[hostingdomain.Proposal](), err
+	}
+	switch len(pullRequests) {
+	case 0:
+		self.log.Success("none")
+		return None[hostingdomain.Proposal](), nil
+	case 1:
+		proposal := self.parsePullRequest(pullRequests[0])
+		self.log.Success(proposal.Target.String())
+		return Some(proposal), nil
+	default:
+		return None[hostingdomain.Proposal](), fmt.Errorf(messages.ProposalMultipleFromFound, len(pullRequests), branch)
+	}
+}
+
+func (self Connector) squashMergeProposal(number int, message gitdomain.CommitMessage) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.HostingAzureDevOpsMergingViaAPI, colors.BoldGreen().Styled(strconv.Itoa(number)))
+	// Azure DevOps only completes a pull request if it knows the latest commit of its source branch
+	var pullRequest PullRequest
+	err := self.request(self.apiPullRequestURL(number)).
+		ToJSON(&pullRequest).
+		Fetch(context.Background())
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	err = self.updatePullRequest(number, PullRequestUpdate{
+		CompletionOptions: &CompletionOptions{
+			DeleteSourceBranch: false,
+			MergeCommitMessage: message.String(),
+			MergeStrategy:      "squash",
+		},
+		Description:           nil,
+		LastMergeSourceCommit: &pullRequest.LastMergeSourceCommit,
+		Status:                "completed",
+		TargetRefName:         "",
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	description := body.String()
+	err := self.updatePullRequest(number, PullRequestUpdate{
+		CompletionOptions:     nil,
+		Description:           &description,
+		LastMergeSourceCommit: nil,
+		Status:                "",
+		TargetRefName:         "",
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error {
+	self.log.Start(messages.APIUpdateProposalTarget, colors.BoldGreen().Styled("#"+strconv.Itoa(number)), colors.BoldCyan().Styled(target.String()))
+	err := self.updatePullRequest(number, PullRequestUpdate{
+		CompletionOptions:     nil,
+		Description:           nil,
+		LastMergeSourceCommit: nil,
+		Status:                "",
+		TargetRefName:         branchRef(target),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) updatePullRequest(number int, update PullRequestUpdate) error {
+	return self.request(self.apiPullRequestURL(number)).
+		Patch().
+		BodyJSON(update).
+		Fetch(context.Background())
+}
+
+// branchRef provides the fully qualified Git ref of the given branch, which the Azure DevOps API uses to identify branches.
+func branchRef(branch gitdomain.LocalBranchName) string {
+	return "refs/heads/" + branch.String()
+}
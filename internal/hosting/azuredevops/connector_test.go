@@ -0,0 +1,197 @@
+package azuredevops_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/git/giturl"
+	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/hosting/azuredevops"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("DefaultProposalMessage", func(t *testing.T) {
+		t.Parallel()
+		give := hostingdomain.Proposal{
+			Number: 1,
+			Title:  "my title",
+		}
+		want := "Merged PR 1: my title"
+		connector := azuredevops.Connector{}
+		have := connector.DefaultProposalMessage(give)
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("FindProposalFn", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("proposal exists", func(t *testing.T) {
+			t.Parallel()
+			server := newTestServer(t, "token", pullRequest(1, "feature", "main"), pullRequest(2, "feature", "other"))
+			connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+			findProposal, has := connector.FindProposalFn().Get()
+			must.True(t, has)
+			have, err := findProposal("feature", "main")
+			must.NoError(t, err)
+			want := Some(hostingdomain.Proposal{
+				Body:         "description",
+				MergeWithAPI: true,
+				Number:       1,
+				Source:       "feature",
+				Target:       "main",
+				Title:        "title 1",
+				URL:          "https://dev.azure.com/org/project/_git/repo/pullrequest/1",
+			})
+			must.Eq(t, want, have)
+		})
+
+		t.Run("no proposal exists", func(t *testing.T) {
+			t.Parallel()
+			server := newTestServer(t, "token", pullRequest(1, "other", "main"))
+			connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+			findProposal, has := connector.FindProposalFn().Get()
+			must.True(t, has)
+			have, err := findProposal("feature", "main")
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+
+		t.Run("wrong token", func(t *testing.T) {
+			t.Parallel()
+			server := newTestServer(t, "token", pullRequest(1, "feature", "main"))
+			connector := newConnector(server, Some(configdomain.AzureDevOpsToken("wrong")))
+			findProposal, has := connector.FindProposalFn().Get()
+			must.True(t, has)
+			_, err := findProposal("feature", "main")
+			must.Error(t, err)
+		})
+
+		t.Run("no token", func(t *testing.T) {
+			t.Parallel()
+			server := newTestServer(t, "token")
+			connector := newConnector(server, None[configdomain.AzureDevOpsToken]())
+			must.True(t, connector.FindProposalFn().IsNone())
+		})
+	})
+
+	t.Run("NewProposalURL", func(t *testing.T) {
+		t.Parallel()
+		connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  None[configdomain.AzureDevOpsToken](),
+			APIURL:    None[string](),
+			Log:       print.NoLogger{},
+			RemoteURL: giturl.Parse("git@ssh.dev.azure.com:v3/org/project/repo").GetOrPanic(),
+		})
+		have, err := connector.NewProposalURL("feature", "main", "main", "", "")
+		must.NoError(t, err)
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo/pullrequestcreate?sourceRef=feature&targetRef=main", have)
+	})
+
+	t.Run("RepositoryURL", func(t *testing.T) {
+		t.Parallel()
+		connector := azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  None[configdomain.AzureDevOpsToken](),
+			APIURL:    None[string](),
+			Log:       print.NoLogger{},
+			RemoteURL: giturl.Parse("https://org@dev.azure.com/org/project/_git/repo").GetOrPanic(),
+		})
+		must.EqOp(t, "https://dev.azure.com/org/project/_git/repo", connector.RepositoryURL())
+	})
+
+	t.Run("SearchProposalFn", func(t *testing.T) {
+		t.Parallel()
+		server := newTestServer(t, "token", pullRequest(1, "other", "main"), pullRequest(2, "feature", "parent"))
+		connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+		searchProposal, has := connector.SearchProposalFn().Get()
+		must.True(t, has)
+		have, err := searchProposal("feature")
+		must.NoError(t, err)
+		proposal, has := have.Get()
+		must.True(t, has)
+		must.EqOp(t, 2, proposal.Number)
+		must.EqOp(t, "parent", proposal.Target)
+	})
+
+	t.Run("SquashMergeProposalFn", func(t *testing.T) {
+		t.Parallel()
+		server := newTestServer(t, "token", pullRequest(1, "feature", "main"))
+		connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+		squashMerge, has := connector.SquashMergeProposalFn().Get()
+		must.True(t, has)
+		err := squashMerge(1, "commit title\n\ncommit body")
+		must.NoError(t, err)
+		updates := server.Updates(1)
+		must.SliceLen(t, 1, updates)
+		must.EqOp(t, "completed", updates[0].Status)
+		must.Eq(t, &azuredevops.GitCommitRef{CommitID: "sha1"}, updates[0].LastMergeSourceCommit)
+		must.Eq(t, &azuredevops.CompletionOptions{
+			DeleteSourceBranch: false,
+			MergeCommitMessage: "commit title\n\ncommit body",
+			MergeStrategy:      "squash",
+		}, updates[0].CompletionOptions)
+	})
+
+	t.Run("UpdateProposalBodyFn", func(t *testing.T) {
+		t.Parallel()
+		server := newTestServer(t, "token", pullRequest(1, "feature", "main"))
+		connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+		updateBody, has := connector.UpdateProposalBodyFn().Get()
+		must.True(t, has)
+		err := updateBody(1, "new body")
+		must.NoError(t, err)
+		updates := server.Updates(1)
+		must.SliceLen(t, 1, updates)
+		must.Eq(t, Ptr("new body"), updates[0].Description)
+		must.EqOp(t, "", updates[0].TargetRefName)
+	})
+
+	t.Run("UpdateProposalSourceFn", func(t *testing.T) {
+		t.Parallel()
+		connector := azuredevops.Connector{}
+		must.True(t, connector.UpdateProposalSourceFn().IsNone())
+	})
+
+	t.Run("UpdateProposalTargetFn", func(t *testing.T) {
+		t.Parallel()
+		server := newTestServer(t, "token", pullRequest(1, "feature", "old"))
+		connector := newConnector(server, Some(configdomain.AzureDevOpsToken("token")))
+		updateTarget, has := connector.UpdateProposalTargetFn().Get()
+		must.True(t, has)
+		err := updateTarget(1, "new", stringslice.Collector{})
+		must.NoError(t, err)
+		updates := server.Updates(1)
+		must.SliceLen(t, 1, updates)
+		must.EqOp(t, "refs/heads/new", updates[0].TargetRefName)
+		must.Nil(t, updates[0].Description)
+	})
+}
+
+func newConnector(server *testServer, token Option[configdomain.AzureDevOpsToken]) azuredevops.Connector {
+	return azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+		APIToken:  token,
+		APIURL:    Some(server.URL),
+		Log:       print.NoLogger{},
+		RemoteURL: giturl.Parts{User: None[string](), Host: giturl.AzureDevOpsHost, Org: "org/project", Repo: "repo"},
+	})
+}
+
+func pullRequest(number int, source, target gitdomain.LocalBranchName) azuredevops.PullRequest {
+	return azuredevops.PullRequest{
+		Description:           "description",
+		LastMergeSourceCommit: azuredevops.GitCommitRef{CommitID: "sha" + strconv.Itoa(number)},
+		MergeStatus:           "succeeded",
+		PullRequestID:         number,
+		SourceRefName:         "refs/heads/" + source.String(),
+		Status:                "active",
+		TargetRefName:         "refs/heads/" + target.String(),
+		Title:                 "title " + strconv.Itoa(number),
+	}
+}
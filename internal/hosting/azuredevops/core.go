@@ -0,0 +1,2 @@
+// Package azuredevops provides the code hosting connector for Azure DevOps.
+package azuredevops
@@ -0,0 +1,8 @@
+package azuredevops
+
+import "github.com/git-town/git-town/v17/internal/git/giturl"
+
+// Detect indicates whether the current repository is hosted on Azure DevOps.
+func Detect(remoteURL giturl.Parts) bool {
+	return remoteURL.Host == giturl.AzureDevOpsHost
+}
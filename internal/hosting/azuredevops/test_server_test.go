@@ -0,0 +1,140 @@
+package azuredevops_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/hosting/azuredevops"
+)
+
+// testServer stands in for the Azure DevOps REST API in tests.
+// It serves the pull requests of the "org/project" project's "repo" repository from memory
+// and records the updates it receives.
+type testServer struct {
+	*httptest.Server
+	mutex        sync.Mutex
+	pullRequests []azuredevops.PullRequest
+	token        string
+	updates      map[int][]azuredevops.PullRequestUpdate
+}
+
+const testServerPullRequestsPath = "/org/project/_apis/git/repositories/repo/pullrequests"
+
+func newTestServer(t *testing.T, token string, pullRequests ...azuredevops.PullRequest) *testServer {
+	t.Helper()
+	result := &testServer{
+		Server:       nil,
+		mutex:        sync.Mutex{},
+		pullRequests: pullRequests,
+		token:        token,
+		updates:      map[int][]azuredevops.PullRequestUpdate{},
+	}
+	result.Server = httptest.NewServer(http.HandlerFunc(result.handle))
+	t.Cleanup(result.Close)
+	return result
+}
+
+func (self *testServer) Updates(number int) []azuredevops.PullRequestUpdate {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.updates[number]
+}
+
+func (self *testServer) handle(writer http.ResponseWriter, request *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	if _, password, ok := request.BasicAuth(); !ok || password != self.token {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if request.URL.Query().Get("api-version") == "" {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	switch {
+	case request.URL.Path == testServerPullRequestsPath && request.Method == http.MethodGet:
+		self.list(writer, request)
+	case strings.HasPrefix(request.URL.Path, testServerPullRequestsPath+"/"):
+		number, err := strconv.Atoi(strings.TrimPrefix(request.URL.Path, testServerPullRequestsPath+"/"))
+		if err != nil {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch request.Method {
+		case http.MethodGet:
+			self.get(writer, number)
+		case http.MethodPatch:
+			self.update(writer, request, number)
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (self *testServer) get(writer http.ResponseWriter, number int) {
+	for _, pullRequest := range self.pullRequests {
+		if pullRequest.PullRequestID == number {
+			writeJSON(writer, pullRequest)
+			return
+		}
+	}
+	writer.WriteHeader(http.StatusNotFound)
+}
+
+func (self *testServer) list(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	result := azuredevops.PullRequestList{Count: 0, Value: []azuredevops.PullRequest{}}
+	for _, pullRequest := range self.pullRequests {
+		if status := query.Get("searchCriteria.status"); status != "" && pullRequest.Status != status {
+			continue
+		}
+		if source := query.Get("searchCriteria.sourceRefName"); source != "" && pullRequest.SourceRefName != source {
+			continue
+		}
+		if target := query.Get("searchCriteria.targetRefName"); target != "" && pullRequest.TargetRefName != target {
+			continue
+		}
+		result.Value = append(result.Value, pullRequest)
+	}
+	result.Count = len(result.Value)
+	writeJSON(writer, result)
+}
+
+func (self *testServer) update(writer http.ResponseWriter, request *http.Request, number int) {
+	var update azuredevops.PullRequestUpdate
+	if err := json.NewDecoder(request.Body).Decode(&update); err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	for p, pullRequest := range self.pullRequests {
+		if pullRequest.PullRequestID != number {
+			continue
+		}
+		self.updates[number] = append(self.updates[number], update)
+		if update.Description != nil {
+			pullRequest.Description = *update.Description
+		}
+		if update.Status != "" {
+			pullRequest.Status = update.Status
+		}
+		if update.TargetRefName != "" {
+			pullRequest.TargetRefName = update.TargetRefName
+		}
+		self.pullRequests[p] = pullRequest
+		writeJSON(writer, pullRequest)
+		return
+	}
+	writer.WriteHeader(http.StatusNotFound)
+}
+
+func writeJSON(writer http.ResponseWriter, data any) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(data)
+}
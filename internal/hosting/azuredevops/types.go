@@ -0,0 +1,42 @@
+//nolint:tagliatelle // we integrate with remote APIs only
+package azuredevops
+
+// CompletionOptions contains the options to complete (merge) a pull request with.
+type CompletionOptions struct {
+	DeleteSourceBranch bool   `json:"deleteSourceBranch"`
+	MergeCommitMessage string `json:"mergeCommitMessage"`
+	MergeStrategy      string `json:"mergeStrategy"`
+}
+
+// GitCommitRef identifies a commit.
+type GitCommitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+// PullRequest is a pull request as provided by the Azure DevOps API.
+type PullRequest struct {
+	Description           string       `json:"description"`
+	LastMergeSourceCommit GitCommitRef `json:"lastMergeSourceCommit"`
+	MergeStatus           string       `json:"mergeStatus"`
+	PullRequestID         int          `json:"pullRequestId"`
+	SourceRefName         string       `json:"sourceRefName"`
+	Status                string       `json:"status"`
+	TargetRefName         string       `json:"targetRefName"`
+	Title                 string       `json:"title"`
+}
+
+// PullRequestList is the response of the API endpoint that lists pull requests.
+type PullRequestList struct {
+	Count int           `json:"count"`
+	Value []PullRequest `json:"value"`
+}
+
+// PullRequestUpdate contains the fields of a pull request that Git Town updates.
+// Empty fields are not sent to the API.
+type PullRequestUpdate struct {
+	CompletionOptions     *CompletionOptions `json:"completionOptions,omitempty"`
+	Description           *string            `json:"description,omitempty"`
+	LastMergeSourceCommit *GitCommitRef      `json:"lastMergeSourceCommit,omitempty"`
+	Status                string             `json:"status,omitempty"`
+	TargetRefName         string             `json:"targetRefName,omitempty"`
+}
@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"github.com/git-town/git-town/v17/internal/cli/colors"
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
@@ -21,8 +20,10 @@ import (
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Data
-	client *bitbucket.Client
-	log    print.Logger
+	appPassword Option[configdomain.BitbucketAppPassword]
+	client      *bitbucket.Client
+	log         hostingdomain.Log
+	userName    Option[configdomain.BitbucketUsername]
 }
 
 // NewConnector provides a Bitbucket connector instance if the current repo is hosted on Bitbucket,
@@ -35,23 +36,37 @@ func NewConnector(args NewConnectorArgs) Connector {
 			Organization: args.RemoteURL.Org,
 			Repository:   args.RemoteURL.Repo,
 		},
-		client: client,
-		log:    args.Log,
+		appPassword: args.AppPassword,
+		client:      client,
+		log:         args.Log,
+		userName:    args.UserName,
 	}
 }
 
 type NewConnectorArgs struct {
 	AppPassword     Option[configdomain.BitbucketAppPassword]
 	HostingPlatform Option[configdomain.HostingPlatform]
-	Log             print.Logger
+	Log             hostingdomain.Log
 	RemoteURL       giturl.Parts
 	UserName        Option[configdomain.BitbucketUsername]
 }
 
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	return Some(self.closeProposalViaAPI)
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	return Some(self.createProposalViaAPI)
+}
+
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	return None[func(number int) error]()
+}
+
 func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
 	proposalURLOverride := hostingdomain.ReadProposalOverride()
 	if len(proposalURLOverride) > 0 {
@@ -60,6 +75,14 @@ func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.Loca
 	return Some(self.findProposalViaAPI)
 }
 
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+}
+
 func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
 	return fmt.Sprintf("%s/pull-requests/new?source=%s&dest=%s%%2F%s%%3A%s",
 			self.RepositoryURL(),
@@ -82,6 +105,13 @@ func (self Connector) SquashMergeProposalFn() Option[func(number int, message gi
 	return Some(self.squashMergeProposal)
 }
 
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.userName.IsNone() || self.appPassword.IsNone() {
+		return None[func(number int, body gitdomain.ProposalBody) error]()
+	}
+	return Some(self.updateProposalBody)
+}
+
 func (self Connector) UpdateProposalSourceFn() Option[func(number int, source gitdomain.LocalBranchName, _ stringslice.Collector) error] {
 	return Some(self.updateProposalSource)
 }
@@ -90,6 +120,53 @@ func (self Connector) UpdateProposalTargetFn() Option[func(number int, target gi
 	return Some(self.updateProposalTarget)
 }
 
+func (self Connector) closeProposalViaAPI(number int) error {
+	self.log.Start(messages.APIProposalClose, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	_, err := self.client.Repositories.PullRequests.Decline(&bitbucket.PullRequestsOptions{
+		ID:       strconv.Itoa(number),
+		Owner:    self.Organization,
+		RepoSlug: self.Repository,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) createProposalViaAPI(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error) {
+	if err := verifyCreateProposalArgs(args); err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Start(messages.APIProposalCreate, colors.BoldCyan().Styled(args.Source.String()))
+	response1, err := self.client.Repositories.PullRequests.Create(&bitbucket.PullRequestsOptions{
+		Description:       args.Body.String(),
+		DestinationBranch: args.Target.String(),
+		Owner:             self.Organization,
+		RepoSlug:          self.Repository,
+		Reviewers:         args.Reviewers,
+		SourceBranch:      args.Source.String(),
+		Title:             args.Title.String(),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	response2, ok := response1.(map[string]interface{})
+	if !ok {
+		self.log.Failed(messages.APIUnexpectedResultDataStructure)
+		return hostingdomain.Proposal{}, errors.New(messages.APIUnexpectedResultDataStructure)
+	}
+	proposal, err := parsePullRequest(response2)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Success(proposal.URL)
+	return proposal, nil
+}
+
 func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	query := fmt.Sprintf("source.branch.name = %q AND destination.branch.name = %q", branch, target)
@@ -167,6 +244,7 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 		return None[hostingdomain.Proposal](), nil
 	}
 	return Some(hostingdomain.Proposal{
+		Body:         "",
 		MergeWithAPI: true,
 		Number:       123,
 		Source:       branch,
@@ -251,6 +329,22 @@ func (self Connector) squashMergeProposal(number int, message gitdomain.CommitMe
 	return nil
 }
 
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	_, err := self.client.Repositories.PullRequests.Update(&bitbucket.PullRequestsOptions{
+		ID:          strconv.Itoa(number),
+		Owner:       self.Organization,
+		RepoSlug:    self.Repository,
+		Description: body.String(),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
 func (self Connector) updateProposalSource(number int, source gitdomain.LocalBranchName, _ stringslice.Collector) error {
 	self.log.Start(messages.APIUpdateProposalSource, colors.BoldGreen().Styled("#"+strconv.Itoa(number)), colors.BoldCyan().Styled(source.String()))
 	_, err := self.client.Repositories.PullRequests.Update(&bitbucket.PullRequestsOptions{
@@ -284,6 +378,20 @@ func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBra
 	return nil
 }
 
+// verifyCreateProposalArgs ensures that the given arguments only use options that the Bitbucket Cloud API supports.
+func verifyCreateProposalArgs(args hostingdomain.CreateProposalArgs) error {
+	if args.Draft {
+		return fmt.Errorf(messages.HostingBitbucketOptionUnsupported, "draft proposals")
+	}
+	if len(args.Assignees) > 0 {
+		return fmt.Errorf(messages.HostingBitbucketOptionUnsupported, "assignees")
+	}
+	if len(args.Labels) > 0 {
+		return fmt.Errorf(messages.HostingBitbucketOptionUnsupported, "labels")
+	}
+	return nil
+}
+
 func parsePullRequest(pullRequest map[string]interface{}) (result hostingdomain.Proposal, err error) {
 	id1, has := pullRequest["id"]
 	if !has {
@@ -374,7 +482,10 @@ func parsePullRequest(pullRequest map[string]interface{}) (result hostingdomain.
 	if !ok {
 		return result, errors.New(messages.APIUnexpectedResultDataStructure)
 	}
+	// the description is optional
+	description, _ := pullRequest["description"].(string)
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(description),
 		MergeWithAPI: false,
 		Number:       number,
 		Source:       gitdomain.NewLocalBranchName(source6),
@@ -65,4 +65,43 @@ func TestBitbucketConnector(t *testing.T) {
 		want := "https://bitbucket.org/org/repo/pull-requests/new?source=branch&dest=org%2Frepo%3Aparent-branch"
 		must.EqOp(t, want, have)
 	})
+
+	t.Run("UpdateProposalBodyFn", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("username@bitbucket.org:org/repo.git").Get()
+		must.True(t, has)
+
+		t.Run("with credentials", func(t *testing.T) {
+			t.Parallel()
+			connector := bitbucketcloud.NewConnector(bitbucketcloud.NewConnectorArgs{
+				AppPassword:     Some(configdomain.BitbucketAppPassword("password")),
+				HostingPlatform: None[configdomain.HostingPlatform](),
+				RemoteURL:       url,
+				UserName:        Some(configdomain.BitbucketUsername("user")),
+			})
+			must.True(t, connector.UpdateProposalBodyFn().IsSome())
+		})
+
+		t.Run("without app password", func(t *testing.T) {
+			t.Parallel()
+			connector := bitbucketcloud.NewConnector(bitbucketcloud.NewConnectorArgs{
+				AppPassword:     None[configdomain.BitbucketAppPassword](),
+				HostingPlatform: None[configdomain.HostingPlatform](),
+				RemoteURL:       url,
+				UserName:        Some(configdomain.BitbucketUsername("user")),
+			})
+			must.True(t, connector.UpdateProposalBodyFn().IsNone())
+		})
+
+		t.Run("without username", func(t *testing.T) {
+			t.Parallel()
+			connector := bitbucketcloud.NewConnector(bitbucketcloud.NewConnectorArgs{
+				AppPassword:     Some(configdomain.BitbucketAppPassword("password")),
+				HostingPlatform: None[configdomain.HostingPlatform](),
+				RemoteURL:       url,
+				UserName:        None[configdomain.BitbucketUsername](),
+			})
+			must.True(t, connector.UpdateProposalBodyFn().IsNone())
+		})
+	})
 }
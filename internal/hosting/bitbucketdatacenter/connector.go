@@ -6,7 +6,6 @@ import (
 	"net/url"
 
 	"github.com/carlmjohnson/requests"
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
@@ -19,7 +18,7 @@ import (
 // Connector provides access to the API of Bitbucket installations.
 type Connector struct {
 	hostingdomain.Data
-	log      print.Logger
+	log      hostingdomain.Log
 	token    string
 	username string
 }
@@ -42,15 +41,27 @@ func NewConnector(args NewConnectorArgs) Connector {
 type NewConnectorArgs struct {
 	AppPassword     Option[configdomain.BitbucketAppPassword]
 	HostingPlatform Option[configdomain.HostingPlatform]
-	Log             print.Logger
+	Log             hostingdomain.Log
 	RemoteURL       giturl.Parts
 	UserName        Option[configdomain.BitbucketUsername]
 }
 
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	return Some(self.closeProposalViaAPI)
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	return Some(self.createProposalViaAPI)
+}
+
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	return None[func(number int) error]()
+}
+
 func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
 	proposalURLOverride := hostingdomain.ReadProposalOverride()
 	if len(proposalURLOverride) > 0 {
@@ -59,6 +70,14 @@ func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.Loca
 	return Some(self.findProposalViaAPI)
 }
 
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+}
+
 func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
 	return fmt.Sprintf("%s/pull-requests?create&sourceBranch=%s&targetBranch=%s",
 			self.RepositoryURL(),
@@ -79,6 +98,13 @@ func (self Connector) SquashMergeProposalFn() Option[func(number int, message gi
 	return None[func(number int, message gitdomain.CommitMessage) error]()
 }
 
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.username == "" || self.token == "" {
+		return None[func(number int, body gitdomain.ProposalBody) error]()
+	}
+	return Some(self.updateProposalBody)
+}
+
 func (self Connector) UpdateProposalSourceFn() Option[func(number int, source gitdomain.LocalBranchName, _ stringslice.Collector) error] {
 	return None[func(number int, source gitdomain.LocalBranchName, _ stringslice.Collector) error]()
 }
@@ -96,6 +122,75 @@ func (self Connector) apiBaseURL() string {
 	)
 }
 
+func (self Connector) closeProposalViaAPI(number int) error {
+	self.log.Start(messages.APIProposalClose, fmt.Sprintf("#%d", number))
+
+	ctx := context.TODO()
+
+	// Bitbucket requires the current version of the pull request to decline it
+	var pullRequest PullRequest
+
+	err := requests.URL(fmt.Sprintf("%s/%d", self.apiBaseURL(), number)).
+		BasicAuth(self.username, self.token).
+		ToJSON(&pullRequest).
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+
+	err = requests.URL(fmt.Sprintf("%s/%d/decline", self.apiBaseURL(), number)).
+		BasicAuth(self.username, self.token).
+		ParamInt("version", pullRequest.Version).
+		Post().
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) createProposalViaAPI(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error) {
+	if err := verifyCreateProposalArgs(args); err != nil {
+		return hostingdomain.Proposal{}, err
+	}
+	self.log.Start(messages.APIProposalCreate, args.Source.String())
+
+	ctx := context.TODO()
+
+	reviewers := make([]CreateReviewer, len(args.Reviewers))
+	for r, reviewer := range args.Reviewers {
+		reviewers[r] = CreateReviewer{User: CreateReviewerUser{Name: reviewer}}
+	}
+
+	var pullRequest PullRequest
+
+	err := requests.URL(self.apiBaseURL()).
+		BasicAuth(self.username, self.token).
+		BodyJSON(CreatePullRequest{
+			Description: args.Body.String(),
+			Draft:       args.Draft,
+			FromRef:     CreatePullRequestRef{ID: fmt.Sprintf("refs/heads/%v", args.Source)},
+			Reviewers:   reviewers,
+			Title:       args.Title.String(),
+			ToRef:       CreatePullRequestRef{ID: fmt.Sprintf("refs/heads/%v", args.Target)},
+		}).
+		ToJSON(&pullRequest).
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+
+	proposal := parsePullRequest(pullRequest, self.RepositoryURL())
+
+	self.log.Success(proposal.URL)
+	return proposal, nil
+}
+
 func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 
@@ -149,6 +244,7 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 		return None[hostingdomain.Proposal](), nil
 	}
 	return Some(hostingdomain.Proposal{
+		Body:         "",
 		MergeWithAPI: true,
 		Number:       123,
 		Source:       branch,
@@ -201,8 +297,61 @@ func (self Connector) searchProposal(branch gitdomain.LocalBranchName) (Option[h
 	return Some(proposal), nil
 }
 
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, fmt.Sprintf("#%d", number))
+
+	ctx := context.TODO()
+
+	// Bitbucket requires the current version, title, and reviewers of the pull request to update it
+	var pullRequest PullRequest
+
+	err := requests.URL(fmt.Sprintf("%s/%d", self.apiBaseURL(), number)).
+		BasicAuth(self.username, self.token).
+		ToJSON(&pullRequest).
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+
+	reviewers := make([]CreateReviewer, len(pullRequest.Reviewers))
+	for r, reviewer := range pullRequest.Reviewers {
+		reviewers[r] = CreateReviewer{User: CreateReviewerUser{Name: reviewer.User.Name}}
+	}
+
+	err = requests.URL(fmt.Sprintf("%s/%d", self.apiBaseURL(), number)).
+		BasicAuth(self.username, self.token).
+		BodyJSON(UpdatePullRequest{
+			Description: body.String(),
+			Reviewers:   reviewers,
+			Title:       pullRequest.Title,
+			Version:     pullRequest.Version,
+		}).
+		Put().
+		Fetch(ctx)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+
+	self.log.Ok()
+	return nil
+}
+
+// verifyCreateProposalArgs ensures that the given arguments only use options that the Bitbucket Data Center API supports.
+func verifyCreateProposalArgs(args hostingdomain.CreateProposalArgs) error {
+	if len(args.Assignees) > 0 {
+		return fmt.Errorf(messages.HostingBitbucketOptionUnsupported, "assignees")
+	}
+	if len(args.Labels) > 0 {
+		return fmt.Errorf(messages.HostingBitbucketOptionUnsupported, "labels")
+	}
+	return nil
+}
+
 func parsePullRequest(pullRequest PullRequest, repoURL string) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Description),
 		MergeWithAPI: false,
 		Number:       pullRequest.ID,
 		Source:       gitdomain.NewLocalBranchName(pullRequest.FromRef.DisplayID),
@@ -49,4 +49,32 @@ func TestBitbucketConnector(t *testing.T) {
 		want := "https://custom-url.com/projects/git-town/repos/docs/pull-requests?create&sourceBranch=branch&targetBranch=parent-branch"
 		must.EqOp(t, want, have)
 	})
+
+	t.Run("UpdateProposalBodyFn", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("ssh://git@custom-url.com:7999/git-town/docs.git").Get()
+		must.True(t, has)
+
+		t.Run("with credentials", func(t *testing.T) {
+			t.Parallel()
+			connector := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				AppPassword:     Some(configdomain.BitbucketAppPassword("password")),
+				HostingPlatform: None[configdomain.HostingPlatform](),
+				RemoteURL:       url,
+				UserName:        Some(configdomain.BitbucketUsername("user")),
+			})
+			must.True(t, connector.UpdateProposalBodyFn().IsSome())
+		})
+
+		t.Run("without credentials", func(t *testing.T) {
+			t.Parallel()
+			connector := bitbucketdatacenter.NewConnector(bitbucketdatacenter.NewConnectorArgs{
+				AppPassword:     None[configdomain.BitbucketAppPassword](),
+				HostingPlatform: None[configdomain.HostingPlatform](),
+				RemoteURL:       url,
+				UserName:        None[configdomain.BitbucketUsername](),
+			})
+			must.True(t, connector.UpdateProposalBodyFn().IsNone())
+		})
+	})
 }
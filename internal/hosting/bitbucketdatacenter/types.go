@@ -88,3 +88,31 @@ type Repository struct {
 	State         string   `json:"state"`
 	StatusMessage string   `json:"statusMessage"`
 }
+
+type CreatePullRequest struct {
+	Description string               `json:"description"`
+	Draft       bool                 `json:"draft"`
+	FromRef     CreatePullRequestRef `json:"fromRef"`
+	Reviewers   []CreateReviewer     `json:"reviewers"`
+	Title       string               `json:"title"`
+	ToRef       CreatePullRequestRef `json:"toRef"`
+}
+
+type CreatePullRequestRef struct {
+	ID string `json:"id"`
+}
+
+type CreateReviewer struct {
+	User CreateReviewerUser `json:"user"`
+}
+
+type CreateReviewerUser struct {
+	Name string `json:"name"`
+}
+
+type UpdatePullRequest struct {
+	Description string           `json:"description"`
+	Reviewers   []CreateReviewer `json:"reviewers"`
+	Title       string           `json:"title"`
+	Version     int              `json:"version"`
+}
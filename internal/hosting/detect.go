@@ -3,6 +3,7 @@ package hosting
 import (
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
+	"github.com/git-town/git-town/v17/internal/hosting/azuredevops"
 	"github.com/git-town/git-town/v17/internal/hosting/bitbucketcloud"
 	"github.com/git-town/git-town/v17/internal/hosting/bitbucketdatacenter"
 	"github.com/git-town/git-town/v17/internal/hosting/gitea"
@@ -16,6 +17,7 @@ func Detect(remoteURL giturl.Parts, userOverride Option[configdomain.HostingPlat
 		return userOverride
 	}
 	detectors := map[configdomain.HostingPlatform]func(giturl.Parts) bool{
+		configdomain.HostingPlatformAzureDevOps:         azuredevops.Detect,
 		configdomain.HostingPlatformBitbucket:           bitbucketcloud.Detect,
 		configdomain.HostingPlatformBitbucketDatacenter: bitbucketdatacenter.Detect,
 		configdomain.HostingPlatformGitea:               gitea.Detect,
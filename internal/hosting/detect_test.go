@@ -13,6 +13,15 @@ import (
 func TestDetect(t *testing.T) {
 	t.Parallel()
 
+	t.Run("Azure DevOps SAAS, no override", func(t *testing.T) {
+		t.Parallel()
+		url, has := giturl.Parse("git@ssh.dev.azure.com:v3/git-town/git-town/docs").Get()
+		must.True(t, has)
+		have := hosting.Detect(url, None[configdomain.HostingPlatform]())
+		want := Some(configdomain.HostingPlatformAzureDevOps)
+		must.Eq(t, want, have)
+	})
+
 	t.Run("BitBucket SAAS, no override", func(t *testing.T) {
 		t.Parallel()
 		url, has := giturl.Parse("username@bitbucket.org:git-town/docs.git").Get()
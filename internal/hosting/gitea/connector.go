@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/git-town/git-town/v17/internal/cli/colors"
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
@@ -24,13 +24,31 @@ type Connector struct {
 	hostingdomain.Data
 	APIToken Option[configdomain.GiteaToken]
 	client   *gitea.Client
-	log      print.Logger
+	log      hostingdomain.Log
+}
+
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	if self.APIToken.IsSome() {
+		return Some(self.closeProposalViaAPI)
+	}
+	return None[func(number int) error]()
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	if self.APIToken.IsSome() {
+		return Some(self.createProposalViaAPI)
+	}
+	return None[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)]()
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	return None[func(number int) error]()
+}
+
 func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
 	if len(hostingdomain.ReadProposalOverride()) > 0 {
 		return Some(self.findProposalViaOverride)
@@ -41,6 +59,14 @@ func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.Loca
 	return None[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
 }
 
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+}
+
 func (self Connector) NewProposalURL(branch, parentBranch, _ gitdomain.LocalBranchName, _ gitdomain.ProposalTitle, _ gitdomain.ProposalBody) (string, error) {
 	toCompare := parentBranch.String() + "..." + branch.String()
 	return fmt.Sprintf("%s/compare/%s", self.RepositoryURL(), url.PathEscape(toCompare)), nil
@@ -64,6 +90,13 @@ func (self Connector) SquashMergeProposalFn() Option[func(number int, message gi
 	return None[func(number int, message gitdomain.CommitMessage) error]()
 }
 
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.APIToken.IsSome() {
+		return Some(self.updateProposalBody)
+	}
+	return None[func(number int, body gitdomain.ProposalBody) error]()
+}
+
 func (self Connector) UpdateProposalSourceFn() Option[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error] {
 	return None[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error]()
 }
@@ -75,6 +108,59 @@ func (self Connector) UpdateProposalTargetFn() Option[func(number int, target gi
 	return None[func(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error]()
 }
 
+func (self Connector) closeProposalViaAPI(number int) error {
+	self.log.Start(messages.APIProposalClose, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	state := gitea.StateClosed
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		State: &state,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) createProposalViaAPI(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalCreate, colors.BoldCyan().Styled(args.Source.String()))
+	labelIDs, err := self.labelIDs(args.Labels)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	title := args.Title.String()
+	if args.Draft {
+		// Gitea marks pull requests as work in progress through their title
+		title = "WIP: " + title
+	}
+	pullRequest, _, err := self.client.CreatePullRequest(self.Organization, self.Repository, gitea.CreatePullRequestOption{
+		Assignees: args.Assignees,
+		Base:      args.Target.String(),
+		Body:      args.Body.String(),
+		Head:      args.Source.String(),
+		Labels:    labelIDs,
+		Title:     title,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	proposal := parsePullRequest(pullRequest)
+	// from here on the pull request exists, so errors provide it together with the error
+	if len(args.Reviewers) > 0 {
+		_, err = self.client.CreateReviewRequests(self.Organization, self.Repository, pullRequest.Index, gitea.PullReviewRequestOptions{
+			Reviewers: args.Reviewers,
+		})
+		if err != nil {
+			self.log.Failed(err.Error())
+			return proposal, err
+		}
+	}
+	self.log.Success(proposal.URL)
+	return proposal, nil
+}
+
 func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	openPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
@@ -109,6 +195,7 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 		return None[hostingdomain.Proposal](), nil
 	}
 	return Some(hostingdomain.Proposal{
+		Body:         "",
 		MergeWithAPI: true,
 		Number:       123,
 		Source:       branch,
@@ -118,6 +205,30 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 	}), nil
 }
 
+// labelIDs provides the IDs of the labels with the given names in this repository.
+func (self Connector) labelIDs(names []string) ([]int64, error) {
+	result := make([]int64, 0, len(names))
+	if len(names) == 0 {
+		return result, nil
+	}
+	labels, _, err := self.client.ListRepoLabels(self.Organization, self.Repository, gitea.ListLabelsOptions{
+		ListOptions: gitea.ListOptions{
+			PageSize: 50,
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, name := range names {
+		index := slices.IndexFunc(labels, func(label *gitea.Label) bool { return label.Name == name })
+		if index < 0 {
+			return result, fmt.Errorf(messages.HostingGiteaLabelNotFound, name)
+		}
+		result = append(result, labels[index].ID)
+	}
+	return result, nil
+}
+
 func (self Connector) searchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIParentBranchLookupStart, branch.String())
 	openPullRequests, _, err := self.client.ListRepoPullRequests(self.Organization, self.Repository, gitea.ListPullRequestsOptions{
@@ -167,6 +278,19 @@ func (self Connector) squashMergeProposal(number int, message gitdomain.CommitMe
 	return err
 }
 
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	_, _, err := self.client.EditPullRequest(self.Organization, self.Repository, int64(number), gitea.EditPullRequestOption{
+		Body: body.String(),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
 func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error {
 	targetName := target.String()
 	self.log.Start(messages.APIUpdateProposalTarget, colors.BoldGreen().Styled("#"+strconv.Itoa(number)), colors.BoldCyan().Styled(targetName))
@@ -221,12 +345,13 @@ func NewConnector(args NewConnectorArgs) Connector {
 
 type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GiteaToken]
-	Log       print.Logger
+	Log       hostingdomain.Log
 	RemoteURL giturl.Parts
 }
 
 func parsePullRequest(pullRequest *gitea.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Body),
 		MergeWithAPI: pullRequest.Mergeable,
 		Number:       int(pullRequest.Index),
 		Source:       gitdomain.NewLocalBranchName(pullRequest.Head.Ref),
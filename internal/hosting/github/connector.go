@@ -9,8 +9,8 @@ import (
 	"strconv"
 
 	"github.com/git-town/git-town/v17/internal/cli/colors"
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/config/envconfig"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
@@ -27,13 +27,34 @@ type Connector struct {
 	hostingdomain.Data
 	APIToken Option[configdomain.GitHubToken]
 	client   *github.Client
-	log      print.Logger
+	log      hostingdomain.Log
+}
+
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) error]()
+	}
+	return Some(self.closeProposalViaAPI)
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	if self.APIToken.IsNone() {
+		return None[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)]()
+	}
+	return Some(self.createProposalViaAPI)
 }
 
 func (self Connector) DefaultProposalMessage(proposal hostingdomain.Proposal) string {
 	return fmt.Sprintf("%s (#%d)", proposal.Title, proposal.Number)
 }
 
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) error]()
+	}
+	return Some(self.enqueueProposalViaAPI)
+}
+
 func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
 	if len(hostingdomain.ReadProposalOverride()) > 0 {
 		return Some(self.findProposalViaOverride)
@@ -44,6 +65,20 @@ func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.Loca
 	return None[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
 }
 
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	if len(hostingdomain.ReadProposalOverride()) > 0 || self.APIToken.IsNone() {
+		return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+	}
+	return Some(self.findProposalsViaGraphQL)
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+	}
+	return Some(self.mergeQueueStatusViaAPI)
+}
+
 func (self Connector) NewProposalURL(branch, parentBranch, mainBranch gitdomain.LocalBranchName, proposalTitle gitdomain.ProposalTitle, proposalBody gitdomain.ProposalBody) (string, error) {
 	toCompare := branch.String()
 	if parentBranch != mainBranch {
@@ -77,6 +112,13 @@ func (self Connector) SquashMergeProposalFn() Option[func(number int, message gi
 	return Some(self.squashMergeProposal)
 }
 
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int, body gitdomain.ProposalBody) error]()
+	}
+	return Some(self.updateProposalBody)
+}
+
 func (self Connector) UpdateProposalSourceFn() Option[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error] {
 	return None[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error]()
 }
@@ -88,6 +130,88 @@ func (self Connector) UpdateProposalTargetFn() Option[func(number int, target gi
 	return Some(self.updateProposalTarget)
 }
 
+func (self Connector) closeProposalViaAPI(number int) error {
+	self.log.Start(messages.APIProposalClose, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	state := "closed"
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		State: &state,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) createProposalViaAPI(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalCreate, colors.BoldCyan().Styled(args.Source.String()))
+	ctx := context.Background()
+	title := args.Title.String()
+	body := args.Body.String()
+	head := args.Source.String()
+	base := args.Target.String()
+	pullRequest, _, err := self.client.PullRequests.Create(ctx, self.Organization, self.Repository, &github.NewPullRequest{
+		Base:  &base,
+		Body:  &body,
+		Draft: &args.Draft,
+		Head:  &head,
+		Title: &title,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	proposal := parsePullRequest(pullRequest)
+	// from here on the pull request exists, so errors provide it together with the error
+	if len(args.Reviewers) > 0 {
+		if _, _, err = self.client.PullRequests.RequestReviewers(ctx, self.Organization, self.Repository, proposal.Number, github.ReviewersRequest{Reviewers: args.Reviewers}); err != nil {
+			self.log.Failed(err.Error())
+			return proposal, err
+		}
+	}
+	if len(args.Assignees) > 0 {
+		if _, _, err = self.client.Issues.AddAssignees(ctx, self.Organization, self.Repository, proposal.Number, args.Assignees); err != nil {
+			self.log.Failed(err.Error())
+			return proposal, err
+		}
+	}
+	if len(args.Labels) > 0 {
+		if _, _, err = self.client.Issues.AddLabelsToIssue(ctx, self.Organization, self.Repository, proposal.Number, args.Labels); err != nil {
+			self.log.Failed(err.Error())
+			return proposal, err
+		}
+	}
+	self.log.Success(proposal.URL)
+	return proposal, nil
+}
+
+func (self Connector) enqueueProposalViaAPI(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.APIMergeQueueEnqueue, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	pullRequest, _, err := self.client.PullRequests.Get(context.Background(), self.Organization, self.Repository, number)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	var data any
+	err = self.graphql(graphqlRequest{
+		Query: enqueueMutation,
+		Variables: map[string]any{
+			"headOid": pullRequest.GetHead().GetSHA(),
+			"id":      pullRequest.GetNodeID(),
+		},
+	}, &data)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
 func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
@@ -119,6 +243,7 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 		return None[hostingdomain.Proposal](), nil
 	}
 	return Some(hostingdomain.Proposal{
+		Body:         "",
 		MergeWithAPI: true,
 		Number:       123,
 		Source:       branch,
@@ -128,6 +253,26 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 	}), nil
 }
 
+func (self Connector) mergeQueueStatusViaAPI(number int) (hostingdomain.MergeQueueStatus, error) {
+	self.log.Start(messages.APIMergeQueueStatus, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	var data graphqlMergeQueueStatusData
+	err := self.graphql(graphqlRequest{
+		Query: mergeQueueStatusQuery,
+		Variables: map[string]any{
+			"number": number,
+			"owner":  self.Organization,
+			"repo":   self.Repository,
+		},
+	}, &data)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.MergeQueueStatusQueued, err
+	}
+	status := parseMergeQueueStatus(data)
+	self.log.Success(status.String())
+	return status, nil
+}
+
 func (self Connector) searchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIParentBranchLookupStart, branch.String())
 	pullRequests, _, err := self.client.PullRequests.List(context.Background(), self.Organization, self.Repository, &github.PullRequestListOptions{
@@ -161,9 +306,25 @@ func (self Connector) squashMergeProposal(number int, message gitdomain.CommitMe
 		CommitTitle: commitMessageParts.Subject,
 	})
 	if err != nil {
-		self.log.Ok()
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, colors.BoldGreen().Styled("#"+strconv.Itoa(number)))
+	bodyText := body.String()
+	_, _, err := self.client.PullRequests.Edit(context.Background(), self.Organization, self.Repository, number, &github.PullRequest{
+		Body: &bodyText,
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
 	}
-	return err
+	self.log.Ok()
+	return nil
 }
 
 func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error {
@@ -204,8 +365,11 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: args.APIToken.String()})
 	httpClient := oauth2.NewClient(context.Background(), tokenSource)
 	githubClient := github.NewClient(httpClient)
-	if args.RemoteURL.Host != "github.com" {
-		url := "https://" + args.RemoteURL.Host
+	apiURL := envconfig.GitHubAPIURLOverride()
+	if apiURL.IsNone() && args.RemoteURL.Host != "github.com" {
+		apiURL = Some("https://" + args.RemoteURL.Host)
+	}
+	if url, hasURL := apiURL.Get(); hasURL {
 		var err error
 		githubClient, err = githubClient.WithEnterpriseURLs(url, url)
 		if err != nil {
@@ -226,13 +390,14 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 
 type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GitHubToken]
-	Log       print.Logger
+	Log       hostingdomain.Log
 	RemoteURL giturl.Parts
 }
 
 // parsePullRequest extracts standardized proposal data from the given GitHub pull-request.
 func parsePullRequest(pullRequest *github.PullRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.GetBody()),
 		Number:       pullRequest.GetNumber(),
 		Source:       gitdomain.NewLocalBranchName(pullRequest.Head.GetRef()),
 		Target:       gitdomain.NewLocalBranchName(pullRequest.Base.GetRef()),
@@ -0,0 +1,61 @@
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/google/go-github/v58/github"
+	"github.com/shoenig/test/must"
+)
+
+func TestCreateProposalViaAPI(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requesting reviewers fails after the pull request got created", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /repos/git-town/git-town/pulls", func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusCreated)
+			_, _ = writer.Write([]byte(`{"number": 7, "title": "title", "html_url": "https://github.com/git-town/git-town/pull/7", "head": {"ref": "feature"}, "base": {"ref": "main"}}`))
+		})
+		mux.HandleFunc("POST /repos/git-town/git-town/pulls/7/requested_reviewers", func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = writer.Write([]byte(`{"message": "Reviews may only be requested from collaborators"}`))
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		client := github.NewClient(nil)
+		baseURL, err := url.Parse(server.URL + "/")
+		must.NoError(t, err)
+		client.BaseURL = baseURL
+		connector := Connector{
+			APIToken: None[configdomain.GitHubToken](),
+			Data: hostingdomain.Data{
+				Hostname:     "github.com",
+				Organization: "git-town",
+				Repository:   "git-town",
+			},
+			client: client,
+			log:    print.NoLogger{},
+		}
+		have, err := connector.createProposalViaAPI(hostingdomain.CreateProposalArgs{
+			Assignees: []string{},
+			Body:      "",
+			Draft:     false,
+			Labels:    []string{},
+			Reviewers: []string{"someone"},
+			Source:    "feature",
+			Target:    "main",
+			Title:     "title",
+		})
+		must.Error(t, err)
+		must.EqOp(t, 7, have.Number)
+		must.EqOp(t, "https://github.com/git-town/git-town/pull/7", have.URL)
+	})
+}
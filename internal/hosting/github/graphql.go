@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// graphqlPath is the path of the GraphQL endpoint relative to the REST API endpoint.
+// This resolves to "https://api.github.com/graphql" on github.com
+// and to "https://<hostname>/api/graphql" on GitHub Enterprise.
+const graphqlPath = "../graphql"
+
+// proposalsBatchSize is the maximum number of lookups in a single query created by proposalsQuery.
+// This keeps the queries within the node limits of the GraphQL API.
+const proposalsBatchSize = 25
+
+// findProposalsViaGraphQL loads the proposals for all given lookups with as few GraphQL queries as possible.
+func (self Connector) findProposalsViaGraphQL(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+	return findProposalsInBatches(lookups, proposalsBatchSize, self.findProposalsBatchViaGraphQL)
+}
+
+// findProposalsBatchViaGraphQL loads the proposals for all given lookups with a single GraphQL query.
+func (self Connector) findProposalsBatchViaGraphQL(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+	request, err := self.client.NewRequest("POST", graphqlPath, proposalsQuery(self.Organization, self.Repository, lookups))
+	if err != nil {
+		return nil, err
+	}
+	var response graphqlProposalsResponse
+	_, err = self.client.Do(context.Background(), request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return parseProposalsResponse(response, self.Organization, lookups)
+}
+
+// findProposalsInBatches looks up the given proposals using the given function,
+// calling it with at most batchSize lookups at a time.
+func findProposalsInBatches(lookups []hostingdomain.ProposalLookup, batchSize int, findBatch func([]hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+	result := make(map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], len(lookups))
+	for batch := range slices.Chunk(lookups, batchSize) {
+		proposals, err := findBatch(batch)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(result, proposals)
+	}
+	return result, nil
+}
+
+// graphql sends the given request to the GraphQL API and stores the data of the response in the given data structure.
+func (self Connector) graphql(request graphqlRequest, data any) error {
+	httpRequest, err := self.client.NewRequest("POST", graphqlPath, request)
+	if err != nil {
+		return err
+	}
+	response := graphqlResponse{Data: data, Errors: []graphqlError{}}
+	_, err = self.client.Do(context.Background(), httpRequest, &response)
+	if err != nil {
+		return err
+	}
+	return joinGraphqlErrors(response.Errors)
+}
+
+// graphqlError is an error reported by the GraphQL API.
+type graphqlError struct {
+	Message string `json:"message"`
+}
+
+// graphqlRequest is the payload of a GraphQL request.
+type graphqlRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// graphqlResponse is the payload of the response to a GraphQL request.
+type graphqlResponse struct {
+	Data   any            `json:"data"`
+	Errors []graphqlError `json:"errors"`
+}
+
+// graphqlProposalsResponse is the payload of the response to the query created by proposalsQuery.
+type graphqlProposalsResponse struct {
+	Data struct {
+		Repository map[string]struct {
+			Nodes []graphqlPullRequest `json:"nodes"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []graphqlError `json:"errors"`
+}
+
+// graphqlPullRequest is a pull request returned by the GraphQL API.
+type graphqlPullRequest struct {
+	BaseRefName         string `json:"baseRefName"`
+	Body                string `json:"body"`
+	HeadRefName         string `json:"headRefName"`
+	HeadRepositoryOwner struct {
+		Login string `json:"login"`
+	} `json:"headRepositoryOwner"`
+	MergeStateStatus string `json:"mergeStateStatus"`
+	Number           int    `json:"number"`
+	Title            string `json:"title"`
+	URL              string `json:"url"`
+}
+
+// proposalsQuery provides the GraphQL query that loads the open pull requests for all given lookups.
+// Each lookup gets its own alias in the query, named after its position in the given lookups.
+func proposalsQuery(owner, repo string, lookups []hostingdomain.ProposalLookup) graphqlRequest {
+	variables := map[string]any{
+		"owner": owner,
+		"repo":  repo,
+	}
+	parameters := []string{"$owner: String!", "$repo: String!"}
+	fields := make([]string, len(lookups))
+	for l, lookup := range lookups {
+		variables[fmt.Sprintf("head%d", l)] = lookup.Branch.String()
+		variables[fmt.Sprintf("base%d", l)] = lookup.Target.String()
+		parameters = append(parameters, fmt.Sprintf("$head%d: String!, $base%d: String!", l, l))
+		fields[l] = fmt.Sprintf("    %s: pullRequests(headRefName: $head%d, baseRefName: $base%d, states: OPEN, first: 10) { nodes { baseRefName body headRefName headRepositoryOwner { login } mergeStateStatus number title url } }", proposalsQueryAlias(l), l, l)
+	}
+	query := fmt.Sprintf("query(%s) {\n  repository(owner: $owner, name: $repo) {\n%s\n  }\n}", strings.Join(parameters, ", "), strings.Join(fields, "\n"))
+	return graphqlRequest{
+		Query:     query,
+		Variables: variables,
+	}
+}
+
+// proposalsQueryAlias provides the alias of the lookup at the given position in the query created by proposalsQuery.
+func proposalsQueryAlias(position int) string {
+	return fmt.Sprintf("lookup%d", position)
+}
+
+// parseProposalsResponse extracts the proposals for the given lookups from the given GraphQL response.
+// Only pull requests whose source branch is in the repository of the given owner count, forks are ignored.
+func parseProposalsResponse(response graphqlProposalsResponse, owner string, lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+	if err := joinGraphqlErrors(response.Errors); err != nil {
+		return nil, err
+	}
+	result := make(map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], len(lookups))
+	for l, lookup := range lookups {
+		pullRequests := []graphqlPullRequest{}
+		for _, pullRequest := range response.Data.Repository[proposalsQueryAlias(l)].Nodes {
+			if strings.EqualFold(pullRequest.HeadRepositoryOwner.Login, owner) {
+				pullRequests = append(pullRequests, pullRequest)
+			}
+		}
+		switch len(pullRequests) {
+		case 0:
+			result[lookup] = None[hostingdomain.Proposal]()
+		case 1:
+			result[lookup] = Some(parseGraphqlPullRequest(pullRequests[0]))
+		default:
+			return nil, fmt.Errorf(messages.ProposalMultipleFromToFound, len(pullRequests), lookup.Branch, lookup.Target)
+		}
+	}
+	return result, nil
+}
+
+// joinGraphqlErrors provides a single error containing all given GraphQL errors,
+// or nil if there are none.
+func joinGraphqlErrors(graphqlErrors []graphqlError) error {
+	errs := make([]error, len(graphqlErrors))
+	for e, graphqlErr := range graphqlErrors {
+		errs[e] = errors.New(graphqlErr.Message)
+	}
+	return errors.Join(errs...)
+}
+
+// parseGraphqlPullRequest extracts standardized proposal data from the given GraphQL pull request.
+func parseGraphqlPullRequest(pullRequest graphqlPullRequest) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(pullRequest.Body),
+		MergeWithAPI: pullRequest.MergeStateStatus == "CLEAN",
+		Number:       pullRequest.Number,
+		Source:       gitdomain.NewLocalBranchName(pullRequest.HeadRefName),
+		Target:       gitdomain.NewLocalBranchName(pullRequest.BaseRefName),
+		Title:        pullRequest.Title,
+		URL:          pullRequest.URL,
+	}
+}
+
+// enqueueMutation is the GraphQL mutation that adds a pull request to the merge queue of its base branch.
+// Providing the expected head commit ensures that the merge queue merges the commits that the user has seen.
+const enqueueMutation = `mutation($id: ID!, $headOid: GitObjectID!) {
+  enqueuePullRequest(input: {pullRequestId: $id, expectedHeadOid: $headOid}) {
+    mergeQueueEntry { position }
+  }
+}`
+
+// mergeQueueStatusQuery is the GraphQL query that loads where a pull request is in the merge queue of its base branch.
+const mergeQueueStatusQuery = `query($owner: String!, $repo: String!, $number: Int!) {
+  repository(owner: $owner, name: $repo) {
+    pullRequest(number: $number) { isInMergeQueue state }
+  }
+}`
+
+// graphqlMergeQueueStatusData is the data of the response to mergeQueueStatusQuery.
+type graphqlMergeQueueStatusData struct {
+	Repository struct {
+		PullRequest struct {
+			IsInMergeQueue bool   `json:"isInMergeQueue"`
+			State          string `json:"state"`
+		} `json:"pullRequest"`
+	} `json:"repository"`
+}
+
+// parseMergeQueueStatus extracts the merge queue status from the given GraphQL response data.
+func parseMergeQueueStatus(data graphqlMergeQueueStatusData) hostingdomain.MergeQueueStatus {
+	pullRequest := data.Repository.PullRequest
+	switch {
+	case pullRequest.State == "MERGED":
+		return hostingdomain.MergeQueueStatusMerged
+	case pullRequest.State == "OPEN" && pullRequest.IsInMergeQueue:
+		return hostingdomain.MergeQueueStatusQueued
+	default:
+		return hostingdomain.MergeQueueStatusRemoved
+	}
+}
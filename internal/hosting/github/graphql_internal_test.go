@@ -0,0 +1,183 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestFindProposalsInBatches(t *testing.T) {
+	t.Parallel()
+
+	lookups := []hostingdomain.ProposalLookup{
+		{Branch: "alpha", Target: "main"},
+		{Branch: "beta", Target: "alpha"},
+		{Branch: "gamma", Target: "beta"},
+	}
+
+	t.Run("splits the lookups into batches", func(t *testing.T) {
+		t.Parallel()
+		batches := [][]hostingdomain.ProposalLookup{}
+		findBatch := func(batch []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+			batches = append(batches, batch)
+			result := map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal]{}
+			for _, lookup := range batch {
+				result[lookup] = Some(hostingdomain.Proposal{Source: lookup.Branch, Target: lookup.Target}) //exhaustruct:ignore
+			}
+			return result, nil
+		}
+		have, err := findProposalsInBatches(lookups, 2, findBatch)
+		must.NoError(t, err)
+		must.Eq(t, [][]hostingdomain.ProposalLookup{lookups[0:2], lookups[2:3]}, batches)
+		must.MapLen(t, 3, have)
+		for _, lookup := range lookups {
+			proposal, has := have[lookup].Get()
+			must.True(t, has)
+			must.EqOp(t, lookup.Branch, proposal.Source)
+		}
+	})
+
+	t.Run("all lookups fit into one batch", func(t *testing.T) {
+		t.Parallel()
+		batchCount := 0
+		findBatch := func(batch []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+			batchCount++
+			return map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal]{}, nil
+		}
+		_, err := findProposalsInBatches(lookups, proposalsBatchSize, findBatch)
+		must.NoError(t, err)
+		must.EqOp(t, 1, batchCount)
+	})
+
+	t.Run("a batch fails", func(t *testing.T) {
+		t.Parallel()
+		batchCount := 0
+		findBatch := func(batch []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+			batchCount++
+			return nil, errors.New("rate limited")
+		}
+		_, err := findProposalsInBatches(lookups, 1, findBatch)
+		must.EqError(t, err, "rate limited")
+		must.EqOp(t, 1, batchCount)
+	})
+}
+
+func TestParseProposalsResponse(t *testing.T) {
+	t.Parallel()
+
+	lookups := []hostingdomain.ProposalLookup{
+		{Branch: "alpha", Target: "main"},
+		{Branch: "beta", Target: "alpha"},
+	}
+
+	parse := func(t *testing.T, text string) graphqlProposalsResponse {
+		t.Helper()
+		var response graphqlProposalsResponse
+		must.NoError(t, json.Unmarshal([]byte(text), &response))
+		return response
+	}
+
+	t.Run("proposals found and not found", func(t *testing.T) {
+		t.Parallel()
+		response := parse(t, `{"data": {"repository": {
+			"lookup0": {"nodes": [{"baseRefName": "main", "body": "body", "headRefName": "alpha", "headRepositoryOwner": {"login": "Git-Town"}, "mergeStateStatus": "CLEAN", "number": 1, "title": "title", "url": "https://github.com/git-town/git-town/pull/1"}]},
+			"lookup1": {"nodes": []}
+		}}}`)
+		have, err := parseProposalsResponse(response, "git-town", lookups)
+		must.NoError(t, err)
+		want := map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal]{
+			lookups[0]: Some(hostingdomain.Proposal{
+				Body:         "body",
+				MergeWithAPI: true,
+				Number:       1,
+				Source:       "alpha",
+				Target:       "main",
+				Title:        "title",
+				URL:          "https://github.com/git-town/git-town/pull/1",
+			}),
+			lookups[1]: None[hostingdomain.Proposal](),
+		}
+		must.Eq(t, want, have)
+	})
+
+	t.Run("ignores proposals from forks", func(t *testing.T) {
+		t.Parallel()
+		response := parse(t, `{"data": {"repository": {
+			"lookup0": {"nodes": [{"baseRefName": "main", "headRefName": "alpha", "headRepositoryOwner": {"login": "someone-else"}, "number": 1}]},
+			"lookup1": {"nodes": []}
+		}}}`)
+		have, err := parseProposalsResponse(response, "git-town", lookups)
+		must.NoError(t, err)
+		must.True(t, have[lookups[0]].IsNone())
+	})
+
+	t.Run("multiple proposals for the same lookup", func(t *testing.T) {
+		t.Parallel()
+		response := parse(t, `{"data": {"repository": {
+			"lookup0": {"nodes": []},
+			"lookup1": {"nodes": [
+				{"baseRefName": "alpha", "headRefName": "beta", "headRepositoryOwner": {"login": "git-town"}, "number": 1},
+				{"baseRefName": "alpha", "headRefName": "beta", "headRepositoryOwner": {"login": "git-town"}, "number": 2}
+			]}
+		}}}`)
+		_, err := parseProposalsResponse(response, "git-town", lookups)
+		must.Error(t, err)
+	})
+
+	t.Run("GraphQL errors", func(t *testing.T) {
+		t.Parallel()
+		response := parse(t, `{"errors": [{"message": "one"}, {"message": "two"}]}`)
+		_, err := parseProposalsResponse(response, "git-town", lookups)
+		must.EqError(t, err, "one\ntwo")
+	})
+}
+
+func TestProposalsQuery(t *testing.T) {
+	t.Parallel()
+	lookups := []hostingdomain.ProposalLookup{
+		{Branch: "alpha", Target: "main"},
+		{Branch: "beta", Target: "alpha"},
+	}
+	have := proposalsQuery("git-town", "git-town", lookups)
+	wantQuery := `query($owner: String!, $repo: String!, $head0: String!, $base0: String!, $head1: String!, $base1: String!) {
+  repository(owner: $owner, name: $repo) {
+    lookup0: pullRequests(headRefName: $head0, baseRefName: $base0, states: OPEN, first: 10) { nodes { baseRefName body headRefName headRepositoryOwner { login } mergeStateStatus number title url } }
+    lookup1: pullRequests(headRefName: $head1, baseRefName: $base1, states: OPEN, first: 10) { nodes { baseRefName body headRefName headRepositoryOwner { login } mergeStateStatus number title url } }
+  }
+}`
+	must.EqOp(t, wantQuery, have.Query)
+	wantVariables := map[string]any{
+		"owner": "git-town",
+		"repo":  "git-town",
+		"head0": "alpha",
+		"base0": "main",
+		"head1": "beta",
+		"base1": "alpha",
+	}
+	must.Eq(t, wantVariables, have.Variables)
+}
+
+func TestParseMergeQueueStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		state          string
+		isInMergeQueue bool
+		want           hostingdomain.MergeQueueStatus
+	}{
+		{state: "MERGED", isInMergeQueue: false, want: hostingdomain.MergeQueueStatusMerged},
+		{state: "OPEN", isInMergeQueue: true, want: hostingdomain.MergeQueueStatusQueued},
+		{state: "OPEN", isInMergeQueue: false, want: hostingdomain.MergeQueueStatusRemoved},
+		{state: "CLOSED", isInMergeQueue: false, want: hostingdomain.MergeQueueStatusRemoved},
+	}
+	for _, test := range tests {
+		var data graphqlMergeQueueStatusData
+		data.Repository.PullRequest.State = test.state
+		data.Repository.PullRequest.IsInMergeQueue = test.isInMergeQueue
+		have := parseMergeQueueStatus(data)
+		must.EqOp(t, test.want, have)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 	"strconv"
 
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/git/giturl"
@@ -22,7 +21,28 @@ import (
 type Connector struct {
 	client *gitlab.Client
 	Data
-	log print.Logger
+	log hostingdomain.Log
+}
+
+func (self Connector) CloseProposalFn() Option[func(number int) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) error]()
+	}
+	return Some(self.closeProposalViaAPI)
+}
+
+func (self Connector) CreateProposalFn() Option[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)] {
+	if self.APIToken.IsNone() {
+		return None[func(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error)]()
+	}
+	return Some(self.createProposalViaAPI)
+}
+
+func (self Connector) EnqueueProposalFn() Option[func(number int) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) error]()
+	}
+	return Some(self.enqueueProposalViaAPI)
 }
 
 func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
@@ -35,6 +55,17 @@ func (self Connector) FindProposalFn() Option[func(branch, target gitdomain.Loca
 	return None[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
 }
 
+func (self Connector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+}
+
+func (self Connector) MergeQueueStatusFn() Option[func(number int) (hostingdomain.MergeQueueStatus, error)] {
+	if self.APIToken.IsNone() {
+		return None[func(number int) (hostingdomain.MergeQueueStatus, error)]()
+	}
+	return Some(self.mergeQueueStatusViaAPI)
+}
+
 func (self Connector) SearchProposalFn() Option[func(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
 	if self.APIToken.IsNone() {
 		return None[func(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)]()
@@ -49,6 +80,13 @@ func (self Connector) SquashMergeProposalFn() Option[func(number int, message gi
 	return Some(self.squashMergeProposal)
 }
 
+func (self Connector) UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error] {
+	if self.APIToken.IsNone() {
+		return None[func(number int, body gitdomain.ProposalBody) error]()
+	}
+	return Some(self.updateProposalBody)
+}
+
 func (self Connector) UpdateProposalSourceFn() Option[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error] {
 	return None[func(number int, _ gitdomain.LocalBranchName, finalMessages stringslice.Collector) error]()
 }
@@ -60,6 +98,72 @@ func (self Connector) UpdateProposalTargetFn() Option[func(number int, target gi
 	return Some(self.updateProposalTarget)
 }
 
+func (self Connector) closeProposalViaAPI(number int) error {
+	self.log.Start(messages.APIProposalClose, "!"+strconv.Itoa(number))
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.Ptr("close"),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
+func (self Connector) createProposalViaAPI(args hostingdomain.CreateProposalArgs) (hostingdomain.Proposal, error) {
+	self.log.Start(messages.APIProposalCreate, args.Source.String())
+	assigneeIDs, err := self.userIDs(args.Assignees)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	reviewerIDs, err := self.userIDs(args.Reviewers)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	title := args.Title.String()
+	if args.Draft {
+		// GitLab marks merge requests as drafts through their title
+		title = "Draft: " + title
+	}
+	labels := gitlab.LabelOptions(args.Labels)
+	mergeRequest, _, err := self.client.MergeRequests.CreateMergeRequest(self.projectPath(), &gitlab.CreateMergeRequestOptions{
+		AssigneeIDs:  &assigneeIDs,
+		Description:  gitlab.Ptr(args.Body.String()),
+		Labels:       &labels,
+		ReviewerIDs:  &reviewerIDs,
+		SourceBranch: gitlab.Ptr(args.Source.String()),
+		TargetBranch: gitlab.Ptr(args.Target.String()),
+		Title:        gitlab.Ptr(title),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return hostingdomain.Proposal{}, err
+	}
+	proposal := parseMergeRequest(mergeRequest)
+	self.log.Success(proposal.URL)
+	return proposal, nil
+}
+
+func (self Connector) enqueueProposalViaAPI(number int) error {
+	if number <= 0 {
+		return errors.New(messages.ProposalNoNumberGiven)
+	}
+	self.log.Start(messages.APIMergeQueueEnqueue, "!"+strconv.Itoa(number))
+	// merge trains merge only after the pipeline of the merged result has passed
+	_, _, err := self.client.MergeTrains.AddMergeRequestToMergeTrain(self.projectPath(), number, &gitlab.AddMergeRequestToMergeTrainOptions{
+		WhenPipelineSucceeds: gitlab.Ptr(true),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
 func (self Connector) findProposalViaAPI(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIProposalLookupStart)
 	opts := &gitlab.ListProjectMergeRequestsOptions{
@@ -93,6 +197,7 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 		return None[hostingdomain.Proposal](), nil
 	}
 	return Some(hostingdomain.Proposal{
+		Body:         "",
 		MergeWithAPI: true,
 		Number:       123,
 		Source:       branch,
@@ -102,6 +207,44 @@ func (self Connector) findProposalViaOverride(branch, target gitdomain.LocalBran
 	}), nil
 }
 
+func (self Connector) mergeQueueStatusViaAPI(number int) (hostingdomain.MergeQueueStatus, error) {
+	self.log.Start(messages.APIMergeQueueStatus, "!"+strconv.Itoa(number))
+	status, err := self.mergeQueueStatus(number)
+	if err != nil {
+		self.log.Failed(err.Error())
+		return status, err
+	}
+	self.log.Success(status.String())
+	return status, nil
+}
+
+func (self Connector) mergeQueueStatus(number int) (hostingdomain.MergeQueueStatus, error) {
+	mergeRequest, _, err := self.client.MergeRequests.GetMergeRequest(self.projectPath(), number, nil)
+	if err != nil {
+		return hostingdomain.MergeQueueStatusQueued, err
+	}
+	onMergeTrain := false
+	if mergeRequest.State == "opened" {
+		onMergeTrain, err = self.isOnMergeTrain(number)
+		if err != nil {
+			return hostingdomain.MergeQueueStatusQueued, err
+		}
+	}
+	return parseMergeQueueStatus(mergeRequest.State, onMergeTrain), nil
+}
+
+// isOnMergeTrain indicates whether the open merge request with the given number is on a merge train.
+func (self Connector) isOnMergeTrain(number int) (bool, error) {
+	_, response, err := self.client.MergeTrains.GetMergeRequestOnAMergeTrain(self.projectPath(), number)
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (self Connector) searchProposal(branch gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
 	self.log.Start(messages.APIParentBranchLookupStart, branch.String())
 	opts := &gitlab.ListProjectMergeRequestsOptions{
@@ -146,6 +289,19 @@ func (self Connector) squashMergeProposal(number int, message gitdomain.CommitMe
 	return nil
 }
 
+func (self Connector) updateProposalBody(number int, body gitdomain.ProposalBody) error {
+	self.log.Start(messages.APIUpdateProposalBody, "!"+strconv.Itoa(number))
+	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
+		Description: gitlab.Ptr(body.String()),
+	})
+	if err != nil {
+		self.log.Failed(err.Error())
+		return err
+	}
+	self.log.Ok()
+	return nil
+}
+
 func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBranchName, _ stringslice.Collector) error {
 	self.log.Start(messages.HostingGitlabUpdateMRViaAPI, number, target)
 	_, _, err := self.client.MergeRequests.UpdateMergeRequest(self.projectPath(), number, &gitlab.UpdateMergeRequestOptions{
@@ -159,6 +315,24 @@ func (self Connector) updateProposalTarget(number int, target gitdomain.LocalBra
 	return nil
 }
 
+// userIDs provides the IDs of the GitLab users with the given usernames.
+func (self Connector) userIDs(usernames []string) ([]int, error) {
+	result := make([]int, 0, len(usernames))
+	for _, username := range usernames {
+		users, _, err := self.client.Users.ListUsers(&gitlab.ListUsersOptions{
+			Username: gitlab.Ptr(username),
+		})
+		if err != nil {
+			return result, err
+		}
+		if len(users) == 0 {
+			return result, fmt.Errorf(messages.HostingGitlabUserNotFound, username)
+		}
+		result = append(result, users[0].ID)
+	}
+	return result, nil
+}
+
 // NewGitlabConfig provides GitLab configuration data if the current repo is hosted on GitLab,
 // otherwise nil.
 func NewConnector(args NewConnectorArgs) (Connector, error) {
@@ -186,12 +360,13 @@ func NewConnector(args NewConnectorArgs) (Connector, error) {
 
 type NewConnectorArgs struct {
 	APIToken  Option[configdomain.GitLabToken]
-	Log       print.Logger
+	Log       hostingdomain.Log
 	RemoteURL giturl.Parts
 }
 
 func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal {
 	return hostingdomain.Proposal{
+		Body:         gitdomain.ProposalBody(mergeRequest.Description),
 		MergeWithAPI: true,
 		Number:       mergeRequest.IID,
 		Source:       gitdomain.NewLocalBranchName(mergeRequest.SourceBranch),
@@ -200,3 +375,16 @@ func parseMergeRequest(mergeRequest *gitlab.MergeRequest) hostingdomain.Proposal
 		URL:          mergeRequest.WebURL,
 	}
 }
+
+// parseMergeQueueStatus determines the merge queue status of a merge request
+// from its state and whether it is on a merge train.
+func parseMergeQueueStatus(state string, onMergeTrain bool) hostingdomain.MergeQueueStatus {
+	switch {
+	case state == "merged":
+		return hostingdomain.MergeQueueStatusMerged
+	case state == "opened" && onMergeTrain:
+		return hostingdomain.MergeQueueStatusQueued
+	default:
+		return hostingdomain.MergeQueueStatusRemoved
+	}
+}
@@ -0,0 +1,27 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestParseMergeQueueStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		state        string
+		onMergeTrain bool
+		want         hostingdomain.MergeQueueStatus
+	}{
+		{state: "merged", onMergeTrain: false, want: hostingdomain.MergeQueueStatusMerged},
+		{state: "opened", onMergeTrain: true, want: hostingdomain.MergeQueueStatusQueued},
+		{state: "opened", onMergeTrain: false, want: hostingdomain.MergeQueueStatusRemoved},
+		{state: "closed", onMergeTrain: false, want: hostingdomain.MergeQueueStatusRemoved},
+		{state: "locked", onMergeTrain: false, want: hostingdomain.MergeQueueStatusRemoved},
+	}
+	for _, test := range tests {
+		have := parseMergeQueueStatus(test.state, test.onMergeTrain)
+		must.EqOp(t, test.want, have)
+	}
+}
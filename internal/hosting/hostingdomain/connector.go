@@ -12,16 +12,49 @@ import (
 // i.e. they return an option of the function to call.
 // A `None“ value implies that the respective functionality isn't supported by this connector implementation.
 type Connector interface {
+	// If this connector instance supports creating proposals via the API,
+	// calling this function returns a function that you can call
+	// to close the proposal with the given number without merging it.
+	// A None return value indicates that this connector does not support this feature (yet).
+	CloseProposalFn() Option[func(number int) error]
+
+	// If this connector instance supports creating proposals via the API,
+	// calling this function returns a function that you can call
+	// to create a proposal with the given data.
+	// If creating the proposal succeeds but a subsequent step like requesting reviewers fails,
+	// the returned function provides the created proposal together with the error.
+	// A None return value indicates that this connector does not support this feature (yet).
+	CreateProposalFn() Option[func(args CreateProposalArgs) (Proposal, error)]
+
 	// DefaultProposalMessage provides the text that the form for creating new proposals
 	// on the respective hosting platform is prepopulated with.
 	DefaultProposalMessage(proposal Proposal) string
 
+	// If this connector instance supports merge queues,
+	// calling this function returns a function that you can call
+	// to add the proposal with the given number to the merge queue of its target branch.
+	// A None return value indicates that this connector does not support this feature (yet).
+	EnqueueProposalFn() Option[func(number int) error]
+
 	// If this connector instance supports loading proposals via the API,
 	// calling this function returns a function that you can call
 	// to load details about the proposal for the given branch into the given target branch.
 	// A None return value indicates that this connector does not support this feature (yet).
 	FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[Proposal], error)]
 
+	// If this connector instance supports loading many proposals with a single API call,
+	// calling this function returns a function that you can call
+	// to load the proposals for all given lookups at once.
+	// The result contains an entry for each given lookup.
+	// A None return value indicates that this connector does not support this feature (yet).
+	FindProposalsFn() Option[func(lookups []ProposalLookup) (map[ProposalLookup]Option[Proposal], error)]
+
+	// If this connector instance supports merge queues,
+	// calling this function returns a function that you can call
+	// to determine where the proposal with the given number is in the merge queue of its target branch.
+	// A None return value indicates that this connector does not support this feature (yet).
+	MergeQueueStatusFn() Option[func(number int) (MergeQueueStatus, error)]
+
 	// If this connector instance supports loading proposals via the API,
 	// calling this function returns a function that you can call
 	// to search for a proposal that has the given branch as its source branch.
@@ -41,6 +74,12 @@ type Connector interface {
 	// RepositoryURL provides the URL where the current repository can be found online.
 	RepositoryURL() string
 
+	// If this connector instance supports loading proposals via the API,
+	// calling this function returns a function that you can call
+	// to update the body of the proposal with the given number.
+	// A None return value indicates that this connector does not support this feature (yet).
+	UpdateProposalBodyFn() Option[func(number int, body gitdomain.ProposalBody) error]
+
 	// If this connector instance supports loading proposals via the API,
 	// calling this function returns a function that you can call
 	// to update the source branch of the proposal with the given number.
@@ -0,0 +1,15 @@
+package hostingdomain
+
+import "github.com/git-town/git-town/v17/internal/git/gitdomain"
+
+// CreateProposalArgs describes a proposal to create at the code hosting platform.
+type CreateProposalArgs struct {
+	Assignees []string                  // usernames of the people to assign the proposal to
+	Body      gitdomain.ProposalBody    // textual description of the proposal
+	Draft     bool                      // whether to create the proposal as a draft
+	Labels    []string                  // names of the labels to add to the proposal
+	Reviewers []string                  // usernames of the people to request reviews from
+	Source    gitdomain.LocalBranchName // the source branch of the proposal
+	Target    gitdomain.LocalBranchName // the target branch of the proposal
+	Title     gitdomain.ProposalTitle   // textual title of the proposal
+}
@@ -0,0 +1,10 @@
+package hostingdomain
+
+// Log logs the activities of a connector on the CLI.
+type Log interface {
+	Failed(failure string)
+	Log(text string)
+	Ok()
+	Start(template string, data ...interface{})
+	Success(message string)
+}
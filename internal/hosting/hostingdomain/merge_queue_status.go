@@ -0,0 +1,15 @@
+package hostingdomain
+
+// MergeQueueStatus describes where a proposal is on its way through the merge queue
+// (called "merge train" on GitLab) of its target branch.
+type MergeQueueStatus string
+
+const (
+	MergeQueueStatusMerged  MergeQueueStatus = "merged"  // the merge queue has merged the proposal
+	MergeQueueStatusQueued  MergeQueueStatus = "queued"  // the proposal waits in the merge queue
+	MergeQueueStatusRemoved MergeQueueStatus = "removed" // the proposal left the merge queue without getting merged, for example because its CI checks failed
+)
+
+func (self MergeQueueStatus) String() string {
+	return string(self)
+}
@@ -5,6 +5,9 @@ import "github.com/git-town/git-town/v17/internal/git/gitdomain"
 // Proposal contains information about a change request on a code hosting platform.
 // Alternative names are "pull request" or "merge request".
 type Proposal struct {
+	// textual description of the proposal
+	Body gitdomain.ProposalBody
+
 	// whether this proposal can be merged via the API
 	MergeWithAPI bool
 
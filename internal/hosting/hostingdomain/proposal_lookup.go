@@ -0,0 +1,9 @@
+package hostingdomain
+
+import "github.com/git-town/git-town/v17/internal/git/gitdomain"
+
+// ProposalLookup describes a proposal to look up at the code hosting platform.
+type ProposalLookup struct {
+	Branch gitdomain.LocalBranchName // the source branch of the proposal
+	Target gitdomain.LocalBranchName // the target branch of the proposal
+}
@@ -0,0 +1,70 @@
+package hostingdomain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+)
+
+const (
+	// marks the beginning of the stack section in proposal bodies
+	ProposalStackStart = "<!-- git-town stack start -->"
+
+	// marks the end of the stack section in proposal bodies
+	ProposalStackEnd = "<!-- git-town stack end -->"
+)
+
+// ProposalStackEntry is a proposal listed in the stack section of proposal bodies.
+type ProposalStackEntry struct {
+	// how many ancestor proposals this proposal has in the stack
+	Depth int
+
+	Proposal Proposal
+}
+
+// ProposalStackSection provides the marker-delimited Markdown section
+// that lists the given proposals of a stack and marks the proposal with the given number as the current one.
+func ProposalStackSection(entries []ProposalStackEntry, current int) string {
+	lines := []string{ProposalStackStart, "This proposal is part of a stack:", ""}
+	for _, entry := range entries {
+		line := fmt.Sprintf("%s- [#%d %s](%s)", strings.Repeat("  ", entry.Depth), entry.Proposal.Number, entry.Proposal.Title, entry.Proposal.URL)
+		if entry.Proposal.Number == current {
+			line += " 👈"
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, ProposalStackEnd)
+	return strings.Join(lines, "\n")
+}
+
+// UpdateProposalStackSection provides the given proposal body with its stack section replaced by the given section.
+// Appends the section if the body doesn't contain one yet.
+// An empty section removes the stack section from the body.
+// Leaves bodies that neither contain nor receive a stack section unchanged.
+func UpdateProposalStackSection(body gitdomain.ProposalBody, section string) gitdomain.ProposalBody {
+	text := body.String()
+	start := strings.Index(text, ProposalStackStart)
+	end := strings.Index(text, ProposalStackEnd)
+	if start < 0 || end < start {
+		if section == "" {
+			return body
+		}
+		return gitdomain.ProposalBody(joinParagraphs(text, section))
+	}
+	before := text[:start]
+	after := strings.TrimLeft(text[end+len(ProposalStackEnd):], "\r\n")
+	return gitdomain.ProposalBody(joinParagraphs(before, section, after))
+}
+
+// joinParagraphs concatenates the given non-empty texts, separated by empty lines.
+func joinParagraphs(texts ...string) string {
+	paragraphs := []string{}
+	for _, text := range texts {
+		text = strings.TrimRight(text, "\r\n")
+		if len(text) > 0 {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
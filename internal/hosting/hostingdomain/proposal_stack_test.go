@@ -0,0 +1,103 @@
+package hostingdomain_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposalStack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ProposalStackSection", func(t *testing.T) {
+		t.Parallel()
+		entries := []hostingdomain.ProposalStackEntry{
+			{
+				Depth:    0,
+				Proposal: hostingdomain.Proposal{Number: 1, Title: "one", URL: "https://example.com/1"},
+			},
+			{
+				Depth:    1,
+				Proposal: hostingdomain.Proposal{Number: 2, Title: "two", URL: "https://example.com/2"},
+			},
+			{
+				Depth:    1,
+				Proposal: hostingdomain.Proposal{Number: 3, Title: "three", URL: "https://example.com/3"},
+			},
+		}
+		have := hostingdomain.ProposalStackSection(entries, 2)
+		want := `<!-- git-town stack start -->
+This proposal is part of a stack:
+
+- [#1 one](https://example.com/1)
+  - [#2 two](https://example.com/2) 👈
+  - [#3 three](https://example.com/3)
+<!-- git-town stack end -->`
+		must.EqOp(t, want, have)
+	})
+
+	t.Run("UpdateProposalStackSection", func(t *testing.T) {
+		t.Parallel()
+		section := hostingdomain.ProposalStackStart + "\nnew\n" + hostingdomain.ProposalStackEnd
+		tests := map[string]struct {
+			body    gitdomain.ProposalBody
+			section string
+			want    gitdomain.ProposalBody
+		}{
+			"empty body": {
+				body:    "",
+				section: section,
+				want:    gitdomain.ProposalBody(section),
+			},
+			"body without stack section": {
+				body:    "description\n",
+				section: section,
+				want:    gitdomain.ProposalBody("description\n\n" + section),
+			},
+			"body with stack section at the end": {
+				body:    gitdomain.ProposalBody("description\n\n" + hostingdomain.ProposalStackStart + "\nold\n" + hostingdomain.ProposalStackEnd),
+				section: section,
+				want:    gitdomain.ProposalBody("description\n\n" + section),
+			},
+			"body with stack section in the middle": {
+				body:    gitdomain.ProposalBody("description\n\n" + hostingdomain.ProposalStackStart + "\nold\n" + hostingdomain.ProposalStackEnd + "\n\nfooter"),
+				section: section,
+				want:    gitdomain.ProposalBody("description\n\n" + section + "\n\nfooter"),
+			},
+			"remove stack section": {
+				body:    gitdomain.ProposalBody("description\n\n" + hostingdomain.ProposalStackStart + "\nold\n" + hostingdomain.ProposalStackEnd),
+				section: "",
+				want:    "description",
+			},
+			"remove missing stack section": {
+				body:    "description",
+				section: "",
+				want:    "description",
+			},
+			"remove missing stack section from body ending in a newline": {
+				body:    "description\n",
+				section: "",
+				want:    "description\n",
+			},
+			"remove missing stack section from body with Windows line endings": {
+				body:    "description\r\n\r\nmore description\r\n",
+				section: "",
+				want:    "description\r\n\r\nmore description\r\n",
+			},
+			"body with Windows line endings and stack section": {
+				body:    gitdomain.ProposalBody("description\r\n\r\n" + hostingdomain.ProposalStackStart + "\nold\n" + hostingdomain.ProposalStackEnd + "\r\n"),
+				section: section,
+				want:    gitdomain.ProposalBody("description\n\n" + section),
+			},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				have := hostingdomain.UpdateProposalStackSection(tt.body, tt.section)
+				must.EqOp(t, tt.want, have)
+			})
+		}
+	})
+}
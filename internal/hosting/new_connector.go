@@ -1,10 +1,10 @@
 package hosting
 
 import (
-	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/azuredevops"
 	"github.com/git-town/git-town/v17/internal/hosting/bitbucketcloud"
 	"github.com/git-town/git-town/v17/internal/hosting/bitbucketdatacenter"
 	"github.com/git-town/git-town/v17/internal/hosting/gitea"
@@ -15,7 +15,7 @@ import (
 )
 
 // NewConnector provides an instance of the code hosting connector to use based on the given gitConfig.
-func NewConnector(config config.UnvalidatedConfig, remote gitdomain.Remote, log print.Logger) (Option[hostingdomain.Connector], error) {
+func NewConnector(config config.UnvalidatedConfig, remote gitdomain.Remote, log hostingdomain.Log) (Option[hostingdomain.Connector], error) {
 	remoteURL, hasRemoteURL := config.NormalConfig.RemoteURL(remote).Get()
 	hostingPlatform := config.NormalConfig.HostingPlatform
 	platform, hasPlatform := Detect(remoteURL, hostingPlatform).Get()
@@ -24,6 +24,14 @@ func NewConnector(config config.UnvalidatedConfig, remote gitdomain.Remote, log
 	}
 	var connector hostingdomain.Connector
 	switch platform {
+	case configdomain.HostingPlatformAzureDevOps:
+		connector = azuredevops.NewConnector(azuredevops.NewConnectorArgs{
+			APIToken:  config.NormalConfig.AzureDevOpsToken,
+			APIURL:    None[string](),
+			Log:       log,
+			RemoteURL: remoteURL,
+		})
+		return Some(connector), nil
 	case configdomain.HostingPlatformBitbucket:
 		connector = bitbucketcloud.NewConnector(bitbucketcloud.NewConnectorArgs{
 			AppPassword:     config.NormalConfig.BitbucketAppPassword,
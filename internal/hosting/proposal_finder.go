@@ -0,0 +1,144 @@
+package hosting
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/config"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/gohacks/cache"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// proposalLookupWorkers defines how many proposals ProposalFinder looks up concurrently.
+const proposalLookupWorkers = 8
+
+// proposalCache caches the proposals at the code hosting platform.
+type proposalCache = cache.Map[hostingdomain.ProposalLookup, Option[hostingdomain.Proposal]]
+
+// ProposalFinder finds proposals at the code hosting platform.
+// It looks up each proposal only once for the duration of the current Git Town command.
+type ProposalFinder struct {
+	connector      Option[hostingdomain.Connector]
+	log            hostingdomain.Log
+	proposals      *proposalCache
+	quietConnector Option[hostingdomain.Connector] // doesn't log its activities, for concurrent lookups
+}
+
+// NewProposalFinder provides a ProposalFinder that uses the given connector.
+func NewProposalFinder(config config.UnvalidatedConfig, remote gitdomain.Remote, connector Option[hostingdomain.Connector], log hostingdomain.Log) (ProposalFinder, error) {
+	quietConnector := None[hostingdomain.Connector]()
+	if connector.IsSome() {
+		var err error
+		quietConnector, err = NewConnector(config, remote, print.NoLogger{})
+		if err != nil {
+			return ProposalFinder{}, err
+		}
+	}
+	return ProposalFinder{
+		connector:      connector,
+		log:            log,
+		proposals:      &proposalCache{},
+		quietConnector: quietConnector,
+	}, nil
+}
+
+// Find provides the proposal of the given branch into the given target branch.
+func (self ProposalFinder) Find(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	lookup := hostingdomain.ProposalLookup{Branch: branch, Target: target}
+	if proposal, isCached := self.proposals.Get(lookup); isCached {
+		return proposal, nil
+	}
+	connector, hasConnector := self.connector.Get()
+	if !hasConnector {
+		return None[hostingdomain.Proposal](), nil
+	}
+	findProposal, canFindProposal := connector.FindProposalFn().Get()
+	if !canFindProposal {
+		return None[hostingdomain.Proposal](), nil
+	}
+	proposal, err := findProposal(branch, target)
+	if err != nil {
+		return None[hostingdomain.Proposal](), err
+	}
+	self.proposals.Set(lookup, proposal)
+	return proposal, nil
+}
+
+// Preload looks up the proposals for all given lookups at once and caches them.
+// Lookups that fail here don't get cached, Find looks them up again and reports the error.
+func (self ProposalFinder) Preload(lookups []hostingdomain.ProposalLookup) {
+	uncached := []hostingdomain.ProposalLookup{}
+	for _, lookup := range lookups {
+		if _, isCached := self.proposals.Get(lookup); !isCached && !slices.Contains(uncached, lookup) {
+			uncached = append(uncached, lookup)
+		}
+	}
+	if len(uncached) < 2 {
+		// a single lookup doesn't benefit from preloading
+		return
+	}
+	connector, hasConnector := self.quietConnector.Get()
+	if !hasConnector {
+		return
+	}
+	if findProposals, canFindProposals := connector.FindProposalsFn().Get(); canFindProposals {
+		self.log.Start(messages.APIProposalsLookupStart, len(uncached))
+		proposals, err := findProposals(uncached)
+		if err != nil {
+			self.log.Failed(err.Error())
+			return
+		}
+		for lookup, proposal := range proposals {
+			self.proposals.Set(lookup, proposal)
+		}
+		self.log.Ok()
+		return
+	}
+	findProposal, canFindProposal := connector.FindProposalFn().Get()
+	if !canFindProposal {
+		return
+	}
+	self.log.Start(messages.APIProposalsLookupStart, len(uncached))
+	failures := self.preloadConcurrently(uncached, findProposal)
+	if failures > 0 {
+		self.log.Failed(fmt.Sprintf(messages.APIProposalsLookupFailed, failures))
+		return
+	}
+	self.log.Ok()
+}
+
+// preloadConcurrently looks up the given proposals using a bounded pool of workers.
+// Returns the number of failed lookups.
+func (self ProposalFinder) preloadConcurrently(lookups []hostingdomain.ProposalLookup, findProposal func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)) int {
+	queue := make(chan hostingdomain.ProposalLookup)
+	var failures int
+	var failuresMutex sync.Mutex
+	var waitGroup sync.WaitGroup
+	for range min(proposalLookupWorkers, len(lookups)) {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for lookup := range queue {
+				proposal, err := findProposal(lookup.Branch, lookup.Target)
+				if err != nil {
+					failuresMutex.Lock()
+					failures++
+					failuresMutex.Unlock()
+					continue
+				}
+				self.proposals.Set(lookup, proposal)
+			}
+		}()
+	}
+	for _, lookup := range lookups {
+		queue <- lookup
+	}
+	close(queue)
+	waitGroup.Wait()
+	return failures
+}
@@ -0,0 +1,226 @@
+package hosting
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestProposalFinder(t *testing.T) {
+	t.Parallel()
+
+	alpha := hostingdomain.ProposalLookup{Branch: "alpha", Target: "main"}
+	beta := hostingdomain.ProposalLookup{Branch: "beta", Target: "alpha"}
+	gamma := hostingdomain.ProposalLookup{Branch: "gamma", Target: "beta"}
+
+	t.Run("Find", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("cache miss and cache hit", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			finder := newTestProposalFinder(connector)
+			have, err := finder.Find(alpha.Branch, alpha.Target)
+			must.NoError(t, err)
+			must.Eq(t, Some(testProposal(alpha)), have)
+			have, err = finder.Find(alpha.Branch, alpha.Target)
+			must.NoError(t, err)
+			must.Eq(t, Some(testProposal(alpha)), have)
+			must.Eq(t, []hostingdomain.ProposalLookup{alpha}, connector.singleLookups())
+		})
+
+		t.Run("failed lookups don't get cached", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.failingLookups = []hostingdomain.ProposalLookup{alpha}
+			finder := newTestProposalFinder(connector)
+			_, err := finder.Find(alpha.Branch, alpha.Target)
+			must.Error(t, err)
+			_, err = finder.Find(alpha.Branch, alpha.Target)
+			must.Error(t, err)
+			must.Eq(t, []hostingdomain.ProposalLookup{alpha, alpha}, connector.singleLookups())
+		})
+
+		t.Run("no connector", func(t *testing.T) {
+			t.Parallel()
+			finder := ProposalFinder{
+				connector:      None[hostingdomain.Connector](),
+				log:            print.NoLogger{},
+				proposals:      &proposalCache{},
+				quietConnector: None[hostingdomain.Connector](),
+			}
+			have, err := finder.Find(alpha.Branch, alpha.Target)
+			must.NoError(t, err)
+			must.True(t, have.IsNone())
+		})
+	})
+
+	t.Run("Preload", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("batch lookup", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.supportsBatches = true
+			finder := newTestProposalFinder(connector)
+			finder.Preload([]hostingdomain.ProposalLookup{alpha, beta, alpha, gamma})
+			must.Eq(t, [][]hostingdomain.ProposalLookup{{alpha, beta, gamma}}, connector.batchLookups())
+			for _, lookup := range []hostingdomain.ProposalLookup{alpha, beta, gamma} {
+				have, err := finder.Find(lookup.Branch, lookup.Target)
+				must.NoError(t, err)
+				must.Eq(t, Some(testProposal(lookup)), have)
+			}
+			must.SliceEmpty(t, connector.singleLookups())
+		})
+
+		t.Run("only looks up uncached proposals", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.supportsBatches = true
+			finder := newTestProposalFinder(connector)
+			_, err := finder.Find(alpha.Branch, alpha.Target)
+			must.NoError(t, err)
+			finder.Preload([]hostingdomain.ProposalLookup{alpha, beta, gamma})
+			must.Eq(t, [][]hostingdomain.ProposalLookup{{beta, gamma}}, connector.batchLookups())
+		})
+
+		t.Run("single uncached lookup", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.supportsBatches = true
+			finder := newTestProposalFinder(connector)
+			finder.Preload([]hostingdomain.ProposalLookup{alpha})
+			must.SliceEmpty(t, connector.batchLookups())
+			must.SliceEmpty(t, connector.singleLookups())
+		})
+
+		t.Run("failing batch lookup", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.supportsBatches = true
+			connector.failingLookups = []hostingdomain.ProposalLookup{beta}
+			finder := newTestProposalFinder(connector)
+			finder.Preload([]hostingdomain.ProposalLookup{alpha, beta})
+			_, err := finder.Find(alpha.Branch, alpha.Target)
+			must.NoError(t, err)
+			must.Eq(t, []hostingdomain.ProposalLookup{alpha}, connector.singleLookups())
+		})
+
+		t.Run("concurrent lookups", func(t *testing.T) {
+			t.Parallel()
+			connector := newFakeConnector()
+			connector.failingLookups = []hostingdomain.ProposalLookup{beta}
+			finder := newTestProposalFinder(connector)
+			finder.Preload([]hostingdomain.ProposalLookup{alpha, beta, gamma})
+			must.SliceContainsAll(t, []hostingdomain.ProposalLookup{alpha, beta, gamma}, connector.singleLookups())
+			// successful lookups are cached
+			have, err := finder.Find(gamma.Branch, gamma.Target)
+			must.NoError(t, err)
+			must.Eq(t, Some(testProposal(gamma)), have)
+			// failed lookups are not cached
+			_, err = finder.Find(beta.Branch, beta.Target)
+			must.Error(t, err)
+			must.Len(t, 4, connector.singleLookups())
+		})
+	})
+}
+
+// fakeConnector is a connector that records the proposal lookups it receives.
+type fakeConnector struct {
+	hostingdomain.Connector
+	batches         *[][]hostingdomain.ProposalLookup
+	failingLookups  []hostingdomain.ProposalLookup
+	lookups         *[]hostingdomain.ProposalLookup
+	mutex           *sync.Mutex
+	supportsBatches bool
+}
+
+func newFakeConnector() *fakeConnector {
+	return &fakeConnector{
+		Connector:       nil,
+		batches:         &[][]hostingdomain.ProposalLookup{},
+		failingLookups:  []hostingdomain.ProposalLookup{},
+		lookups:         &[]hostingdomain.ProposalLookup{},
+		mutex:           &sync.Mutex{},
+		supportsBatches: false,
+	}
+}
+
+func (self *fakeConnector) FindProposalFn() Option[func(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error)] {
+	return Some(self.findProposal)
+}
+
+func (self *fakeConnector) FindProposalsFn() Option[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)] {
+	if !self.supportsBatches {
+		return None[func(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error)]()
+	}
+	return Some(self.findProposals)
+}
+
+func (self *fakeConnector) batchLookups() [][]hostingdomain.ProposalLookup {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return *self.batches
+}
+
+func (self *fakeConnector) findProposal(branch, target gitdomain.LocalBranchName) (Option[hostingdomain.Proposal], error) {
+	lookup := hostingdomain.ProposalLookup{Branch: branch, Target: target}
+	self.mutex.Lock()
+	*self.lookups = append(*self.lookups, lookup)
+	self.mutex.Unlock()
+	for _, failingLookup := range self.failingLookups {
+		if lookup == failingLookup {
+			return None[hostingdomain.Proposal](), errors.New("lookup failed")
+		}
+	}
+	return Some(testProposal(lookup)), nil
+}
+
+func (self *fakeConnector) findProposals(lookups []hostingdomain.ProposalLookup) (map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal], error) {
+	self.mutex.Lock()
+	*self.batches = append(*self.batches, lookups)
+	self.mutex.Unlock()
+	result := map[hostingdomain.ProposalLookup]Option[hostingdomain.Proposal]{}
+	for _, lookup := range lookups {
+		for _, failingLookup := range self.failingLookups {
+			if lookup == failingLookup {
+				return nil, errors.New("lookup failed")
+			}
+		}
+		result[lookup] = Some(testProposal(lookup))
+	}
+	return result, nil
+}
+
+func (self *fakeConnector) singleLookups() []hostingdomain.ProposalLookup {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return *self.lookups
+}
+
+func newTestProposalFinder(connector *fakeConnector) ProposalFinder {
+	return ProposalFinder{
+		connector:      Some[hostingdomain.Connector](connector),
+		log:            print.NoLogger{},
+		proposals:      &proposalCache{},
+		quietConnector: Some[hostingdomain.Connector](connector),
+	}
+}
+
+func testProposal(lookup hostingdomain.ProposalLookup) hostingdomain.Proposal {
+	return hostingdomain.Proposal{
+		Body:         "",
+		MergeWithAPI: false,
+		Number:       len(lookup.Branch),
+		Source:       lookup.Branch,
+		Target:       lookup.Target,
+		Title:        lookup.Branch.String(),
+		URL:          "",
+	}
+}
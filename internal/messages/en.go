@@ -2,14 +2,29 @@ package messages
 
 const (
 	UndoContinueGuidance               = "\n\nTo continue after having resolved conflicts, run \"git town continue\".\nTo go back to where you started, run \"git town undo\".\n"
+	AbsorbChangesSkippedFile           = "The changes to file %q don't belong to a single commit of this branch or its ancestors. They remain in your workspace."
+	AbsorbChangesSkippedHunk           = "The changes to file %q at line %d don't belong to a single commit of this branch or its ancestors. They remain in your workspace."
+	AbsorbNoFeatureBranch              = "cannot absorb changes into branch %q because it is not a feature branch"
+	AbsorbNoStagedChanges              = "there are no staged changes to absorb"
+	AbsorbNothingToAbsorb              = "cannot find commits in branch %q or its ancestors that the staged changes belong to"
+	AbsorbUnstagedChanges              = "cannot absorb the staged changes because these files also have unstaged changes: %s"
+	AheadBehindUnexpectedOutput        = "unexpected output of git rev-list: %q"
 	AliasedCommands                    = "Aliased commands: %s\n"
 	ArgumentUnknown                    = "unknown argument: %q"
+	APIMergeQueueEnqueue               = "Adding proposal %s to the merge queue ... "
+	APIMergeQueueStatus                = "Checking merge queue status of proposal %s ... "
 	APIParentBranchLookupStart         = "Looking for parent of %s ... "
+	APIProposalClose                   = "Closing proposal %s ... "
+	APIProposalCreate                  = "Creating proposal for branch %s ... "
 	APIProposalLookupStart             = "Looking for proposal online ... "
+	APIProposalsLookupStart            = "Looking for proposals of %d branches online ... "
+	APIProposalsLookupFailed           = "could not load %d proposals"
 	APIProposalUpdateStart             = "Updating proposal online ... "
 	APIUnexpectedResultDataStructure   = "unexpected result data structure"
+	APIUpdateProposalBody              = "Updating body of proposal %s ... "
 	APIUpdateProposalSource            = "Updating source branch of proposal %s to %s ... "
 	APIUpdateProposalTarget            = "Updating target branch of proposal %s to %s ... "
+	AzureDevOpsToken                   = "Azure DevOps token: %s\n"
 	BranchAlreadyExistsLocally         = "there is already a branch %q"
 	BranchAlreadyExistsRemotely        = "there is already a branch %q at the \"origin\" remote"
 	BranchAuthorMultiple               = "\nMultiple people authored the %q branch.\n\n"
@@ -32,6 +47,7 @@ const (
 	BranchLocalProblem                 = "cannot determine whether the local branch %q exists: %w"
 	BranchOtherWorktree                = `branch %q is active in another worktree`
 	BranchParentChanged                = "branch %q is now a child of %q"
+	BranchShippedProblem               = "cannot determine whether branch %q was shipped: %w"
 	BrowserOpen                        = "Please open in a browser: %s\n"
 	CacheUnitialized                   = "using a cached value before initialization"
 	CodeHosting                        = "Code hosting: %s\n"
@@ -47,8 +63,26 @@ const (
 	CompressObservedBranch             = "you are merely observing branch %q and should leave compressing it to the branch owner"
 	CompressParkedBranch               = "branch %q and should not compress it"
 	CompletionTypeUnknown              = "unknown completion type: %q"
+	ConfigAliasUnknown                 = "the configuration file contains an alias for the unknown command %q"
+	ConfigBranchPatternInvalid         = "the configuration file contains the invalid branch pattern %q: %w"
+	ConfigChanges                      = "Apply changes: %s\n"
+	ConfigExplainDeprecatedKey         = "%s setting %q is deprecated, Git Town will migrate it to %q"
+	ConfigExplainDeprecatedValue       = "%s setting %q with value %q is deprecated, Git Town will migrate it to %q with value %q"
+	ConfigExplainLineageCycle          = "the lineage contains a cycle: %s"
+	ConfigExplainLineageMissingBranch  = "the lineage contains a parent for branch %q, which doesn't exist"
+	ConfigExplainLineageMissingParent  = "branch %q has the parent %q, which doesn't exist"
+	ConfigExplainNoProblems            = "No problems found."
+	ConfigExplainObsoleteKey           = "%s setting %q is obsolete, Git Town will remove it"
+	ConfigExplainOverrides             = "    overrides %s (%s)\n"
+	ConfigExplainRegexUnused           = "%s %q matches no branch"
+	ConfigExportUnchanged              = "%s already contains the current configuration.\n"
 	ConfigFileCannotRead               = "cannot read the configuration file %q: %w"
+	ConfigFileChanges                  = "Changes to %s:\n"
+	ConfigFileHooksUntrusted           = "The configuration file defines hooks, which Git Town doesn't run until you trust them.\nTo run them in this repository, run: git config git-town.trust-config-file-hooks true"
 	ConfigFileInvalidContent           = "the configuration file %q does not contain TOML-formatted content: %w"
+	ConfigGitChanges                   = "Changes to the Git metadata:\n"
+	ConfigImportNoFile                 = "cannot find the configuration file %q to import"
+	ConfigImportUnchanged              = "The Git metadata already contains the configuration from %s.\n"
 	ConfigLineageParentIsChild         = "removing lineage entry for %q because the parent is the child"
 	ConfigLineageEmptyChild            = "removing empty lineage entry"
 	ConfigMainbranchInConfigFile       = "please configure the main branch in the config file"
@@ -82,6 +116,13 @@ Please upgrade to the new format: create.new-branch-type = "prototype"`
 	DiffParentNoFeatureBranch       = "you can only diff-parent feature branches"
 	DiffProblem                     = "cannot list diff of %q and %q: %w"
 	DirCurrentProblem               = "cannot determine the current directory"
+	DirReadProblem                  = "cannot read directory %q: %w"
+	DoctorBranchListMissingBranch   = "%s contains the branch %q, which doesn't exist"
+	DoctorFixed                     = "Fixed all problems."
+	DoctorLineageStale              = "the lineage contains a parent for branch %q, which doesn't exist"
+	DoctorNoProblems                = "No problems found."
+	DoctorPerennialAndObserved      = "branch %q is both a perennial and an observed branch"
+	DoctorRunstateUnloadable        = "cannot load the runstate file %q"
 	FeatureRegex                    = "Feature regex: %s\n"
 	FileContentInvalidJSON          = "cannot parse JSON content of file %q: %w"
 	FileDeleteProblem               = "cannot delete file %q: %w"
@@ -117,16 +158,24 @@ END OUTPUT FROM 'git branch -vva'
 	HackBranchIsNowFeature              = "branch %q is now a feature branch\n"
 	HackCannotFeatureMainBranch         = "you are trying to convert the main branch to a feature branch. That's not possible. If you want to create a feature branch, did you forget to add the branch name?"
 	HackCannotFeaturePerennialBranch    = "branch %q is a perennial branch and therefore be a feature branch"
+	HistoryEmpty                        = "The history contains no Git Town commands."
+	HookFailed                          = "the %q hook failed: %w"
+	HostingAzureDevOpsMergingViaAPI     = "Azure DevOps API: merging PR %s ... "
 	HostingBitbucketNotImplemented      = "shipping pull requests via the Bitbucket API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
 	HostingBitbucketMergingViaAPI       = "Bitbucket API: merging PR %s ... "
+	HostingBitbucketOptionUnsupported   = "Bitbucket doesn't support %s when creating proposals through its API"
 	HostingGitlabMergingViaAPI          = "Merging MR !%d ... "
 	HostingGitlabUpdateMRViaAPI         = "Updating target branch for MR !%d to %q ... "
+	HostingGitlabUserNotFound           = "cannot find GitLab user %q"
+	HostingGiteaLabelNotFound           = "cannot find Gitea label %q"
 	HostingGiteaNotImplemented          = "shipping pull requests via the Gitea API is currently not supported. If you need this functionality, please vote for it by opening a ticket at https://github.com/git-town/git-town/issues"
 	HostingGiteaUpdatePRViaAPI          = "Gitea API: Updating base branch for PR #%d to #%s"
 	HostingGithubMergingViaAPI          = "GitHub API: merging PR %s ... "
 	HostingPlatformUnknown              = "unknown hosting platform: %q"
 	InputAddOrRemove                    = `invalid argument %q. Please provide either "add" or "remove"`
 	InputYesOrNo                        = `invalid argument: %q. Please provide either "yes" or "no".\n`
+	JournalLoadProblem                  = "cannot load the history of Git Town commands: %w"
+	JournalSaveProblem                  = "cannot save the history of Git Town commands: %w"
 	DeleteCannotDeleteMainBranch        = "you cannot delete the main branch"
 	DeleteCannotDeletePerennialBranches = "you cannot delete perennial branches"
 	KillDeprecation                     = `DEPRECATION NOTICE
@@ -143,6 +192,15 @@ END OUTPUT FROM 'git branch -vva'
 	MergeOpenChanges                      = "please commit or remove the open changes first"
 	MergeNoGrandParent                    = "cannot merge branch %q because its parent branch (%s) has no parent"
 	MergeNoParent                         = "cannot merge branch %q because it has no parent"
+	MergeTreeUnexpectedOutput             = "unexpected output of git merge-tree: %q"
+	MergeQueueProposalRemoved             = "the merge queue removed proposal #%d without merging it"
+	MergeQueueTimeout                     = "proposal #%d is still in the merge queue after %s,\nrun \"git town continue\" to keep waiting"
+	MoveCommitNoCommits                   = "branch %q has no commits to move"
+	MoveCommitNoFeatureBranch             = "cannot move commits out of branch %q because it is not a feature branch"
+	MoveCommitNoneSelected                = "no commits selected"
+	MoveCommitNoTarget                    = "branch %q has no local ancestor branch to move commits into, Git Town doesn't move commits into the main branch or perennial branches"
+	MoveCommitTargetSelection             = "Selected target branch: %s\n"
+	MoveCommitsSelection                  = "Selected commits: %s\n"
 	ObservedBranchCannotPark              = "cannot park observed branches"
 	ObservedBranchCannotPropose           = "cannot propose observed branches"
 	ObservedBranchCannotShip              = "cannot ship observed branches"
@@ -152,8 +210,20 @@ END OUTPUT FROM 'git branch -vva'
 	OpcodeUnknown                         = "unknown opcode: %q, run \"git town status reset\" to reset it"
 	OpenChangesProblem                    = "cannot determine open changes: %w"
 	OriginHostname                        = "Origin hostname: %s\n"
+	OutboxIntro                           = "Pending actions at the code hosting platform, the next \"git town sync\" performs them:"
+	OutboxPathProblem                     = "cannot determine the outbox file path: %w"
+	OutboxProposalMerge                   = "merge the proposal of branch %q into %q"
+	OutboxProposalNotFound                = "cannot find a proposal of branch %q into %q"
+	OutboxProposalRetarget                = "change the target of the proposal of branch %q from %q to %q"
+	OutboxQueued                          = "Git Town is offline and performs the changes at the code hosting platform during the next online \"git town sync\".\nThat sync also removes branch %q from the local repository."
+	OutboxReplayProblem                   = "Cannot %s: %v\nGit Town tries again during the next sync."
+	OutboxSerializeProblem                = "cannot encode the outbox: %w"
+	OutboxTrackingBranchDelete            = "delete the tracking branch of %q"
+	OutputFormatUnknown                   = "unknown output format: %q"
 	ParentDialogSelected                  = "Selected parent branch for %q: %s\n"
+	ParentInferred                        = "Inferred parent branch for %q: %s\n"
 	ParkedBranchIsNowParked               = "branch %q is now parked\n"
+	PatchFileWriteProblem                 = "cannot write patch file %q: %w"
 	PerennialBranchCannotMakeContribution = "cannot make perennial branches contribution branches"
 	PerennialBranchCannotObserve          = "cannot observe perennial branches"
 	PerennialBranchCannotPark             = "cannot park perennial branches"
@@ -165,6 +235,10 @@ END OUTPUT FROM 'git branch -vva'
 	PerennialRegex                        = "Perennial regex: %s\n"
 	PreviousCommandFinished               = "The previous Git Town command (%s) finished successfully.\n"
 	PreviousCommandProblem                = "The last Git Town command (%s) hit a problem %v ago.\n"
+	ProposalAPIOptionsWithoutAPI          = "the --draft, --assignee, --label, and --reviewer flags require --api"
+	ProposalAPIUnsupported                = "cannot create proposals through the API of this code hosting platform, please make sure Git Town has an API token for it"
+	ProposalCreateIncomplete              = "created proposal #%d, but could not add all reviewers, assignees, and labels to it: %v"
+	ProposalExists                        = "branch %q already has a proposal: %s\n"
 	ProposalMultipleFromToFound           = "found %d proposals from branch %q to branch %q"
 	ProposalMultipleFromFound             = "found %d proposals for branch %q"
 	ProposalNoNumberGiven                 = "no proposal number given"
@@ -208,6 +282,9 @@ and will be removed in future versions of Git Town.`
 	SettingCannotWrite            = "ERROR: cannot write %s Git setting %q: %v"
 	SettingIgnoreInvalid          = "Notice: ignoring invalid dialog input setting %q\n"
 	SettingSunsetDeleted          = "Deleting obsolete setting %q"
+	SharedMetadataConflict        = "Branch %q has the %s %s locally and %s in the shared metadata, keeping the local value."
+	SharedMetadataInvalid         = "the branch metadata in %s is invalid: %w"
+	SharedMetadataPushProblem     = "Cannot share the branch metadata because somebody else has shared metadata in the meantime. Please run \"git town sync\" again."
 	ShipBranchIsInOtherWorktree   = "branch %q is checked out in another worktree, please ship from there"
 	ShipBranchNotInSync           = "branch %q is not in sync"
 	ShipAbortedMergeError         = "aborted because merge exited with error"
@@ -220,9 +297,17 @@ and will be removed in future versions of Git Town.`
 	ShipDeletesTrackingBranches   = "Ship deletes tracking branches: %s\n"
 	ShipAPINoProposal             = "cannot ship branch %q via API because it has no proposal"
 	ShipAPINoRemoteBranch         = "cannot ship branch %q via API because it has no remote branch"
+	ShipMergeQueueEnqueued        = "Proposal #%d is now in the merge queue. Once the merge queue has merged it, run \"git town sync\" to remove branch %q."
+	ShipMergeQueueUnavailable     = "cannot add the proposal to the merge queue while the code hosting platform is unavailable"
+	ShipMergeQueueUnsupported     = "the Git Town driver for your code hosting platform does not support merge queues"
 	ShipMessageWithFastForward    = "shipping with the fast-forward strategy does not use the given commit message"
+	ShipMessageWithMergeQueue     = "shipping with the merge-queue strategy does not use the given commit message"
+	ShipMessageWithRebaseMerge    = "shipping with the rebase-merge strategy does not use the given commit message"
 	ShipOpenChanges               = "you have uncommitted changes. Did you mean to commit them before shipping?"
+	ShipStackMessage              = "cannot use a single commit message when shipping an entire stack"
+	ShipStackMergeQueue           = "cannot ship an entire stack via the merge queue, please ship one branch at a time"
 	ShipStrategyMissing           = "no ship strategy provided"
+	ShipWaitWithoutMergeQueue     = "the --wait flag requires the merge-queue ship strategy"
 	ShippableChangesProblem       = "cannot determine whether branch %q has shippable changes: %w"
 	SkipBranchHasConflicts        = "cannot skip branch that resulted in conflicts"
 	SkipMessage                   = `You can run "git town skip" to skip the currently failing operation.`
@@ -230,27 +315,47 @@ and will be removed in future versions of Git Town.`
 	SkipNoInitialBranchInfo       = "found no information about branch %q in the initial snapshot"
 	SkipNoFinalBranchInfo         = "found no information about branch %q in the final snapshot"
 	SkipNoFinalSnapshot           = "found no final snapshot"
+	SplitAtMissing                = "please provide the last commit of the new parent branch via --at"
+	SplitAtLastCommit             = "cannot split branch %q at its last commit because that would leave it empty"
+	SplitCommitAmbiguous          = "commit %q matches multiple commits in branch %q"
+	SplitCommitNotInBranch        = "commit %q is not part of branch %q"
+	SplitNoFeatureBranch          = "cannot split branch %q because it is not a feature branch"
 	SquashCannotReadFile          = "cannot read squash message file %q: %w"
 	SquashCommitAuthorQuery       = "Please choose an author for the squash commit:"
 	SquashCommitAuthorProblem     = "error getting squash commit author: %w"
 	SquashCommitAuthorSelection   = "Selected squash commit author: %s\n"
+	SquashCommitTemplateInvalid   = "invalid squash commit template in %s: %w"
+	SquashCommitTemplateRender    = "cannot render the squash commit template: %w"
 	SquashMessageProblem          = "cannot comment out the squash commit message: %w"
+	SquashMessagePrefillProblem   = "cannot prefill the squash commit message: %w"
+	StackNoBranches               = "no branches to display"
 	StatusFileNotFound            = "No status file found for this repository."
 	SwitchNoBranches              = "no branches to switch to"
 	SwitchUncommittedChanges      = "uncommitted changes"
+	SyncCheckConflicts            = "syncing would cause merge conflicts"
+	SyncCheckGitVersionTooLow     = "\"git town sync --check\" requires Git 2.38 or higher"
+	SyncCheckHasConflicts         = "Branch %q: conflicts with %q in %s\n"
+	SyncCheckNoConflicts          = "Branch %q: no conflicts\n"
+	SyncCheckParentConflicts      = "Branch %q: not checked because its parent branch %q has conflicts\n"
 	SyncFeatureBranches           = "Sync feature branches: %s\n"
 	SyncPerennialBranches         = "Sync perennial branches: %s\n"
 	SyncPrototypeBranches         = "Sync prototype branches: %s\n"
+	SyncShippedBranchesDelete     = "Delete shipped branches: %s\n"
 	SyncStatusNotRecognized       = "cannot determine the sync status for Git remote %q and branch name %q"
 	SyncTags                      = "Sync tags: %s\n"
 	SyncWithUpstream              = "Sync with upstream: %s\n"
+	SyncWorktreeOpenChanges       = "Did not sync branch %q because its worktree at %s has uncommitted changes."
 	UndoCreateOpcodeProblem       = "cannot create undo operations for %q: %w"
 	UndoMessage                   = `You can run "git town undo" to go back to where you started.`
 	UndoNothingToDo               = "nothing to undo"
+	UndoToInconsistent            = "cannot undo %d commands because the branches have changed outside of Git Town between the %q and %q commands"
+	UndoToOutOfRange              = "cannot undo %d commands because the history contains only %d commands"
+	UndoToUnfinished              = `cannot undo several commands while the %q command is unfinished. Please run "git town continue", "git town skip", or "git town undo" first.`
 	UnfinishedCommandHandle       = "Handle unfinished command: %s\n"
 	UnfinishedRunStateContinue    = "Continue the \"%s\" command after having resolved conflicts"
 	UnfinishedRunStateDiscard     = "Discard the unfinished state and run the new command"
 	UnfinishedRunStateQuit        = "Quit without running anything"
 	UnfinishedRunStateSkip        = "Skip the current branch and continue the \"%s\" command on the next branch"
 	UnfinishedRunStateUndo        = "Undo the previous \"%s\" command"
+	WorktreeRunnerUnsupported     = "cannot run commands in the worktree at %s"
 )
@@ -91,9 +91,14 @@ func removeOpcodesForCurrentBranch(prog program.Program) program.Program {
 }
 
 func revertChangesToCurrentBranch(args ExecuteArgs) error {
-	before := args.RunState.BeginBranchesSnapshot.Branches.FindByLocalName(args.InitialBranch)
+	currentBranch := args.InitialBranch
+	if worktree, inWorktree := args.RunState.Worktree.Get(); inWorktree {
+		// the skipped branch is active in another worktree
+		currentBranch = worktree.Branch
+	}
+	before := args.RunState.BeginBranchesSnapshot.Branches.FindByLocalName(currentBranch)
 	if before.IsNone() {
-		return fmt.Errorf(messages.SkipNoInitialBranchInfo, args.InitialBranch)
+		return fmt.Errorf(messages.SkipNoInitialBranchInfo, currentBranch)
 	}
 	afterSnapshot, hasAfterSnapshot := args.RunState.EndBranchesSnapshot.Get()
 	if !hasAfterSnapshot {
@@ -102,7 +107,7 @@ func revertChangesToCurrentBranch(args ExecuteArgs) error {
 	spans := undobranches.BranchSpans{
 		undobranches.BranchSpan{
 			Before: before.ToOption(),
-			After:  afterSnapshot.Branches.FindByLocalName(args.InitialBranch).ToOption(),
+			After:  afterSnapshot.Branches.FindByLocalName(currentBranch).ToOption(),
 		},
 	}
 	undoCurrentBranchProgram := spans.Changes().UndoProgram(undobranches.BranchChangesUndoProgramArgs{
@@ -111,6 +116,7 @@ func revertChangesToCurrentBranch(args ExecuteArgs) error {
 		EndBranch:                args.InitialBranch,
 		UndoAPIProgram:           args.RunState.UndoAPIProgram,
 		UndoablePerennialCommits: args.RunState.UndoablePerennialCommits,
+		Worktrees:                args.RunState.Worktrees,
 	})
 	lightInterpreter.Execute(lightInterpreter.ExecuteArgs{
 		Backend:       args.Backend,
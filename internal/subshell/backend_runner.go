@@ -11,6 +11,7 @@ import (
 	"github.com/acarl005/stripansi"
 	"github.com/git-town/git-town/v17/internal/cli/colors"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/gohacks"
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v17/internal/messages"
@@ -27,6 +28,12 @@ type BackendRunner struct {
 	Verbose configdomain.Verbose
 }
 
+// InDir provides a copy of this runner that executes commands in the given directory.
+func (self BackendRunner) InDir(dir string) gitdomain.RunnerQuerier { //nolint:ireturn
+	self.Dir = Some(dir)
+	return self
+}
+
 func (self BackendRunner) Query(executable string, args ...string) (string, error) {
 	return self.execute(executable, args...)
 }
@@ -18,6 +18,14 @@ type FrontendDryRunner struct {
 	PrintCommands    bool
 }
 
+// InDir provides a copy of this runner that prints the branch names of the given directory,
+// using the given backend runner for that directory.
+func (self *FrontendDryRunner) InDir(backend gitdomain.Querier, _ string) gitdomain.Runner { //nolint:ireturn
+	result := *self
+	result.Backend = backend
+	return &result
+}
+
 // Run runs the given command in this ShellRunner's directory.
 func (self *FrontendDryRunner) Run(executable string, args ...string) error {
 	var currentBranch gitdomain.LocalBranchName
@@ -23,6 +23,7 @@ import (
 type FrontendRunner struct {
 	Backend          gitdomain.Querier
 	CommandsCounter  Mutable[gohacks.Counter]
+	Dir              Option[string] // if set, runs the commands in the given directory instead of the current working directory
 	GetCurrentBranch GetCurrentBranchFunc
 	PrintBranchNames bool
 	PrintCommands    bool
@@ -51,6 +52,15 @@ func FormatCommand(currentBranch gitdomain.LocalBranchName, printBranch bool, ex
 	return result
 }
 
+// InDir provides a copy of this runner that executes commands in the given directory,
+// using the given backend runner for that directory.
+func (self *FrontendRunner) InDir(backend gitdomain.Querier, dir string) gitdomain.Runner { //nolint:ireturn
+	result := *self
+	result.Backend = backend
+	result.Dir = Some(dir)
+	return &result
+}
+
 // PrintCommand prints the given command-line operation on the console.
 func PrintCommand(branch gitdomain.LocalBranchName, printBranch bool, cmd string, args ...string) {
 	header := FormatCommand(branch, printBranch, cmd, args...)
@@ -78,6 +88,9 @@ func (self *FrontendRunner) Run(cmd string, args ...string) (err error) {
 	concurrentGitRetriesLeft := concurrentGitRetries
 	for {
 		subProcess := exec.Command(cmd, args...)
+		if dir, hasDir := self.Dir.Get(); hasDir {
+			subProcess.Dir = dir
+		}
 		var stderrBuffer bytes.Buffer // we only need to look at STDERR since that's where Git will print error messages
 		subProcess.Stderr = io.MultiWriter(os.Stderr, &stderrBuffer)
 		subProcess.Stdin = os.Stdin
@@ -45,6 +45,12 @@ func Execute(args ExecuteArgs) error {
 	if err != nil {
 		return fmt.Errorf(messages.RunstateDeleteProblem, err)
 	}
+	if args.RunState.IsFinished() {
+		// the journal contains only finished commands
+		if err = removeFromJournal(args.RootDir, 1); err != nil {
+			return err
+		}
+	}
 	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
 	return nil
 }
@@ -0,0 +1,89 @@
+package undo
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/cli/print"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/undo/undobranches"
+	lightInterpreter "github.com/git-town/git-town/v17/internal/vm/interpreter/light"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/git-town/git-town/v17/internal/vm/statefile"
+)
+
+// ExecuteJournal undoes the given number of most recent commands in the journal,
+// the most recent command first.
+func ExecuteJournal(args ExecuteArgs, count int) error {
+	journal, err := statefile.LoadJournal(args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalLoadProblem, err)
+	}
+	if count < 1 || count > len(journal) {
+		return fmt.Errorf(messages.UndoToOutOfRange, count, len(journal))
+	}
+	entries := journal.MostRecent(count)
+	if err = verifyJournalEntriesConsecutive(entries); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		program := CreateUndoForFinishedProgram(CreateUndoProgramArgs{
+			Backend:        args.Backend,
+			Config:         args.Config,
+			DryRun:         entry.RunState.DryRun,
+			Git:            args.Git,
+			HasOpenChanges: args.HasOpenChanges,
+			NoPushHook:     args.Config.NormalConfig.NoPushHook(),
+			RunState:       entry.RunState,
+		})
+		lightInterpreter.Execute(lightInterpreter.ExecuteArgs{
+			Backend:       args.Backend,
+			Config:        args.Config,
+			Connector:     args.Connector,
+			FinalMessages: args.FinalMessages,
+			Frontend:      args.Frontend,
+			Git:           args.Git,
+			Prog:          program,
+		})
+	}
+	err = statefile.Delete(args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.RunstateDeleteProblem, err)
+	}
+	if err = removeFromJournal(args.RootDir, count); err != nil {
+		return err
+	}
+	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
+	return nil
+}
+
+// removes the given number of most recent commands from the journal of the given repo
+func removeFromJournal(rootDir gitdomain.RepoRootDir, count int) error {
+	journal, err := statefile.LoadJournal(rootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalLoadProblem, err)
+	}
+	err = statefile.SaveJournal(journal.RemoveMostRecent(count), rootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalSaveProblem, err)
+	}
+	return nil
+}
+
+// Undoing a command resets its branches to the state before the command ran.
+// This is only safe if the branches didn't change in inconsistent ways between the given journal entries,
+// i.e. if every command started with the branches in the state that the command before it left them.
+func verifyJournalEntriesConsecutive(entries []runstate.JournalEntry) error {
+	for e := 1; e < len(entries); e++ {
+		newer, older := entries[e-1], entries[e]
+		olderEnd, hasOlderEnd := older.RunState.EndBranchesSnapshot.Get()
+		if !hasOlderEnd {
+			continue
+		}
+		changes := undobranches.NewBranchSpans(olderEnd, newer.RunState.BeginBranchesSnapshot).Changes()
+		if len(changes.InconsistentlyChanged) > 0 {
+			return fmt.Errorf(messages.UndoToInconsistent, len(entries), older.RunState.Command, newer.RunState.Command)
+		}
+	}
+	return nil
+}
@@ -1,8 +1,6 @@
 package undobranches
 
 import (
-	"slices"
-
 	"github.com/git-town/git-town/v17/internal/config"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/undo/undodomain"
@@ -61,19 +59,21 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// revert omni-changed perennial branches
 	for _, branch := range omniChangedPerennials.BranchNames() {
 		change := omniChangedPerennials[branch]
-		if slices.Contains(args.UndoablePerennialCommits, change.After) {
-			result.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
-			result.Add(&opcodes.CommitRevertIfNeeded{SHA: change.After})
-			result.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branch})
+		if args.UndoablePerennialCommits.Contains(branch, change.After) {
+			branchProgram := program.Program{}
+			revertUndoablePerennialCommits(&branchProgram, args.UndoablePerennialCommits.OnBranch(branch))
+			branchProgram.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branch})
+			addBranchProgram(&result, branch, branchProgram, args.Worktrees)
 		}
 	}
 
 	// reset omni-changed feature branches
 	for _, branch := range omniChangedFeatures.BranchNames() {
 		change := omniChangedFeatures[branch]
-		result.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
-		result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
-		result.Add(&opcodes.PushCurrentBranchForceIfNeeded{ForceIfIncludes: true})
+		addBranchProgram(&result, branch, program.Program{
+			&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true},
+			&opcodes.PushCurrentBranchForceIfNeeded{ForceIfIncludes: true},
+		}, args.Worktrees)
 	}
 
 	// re-create removed omni-branches
@@ -88,10 +88,11 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset inconsintently changed perennial branches
 	for _, inconsistentlyChangedPerennial := range inconsistentlyChangedPerennials {
 		if isOmni, branchName, afterSHA := inconsistentlyChangedPerennial.After.IsOmniBranch(); isOmni {
-			if slices.Contains(args.UndoablePerennialCommits, afterSHA) {
-				result.Add(&opcodes.CheckoutIfNeeded{Branch: branchName})
-				result.Add(&opcodes.CommitRevertIfNeeded{SHA: afterSHA})
-				result.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branchName})
+			if args.UndoablePerennialCommits.Contains(branchName, afterSHA) {
+				branchProgram := program.Program{}
+				revertUndoablePerennialCommits(&branchProgram, args.UndoablePerennialCommits.OnBranch(branchName))
+				branchProgram.Add(&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: branchName})
+				addBranchProgram(&result, branchName, branchProgram, args.Worktrees)
 			}
 		}
 	}
@@ -102,12 +103,13 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 		hasBeforeRemote, beforeRemoteName, beforeRemoteSHA := inconsistentChange.Before.GetRemoteBranch()
 		hasAfterSHAs, afterLocalSHA, afterRemoteSHA := inconsistentChange.After.GetSHAs()
 		if hasBeforeLocal && hasBeforeRemote && hasAfterSHAs {
-			result.Add(&opcodes.CheckoutIfNeeded{Branch: beforeLocalName})
-			result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{
-				MustHaveSHA: afterLocalSHA,
-				SetToSHA:    beforeLocalSHA,
-				Hard:        true,
-			})
+			addBranchProgram(&result, beforeLocalName, program.Program{
+				&opcodes.BranchCurrentResetToSHAIfNeeded{
+					MustHaveSHA: afterLocalSHA,
+					SetToSHA:    beforeLocalSHA,
+					Hard:        true,
+				},
+			}, args.Worktrees)
 			result.Add(&opcodes.BranchRemoteSetToSHAIfNeeded{
 				Branch:      beforeRemoteName,
 				MustHaveSHA: afterRemoteSHA,
@@ -129,8 +131,9 @@ func (self BranchChanges) UndoProgram(args BranchChangesUndoProgramArgs) program
 	// reset locally changed branches
 	for _, localBranch := range self.LocalChanged.BranchNames() {
 		change := self.LocalChanged[localBranch]
-		result.Add(&opcodes.CheckoutIfNeeded{Branch: localBranch})
-		result.Add(&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true})
+		addBranchProgram(&result, localBranch, program.Program{
+			&opcodes.BranchCurrentResetToSHAIfNeeded{MustHaveSHA: change.After, SetToSHA: change.Before, Hard: true},
+		}, args.Worktrees)
 	}
 
 	// re-create locally removed branches
@@ -187,5 +190,28 @@ type BranchChangesUndoProgramArgs struct {
 	Config                   config.ValidatedConfig
 	EndBranch                gitdomain.LocalBranchName
 	UndoAPIProgram           program.Program
-	UndoablePerennialCommits []gitdomain.SHA
+	UndoablePerennialCommits gitdomain.UndoableCommits
+	Worktrees                gitdomain.Worktrees // the other worktrees in which the Git Town command changed branches
+}
+
+// adds the given program that changes the given branch to the given result program.
+// The program runs in the worktree of the given branch if it is active in another worktree,
+// otherwise it checks out the branch.
+func addBranchProgram(result *program.Program, branch gitdomain.LocalBranchName, branchProgram program.Program, worktrees gitdomain.Worktrees) {
+	if worktree, inWorktree := worktrees.FindByBranch(branch).Get(); inWorktree {
+		result.Add(&opcodes.WorktreeEnter{Worktree: worktree})
+		result.AddProgram(branchProgram)
+		result.Add(&opcodes.WorktreeLeave{})
+		return
+	}
+	result.Add(&opcodes.CheckoutIfNeeded{Branch: branch})
+	result.AddProgram(branchProgram)
+}
+
+// adds opcodes that revert the given undoable commits on the current perennial branch, newest first.
+// Commands like "ship --stack" can create several of them.
+func revertUndoablePerennialCommits(prog *program.Program, undoablePerennialCommits gitdomain.SHAs) {
+	for i := len(undoablePerennialCommits) - 1; i >= 0; i-- {
+		prog.Add(&opcodes.CommitRevertIfNeeded{SHA: undoablePerennialCommits[i]})
+	}
 }
@@ -81,7 +81,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("main")},
@@ -141,7 +142,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.BranchCreate{
@@ -236,7 +238,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -256,6 +259,83 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("local-only branch changed in another worktree", func(t *testing.T) {
+		t.Parallel()
+		before := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  Some(gitdomain.NewLocalBranchName("feature-branch")),
+					LocalSHA:   Some(gitdomain.NewSHA("111111")),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+				// a branch checked out in another worktree
+				gitdomain.BranchInfo{
+					LocalName:  Some(gitdomain.NewLocalBranchName("worktree-branch")),
+					LocalSHA:   Some(gitdomain.NewSHA("222222")),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+			},
+			Active: Some(gitdomain.NewLocalBranchName("feature-branch")),
+		}
+		after := gitdomain.BranchesSnapshot{
+			Branches: gitdomain.BranchInfos{
+				gitdomain.BranchInfo{
+					LocalName:  Some(gitdomain.NewLocalBranchName("feature-branch")),
+					LocalSHA:   Some(gitdomain.NewSHA("111111")),
+					SyncStatus: gitdomain.SyncStatusLocalOnly,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+				gitdomain.BranchInfo{
+					LocalName:  Some(gitdomain.NewLocalBranchName("worktree-branch")),
+					LocalSHA:   Some(gitdomain.NewSHA("333333")),
+					SyncStatus: gitdomain.SyncStatusOtherWorktree,
+					RemoteName: None[gitdomain.RemoteBranchName](),
+					RemoteSHA:  None[gitdomain.SHA](),
+				},
+			},
+			Active: Some(gitdomain.NewLocalBranchName("feature-branch")),
+		}
+		haveChanges := undobranches.NewBranchSpans(before, after).Changes()
+		config := config.ValidatedConfig{
+			ValidatedConfigData: configdomain.ValidatedConfigData{
+				MainBranch: "main",
+			},
+			NormalConfig: config.NormalConfig{
+				NormalConfigData: configdomain.NormalConfigData{
+					Lineage: configdomain.NewLineageWith(configdomain.LineageData{
+						"feature-branch":  "main",
+						"worktree-branch": "main",
+					}),
+					PushHook: false,
+				},
+			},
+		}
+		worktree := gitdomain.Worktree{Branch: "worktree-branch", Dir: "/worktree"}
+		haveProgram := haveChanges.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch:              before.Active.GetOrPanic(),
+			Config:                   config,
+			EndBranch:                after.Active.GetOrPanic(),
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{worktree},
+		})
+		wantProgram := program.Program{
+			&opcodes.WorktreeEnter{Worktree: worktree},
+			&opcodes.BranchCurrentResetToSHAIfNeeded{
+				MustHaveSHA: gitdomain.NewSHA("333333"),
+				SetToSHA:    gitdomain.NewSHA("222222"),
+				Hard:        true,
+			},
+			&opcodes.WorktreeLeave{},
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("feature-branch")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("local-only branch pushed to origin", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -332,7 +412,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.BranchTrackingDelete{
@@ -422,7 +503,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.BranchLocalDelete{Branch: gitdomain.NewLocalBranchName("perennial-branch")},
@@ -496,7 +578,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.BranchLocalDelete{Branch: gitdomain.NewLocalBranchName("perennial-branch")},
@@ -595,7 +678,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -732,7 +816,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// It doesn't reset the remote perennial branch since those are assumed to be protected against force-pushes
@@ -847,9 +932,10 @@ func TestChanges(t *testing.T) {
 			BeginBranch: before.Active.GetOrPanic(),
 			Config:      config,
 			EndBranch:   after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{
-				gitdomain.NewSHA("444444"),
+			UndoablePerennialCommits: gitdomain.UndoableCommits{
+				{Branch: gitdomain.NewLocalBranchName("main"), SHA: gitdomain.NewSHA("444444")},
 			},
+			Worktrees: gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// revert the commit on the perennial branch
@@ -866,6 +952,67 @@ func TestChanges(t *testing.T) {
 		must.Eq(t, wantProgram, haveProgram)
 	})
 
+	t.Run("several perennial branches changed", func(t *testing.T) {
+		t.Parallel()
+		changes := undobranches.BranchChanges{
+			LocalAdded:    gitdomain.LocalBranchNames{},
+			LocalRemoved:  undobranches.LocalBranchesSHAs{},
+			LocalChanged:  undobranches.LocalBranchChange{},
+			RemoteAdded:   gitdomain.RemoteBranchNames{},
+			RemoteRemoved: undobranches.RemoteBranchesSHAs{},
+			RemoteChanged: map[gitdomain.RemoteBranchName]undodomain.Change[gitdomain.SHA]{},
+			OmniRemoved:   undobranches.LocalBranchesSHAs{},
+			OmniChanged: undobranches.LocalBranchChange{
+				gitdomain.NewLocalBranchName("main"): {
+					Before: gitdomain.NewSHA("111111"),
+					After:  gitdomain.NewSHA("444444"),
+				},
+				gitdomain.NewLocalBranchName("qa"): {
+					Before: gitdomain.NewSHA("222222"),
+					After:  gitdomain.NewSHA("555555"),
+				},
+			},
+			InconsistentlyChanged: undodomain.InconsistentChanges{},
+		}
+		config := config.ValidatedConfig{
+			ValidatedConfigData: configdomain.ValidatedConfigData{
+				MainBranch: "main",
+			},
+			NormalConfig: config.NormalConfig{
+				NormalConfigData: configdomain.NormalConfigData{
+					Lineage:           configdomain.NewLineage(),
+					PerennialBranches: gitdomain.NewLocalBranchNames("qa"),
+					PushHook:          false,
+				},
+			},
+		}
+		haveProgram := changes.UndoProgram(undobranches.BranchChangesUndoProgramArgs{
+			BeginBranch: gitdomain.NewLocalBranchName("main"),
+			Config:      config,
+			EndBranch:   gitdomain.NewLocalBranchName("main"),
+			UndoablePerennialCommits: gitdomain.UndoableCommits{
+				{Branch: gitdomain.NewLocalBranchName("main"), SHA: gitdomain.NewSHA("333333")},
+				{Branch: gitdomain.NewLocalBranchName("qa"), SHA: gitdomain.NewSHA("555555")},
+				{Branch: gitdomain.NewLocalBranchName("main"), SHA: gitdomain.NewSHA("444444")},
+			},
+			Worktrees: gitdomain.Worktrees{},
+		})
+		wantProgram := program.Program{
+			// revert the undoable commits on the main branch
+			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("main")},
+			&opcodes.CommitRevertIfNeeded{SHA: gitdomain.NewSHA("444444")},
+			&opcodes.CommitRevertIfNeeded{SHA: gitdomain.NewSHA("333333")},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: gitdomain.NewLocalBranchName("main")},
+			// revert the undoable commit on the qa branch
+			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("qa")},
+			&opcodes.CommitRevertIfNeeded{SHA: gitdomain.NewSHA("555555")},
+			&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: gitdomain.NewLocalBranchName("qa")},
+			// check out the initial branch
+			&opcodes.CheckoutIfExists{Branch: gitdomain.NewLocalBranchName("main")},
+		}
+		must.Eq(t, wantProgram, haveProgram)
+	})
+
 	t.Run("upstream commit downloaded and branch shipped at the same time", func(t *testing.T) {
 		t.Parallel()
 		before := gitdomain.BranchesSnapshot{
@@ -953,9 +1100,10 @@ func TestChanges(t *testing.T) {
 			BeginBranch: before.Active.GetOrPanic(),
 			Config:      config,
 			EndBranch:   after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{
-				gitdomain.NewSHA("444444"),
+			UndoablePerennialCommits: gitdomain.UndoableCommits{
+				{Branch: gitdomain.NewLocalBranchName("main"), SHA: gitdomain.NewSHA("444444")},
 			},
+			Worktrees: gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// revert the undoable commit on the main branch
@@ -1077,7 +1225,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// It doesn't revert the perennial branch because it cannot force-push the changes to the remote branch.
@@ -1179,7 +1328,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.CheckoutIfNeeded{Branch: gitdomain.NewLocalBranchName("feature-branch")},
@@ -1281,7 +1431,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// It doesn't revert the remote perennial branch because it cannot force-push the changes to it.
@@ -1371,7 +1522,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			&opcodes.BranchCreate{
@@ -1463,7 +1615,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrDefault(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// don't re-create the tracking branch for the perennial branch
@@ -1550,7 +1703,8 @@ func TestChanges(t *testing.T) {
 			BeginBranch:              before.Active.GetOrPanic(),
 			Config:                   config,
 			EndBranch:                after.Active.GetOrPanic(),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktrees:                gitdomain.Worktrees{},
 		})
 		wantProgram := program.Program{
 			// No changes should happen here since all changes were syncs on perennial branches.
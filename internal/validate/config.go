@@ -48,11 +48,15 @@ func Config(args ConfigArgs) (config.ValidatedConfig, bool, error) {
 
 	// enter and save missing parent branches
 	additionalLineage, additionalPerennials, exit, err := dialog.Lineage(dialog.LineageArgs{
+		AheadBehind: func(branch, candidate gitdomain.LocalBranchName) (int, int, error) {
+			return args.Git.AheadBehindLocal(args.Backend, branch, candidate)
+		},
 		BranchesAndTypes:  args.BranchesAndTypes,
 		BranchesToVerify:  args.BranchesToValidate,
 		Connector:         args.Connector,
 		DefaultChoice:     mainBranch,
 		DialogTestInputs:  args.TestInputs,
+		InferParents:      args.Unvalidated.Value.NormalConfig.InferParents,
 		Lineage:           args.Unvalidated.Value.NormalConfig.Lineage,
 		LocalBranches:     args.LocalBranches,
 		MainBranch:        mainBranch,
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/git-town/git-town/v17/internal/cli/print"
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/config/gitconfig"
@@ -8,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/gohacks"
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
+	"github.com/git-town/git-town/v17/internal/messages"
 	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	"github.com/git-town/git-town/v17/internal/vm/runstate"
@@ -51,12 +54,20 @@ func Finished(args FinishedArgs) error {
 		FinalUndoProgram:         program.Program{},
 		RunProgram:               program.Program{},
 		TouchedBranches:          args.TouchedBranches,
-		UndoablePerennialCommits: gitdomain.SHAs{},
+		UndoablePerennialCommits: gitdomain.UndoableCommits{},
 		UndoAPIProgram:           program.Program{},
 		UnfinishedDetails:        MutableNone[runstate.UnfinishedRunStateDetails](),
 	}
 	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
-	return statefile.Save(runState, args.RootDir)
+	err = statefile.Save(runState, args.RootDir)
+	if err != nil {
+		return err
+	}
+	err = statefile.AddToJournal(runState, args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalSaveProblem, err)
+	}
+	return nil
 }
 
 type FinishedArgs struct {
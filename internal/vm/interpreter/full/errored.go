@@ -8,6 +8,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/config/gitconfig"
 	"github.com/git-town/git-town/v17/internal/messages"
 	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
+	"github.com/git-town/git-town/v17/internal/vm/opcodes"
 	"github.com/git-town/git-town/v17/internal/vm/shared"
 	"github.com/git-town/git-town/v17/internal/vm/statefile"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
@@ -38,7 +39,15 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 		return err
 	}
 	args.RunState.EndStashSize = Some(endStashSize)
-	args.RunState.AbortProgram.Add(failedOpcode.AbortProgram()...)
+	abortProgram := failedOpcode.AbortProgram()
+	if worktree, inWorktree := args.RunState.Worktree.Get(); inWorktree && len(abortProgram) > 0 {
+		// the failed opcode ran in another worktree, so its abort program must run there as well
+		args.RunState.AbortProgram.Add(&opcodes.WorktreeEnter{Worktree: worktree})
+		args.RunState.AbortProgram.Add(abortProgram...)
+		args.RunState.AbortProgram.Add(&opcodes.WorktreeLeave{})
+	} else {
+		args.RunState.AbortProgram.Add(abortProgram...)
+	}
 	if failedOpcode.ShouldUndoOnError() {
 		return autoUndo(failedOpcode, runErr, args)
 	}
@@ -51,11 +60,15 @@ func errored(failedOpcode shared.Opcode, runErr error, args ExecuteArgs) error {
 	if err != nil {
 		return err
 	}
-	currentBranch, err := args.Git.CurrentBranch(args.Backend)
+	backend, _, err := shared.RunnersInWorktree(args.Backend, args.Frontend, args.RunState.Worktree)
+	if err != nil {
+		return err
+	}
+	currentBranch, err := args.Git.CurrentBranch(backend)
 	if err != nil {
 		return err
 	}
-	repoStatus, err := args.Git.RepoStatus(args.Backend)
+	repoStatus, err := args.Git.RepoStatus(backend)
 	if err != nil {
 		return err
 	}
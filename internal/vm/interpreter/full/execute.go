@@ -17,6 +17,10 @@ import (
 
 // Execute runs the commands in the given runstate.
 func Execute(args ExecuteArgs) error {
+	if args.RunState.Worktree.IsSome() {
+		// resuming in another worktree, the cached current branch belongs to this worktree
+		args.Git.CurrentBranchCache.Invalidate()
+	}
 	for {
 		nextStep := args.RunState.RunProgram.Pop()
 		if nextStep == nil {
@@ -35,17 +39,22 @@ func Execute(args ExecuteArgs) error {
 			args.RunState.SkipCurrentBranchProgram()
 			continue
 		}
-		err := nextStep.Run(shared.RunArgs{
-			Backend:                         args.Backend,
+		backend, frontend, err := shared.RunnersInWorktree(args.Backend, args.Frontend, args.RunState.Worktree)
+		if err != nil {
+			return err
+		}
+		err = nextStep.Run(shared.RunArgs{
+			Backend:                         backend,
 			BranchInfos:                     Some(args.InitialBranchesSnapshot.Branches),
 			Config:                          NewMutable(&args.Config),
 			Connector:                       args.Connector,
 			DialogTestInputs:                args.DialogTestInputs,
 			FinalMessages:                   args.FinalMessages,
-			Frontend:                        args.Frontend,
+			Frontend:                        frontend,
 			Git:                             args.Git,
 			PrependOpcodes:                  args.RunState.RunProgram.Prepend,
 			RegisterUndoablePerennialCommit: args.RunState.RegisterUndoablePerennialCommit,
+			SetWorktree:                     args.RunState.SetWorktree,
 			UpdateInitialSnapshotLocalSHA:   args.InitialBranchesSnapshot.Branches.UpdateLocalSHA,
 		})
 		if err != nil {
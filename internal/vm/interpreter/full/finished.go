@@ -50,6 +50,10 @@ func finished(args finishedArgs) error {
 	if err != nil {
 		return fmt.Errorf(messages.RunstateSaveProblem, err)
 	}
+	err = statefile.AddToJournal(args.RunState, args.RootDir)
+	if err != nil {
+		return fmt.Errorf(messages.JournalSaveProblem, err)
+	}
 	print.Footer(args.Verbose, args.CommandsCounter.Immutable(), args.FinalMessages.Result())
 	return nil
 }
@@ -16,22 +16,32 @@ import (
 )
 
 func Execute(args ExecuteArgs) {
+	worktree := None[gitdomain.Worktree]()
+	setWorktree := func(newWorktree Option[gitdomain.Worktree]) {
+		worktree = newWorktree
+	}
 	for {
 		nextStep := args.Prog.Pop()
 		if nextStep == nil {
 			return
 		}
-		err := nextStep.Run(shared.RunArgs{
-			Backend:                         args.Backend,
+		backend, frontend, err := shared.RunnersInWorktree(args.Backend, args.Frontend, worktree)
+		if err != nil {
+			fmt.Println(colors.Red().Styled("NOTICE: " + err.Error()))
+			continue
+		}
+		err = nextStep.Run(shared.RunArgs{
+			Backend:                         backend,
 			BranchInfos:                     None[gitdomain.BranchInfos](),
 			Config:                          NewMutable(&args.Config),
 			Connector:                       args.Connector,
 			DialogTestInputs:                components.NewTestInputs(),
 			FinalMessages:                   args.FinalMessages,
-			Frontend:                        args.Frontend,
+			Frontend:                        frontend,
 			Git:                             args.Git,
 			PrependOpcodes:                  args.Prog.Prepend,
 			RegisterUndoablePerennialCommit: nil,
+			SetWorktree:                     setWorktree,
 			UpdateInitialSnapshotLocalSHA:   nil,
 		})
 		if err != nil {
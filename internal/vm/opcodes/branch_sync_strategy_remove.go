@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// BranchSyncStrategyRemove removes the sync strategy configured for the given branch.
+type BranchSyncStrategyRemove struct {
+	Branch                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *BranchSyncStrategyRemove) Run(args shared.RunArgs) error {
+	args.Config.Value.NormalConfig.RemoveBranchSyncStrategy(self.Branch)
+	return nil
+}
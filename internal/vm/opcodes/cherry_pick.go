@@ -0,0 +1,28 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// CherryPick applies the commit with the given SHA to the current branch.
+type CherryPick struct {
+	SHA                     gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CherryPick) AbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&CherryPickAbort{},
+	}
+}
+
+func (self *CherryPick) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&CherryPickContinue{},
+	}
+}
+
+func (self *CherryPick) Run(args shared.RunArgs) error {
+	return args.Git.CherryPick(args.Frontend, self.SHA)
+}
@@ -0,0 +1,15 @@
+package opcodes
+
+import "github.com/git-town/git-town/v17/internal/vm/shared"
+
+// CherryPickAbort aborts the current cherry-pick conflict.
+type CherryPickAbort struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CherryPickAbort) Run(args shared.RunArgs) error {
+	if args.Git.HasCherryPickInProgress(args.Backend) {
+		return args.Git.AbortCherryPick(args.Frontend)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import "github.com/git-town/git-town/v17/internal/vm/shared"
+
+// CherryPickContinue finishes an ongoing cherry-pick conflict
+// assuming all conflicts have been resolved by the user.
+type CherryPickContinue struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CherryPickContinue) Run(args shared.RunArgs) error {
+	if args.Git.HasCherryPickInProgress(args.Backend) {
+		return args.Git.ContinueCherryPick(args.Frontend)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// CommitFixup commits the staged changes as a fixup
+// for the commit with the given SHA.
+type CommitFixup struct {
+	SHA                     gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CommitFixup) Run(args shared.RunArgs) error {
+	return args.Git.CommitFixup(args.Frontend, self.SHA)
+}
@@ -0,0 +1,22 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// prefills the currently active commit message with the given message
+type CommitMessagePrefill struct {
+	Message                 gitdomain.CommitMessage
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CommitMessagePrefill) Run(args shared.RunArgs) error {
+	if err := args.Git.PrefillSquashCommitMessage(self.Message); err != nil {
+		return fmt.Errorf(messages.SquashMessagePrefillProblem, err)
+	}
+	return nil
+}
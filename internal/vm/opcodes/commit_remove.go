@@ -0,0 +1,29 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// CommitRemove removes the commit with the given SHA from the current branch
+// by rebasing the commits after it onto its parent commit.
+type CommitRemove struct {
+	SHA                     gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CommitRemove) AbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseAbort{},
+	}
+}
+
+func (self *CommitRemove) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseContinueIfNeeded{},
+	}
+}
+
+func (self *CommitRemove) Run(args shared.RunArgs) error {
+	return args.Git.RemoveCommit(args.Frontend, self.SHA)
+}
@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// CommitRevertMoved adds a commit to the current branch
+// that reverts the commit with the given SHA, which "git town move-commit" moved into an ancestor branch.
+// The user can resolve conflicts while reverting and continue or abort.
+type CommitRevertMoved struct {
+	SHA                     gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *CommitRevertMoved) AbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RevertAbort{},
+	}
+}
+
+func (self *CommitRevertMoved) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RevertContinue{},
+	}
+}
+
+func (self *CommitRevertMoved) Run(args shared.RunArgs) error {
+	return args.Git.RevertCommit(args.Frontend, self.SHA)
+}
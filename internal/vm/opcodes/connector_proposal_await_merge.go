@@ -0,0 +1,70 @@
+package opcodes
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+const (
+	mergeQueuePollInterval = 15 * time.Second // how often to check whether the merge queue has merged the proposal
+	mergeQueueTimeout      = 30 * time.Minute // how long to wait for the merge queue before giving up
+)
+
+// ConnectorProposalAwaitMerge waits until the merge queue of the code hosting platform
+// has merged the proposal with the given number.
+// If the merge queue removes the proposal without merging it, Git Town undoes the ship.
+// If the merge queue takes too long, Git Town stops so that the user can continue waiting later.
+type ConnectorProposalAwaitMerge struct {
+	Branch                  gitdomain.LocalBranchName
+	ProposalNumber          int
+	removedError            error
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorProposalAwaitMerge) AutomaticUndoError() error {
+	return self.removedError
+}
+
+func (self *ConnectorProposalAwaitMerge) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	mergeQueueStatus, canLoadMergeQueueStatus := connector.MergeQueueStatusFn().Get()
+	if !canLoadMergeQueueStatus {
+		return errors.New(messages.ShipMergeQueueUnsupported)
+	}
+	deadline := time.Now().Add(mergeQueueTimeout)
+	for {
+		status, err := mergeQueueStatus(self.ProposalNumber)
+		if err != nil {
+			return err
+		}
+		switch status {
+		case hostingdomain.MergeQueueStatusMerged:
+			return nil
+		case hostingdomain.MergeQueueStatusRemoved:
+			self.removedError = fmt.Errorf(messages.MergeQueueProposalRemoved, self.ProposalNumber)
+			return self.removedError
+		case hostingdomain.MergeQueueStatusQueued:
+		}
+		if time.Now().Add(mergeQueuePollInterval).After(deadline) {
+			return fmt.Errorf(messages.MergeQueueTimeout, self.ProposalNumber, mergeQueueTimeout)
+		}
+		time.Sleep(mergeQueuePollInterval)
+	}
+}
+
+// ShouldUndoOnError returns whether this opcode should cause the command to
+// automatically undo if it errors.
+// Only a proposal that the merge queue has rejected undoes the ship,
+// the user can continue waiting for proposals that are still queued.
+func (self *ConnectorProposalAwaitMerge) ShouldUndoOnError() bool {
+	return self.removedError != nil
+}
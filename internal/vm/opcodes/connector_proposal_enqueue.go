@@ -0,0 +1,41 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// ConnectorProposalEnqueue adds the proposal with the given number to the merge queue of the code hosting platform.
+type ConnectorProposalEnqueue struct {
+	Branch                  gitdomain.LocalBranchName
+	ProposalNumber          int
+	enqueueError            error
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ConnectorProposalEnqueue) AutomaticUndoError() error {
+	return self.enqueueError
+}
+
+func (self *ConnectorProposalEnqueue) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	enqueueProposal, canEnqueueProposal := connector.EnqueueProposalFn().Get()
+	if !canEnqueueProposal {
+		return errors.New(messages.ShipMergeQueueUnsupported)
+	}
+	self.enqueueError = enqueueProposal(self.ProposalNumber)
+	return self.enqueueError
+}
+
+// ShouldUndoOnError returns whether this opcode should cause the command to
+// automatically undo if it errors.
+func (self *ConnectorProposalEnqueue) ShouldUndoOnError() bool {
+	return true
+}
@@ -64,6 +64,7 @@ func Types() []shared.Opcode {
 		&BranchRemoteSetToSHA{},
 		&BranchRemoteSetToSHAIfNeeded{},
 		&BranchReset{},
+		&BranchSyncStrategyRemove{},
 		&BranchTrackingCreate{},
 		&BranchTrackingDelete{},
 		&BranchesContributionAdd{},
@@ -84,20 +85,30 @@ func Types() []shared.Opcode {
 		&CheckoutIfNeeded{},
 		&CheckoutParentOrMain{},
 		&CheckoutUncached{},
+		&CherryPick{},
+		&CherryPickAbort{},
+		&CherryPickContinue{},
 		&ChangesStage{},
 		&Commit{},
 		&CommitAutoUndo{},
+		&CommitFixup{},
 		&CommitMessageCommentOut{},
+		&CommitMessagePrefill{},
+		&CommitRemove{},
 		&CommitRevert{},
 		&CommitRevertIfNeeded{},
+		&CommitRevertMoved{},
 		&CommitWithMessage{},
 		&ConfigRemove{},
 		&ConfigSet{},
 		&ConflictPhantomDetect{},
 		&ConflictPhantomFinalize{},
 		&ConflictPhantomResolve{},
+		&ConnectorProposalAwaitMerge{},
+		&ConnectorProposalEnqueue{},
 		&ConnectorProposalMerge{},
 		&FetchUpstream{},
+		&HookRun{},
 		&PushCurrentBranchForce{},
 		&LineageBranchRemove{},
 		&LineageParentRemove{},
@@ -110,17 +121,27 @@ func Types() []shared.Opcode {
 		&MergeContinue{},
 		&MergeParentResolvePhantomConflicts{},
 		&MergeParentIfNeeded{},
+		&MergeRebasedProgram{},
 		&MergeSquashProgram{},
 		&MessageQueue{},
+		&OutboxEntryAdd{},
+		&OutboxEntryRemove{},
+		&PatchApply{},
+		&PatchRemove{},
 		&ProgramEndOfBranch{},
 		&RebaseAbort{},
+		&RebaseAutosquash{},
 		&RebaseBranch{},
+		&RebaseCommitsSince{},
 		&RebaseContinue{},
 		&RebaseContinueIfNeeded{},
 		&RebaseOnto{},
 		&RebaseParentIfNeeded{},
 		&RebaseTrackingBranch{},
+		&ProposalClose{},
 		&ProposalCreate{},
+		&ProposalCreateViaAPI{},
+		&ProposalUpdateBody{},
 		&ProposalUpdateTarget{},
 		&ProposalUpdateTargetToGrandParent{},
 		&ProposalUpdateSource{},
@@ -131,12 +152,18 @@ func Types() []shared.Opcode {
 		&PushCurrentBranchIfNeeded{},
 		&PushTags{},
 		&RegisterUndoablePerennialCommit{},
+		&RegisterUndoablePerennialCommits{},
+		&RevertAbort{},
+		&RevertContinue{},
 		&SnapshotInitialUpdateLocalSHA{},
 		&SnapshotInitialUpdateLocalSHAIfNeeded{},
+		&SharedMetadataPush{},
 		&StashDrop{},
 		&StashOpenChanges{},
 		&StashPop{},
 		&StashPopIfNeeded{},
 		&UndoLastCommit{},
+		&WorktreeEnter{},
+		&WorktreeLeave{},
 	} //exhaustruct:ignore
 }
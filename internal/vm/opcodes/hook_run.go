@@ -0,0 +1,29 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// HookRun runs the shell command that the user has configured for the given hook.
+// The command receives the name of the hook as $0 and the name of the given branch as $1.
+// If the command fails, Git Town stops so that the user can fix the problem
+// and then continue (which runs the command again), skip, or undo.
+type HookRun struct {
+	Branch                  gitdomain.LocalBranchName
+	Command                 configdomain.HookCommand
+	Hook                    configdomain.Hook
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *HookRun) Run(args shared.RunArgs) error {
+	err := args.Frontend.Run("sh", "-c", self.Command.String(), self.Hook.String(), self.Branch.String())
+	if err != nil {
+		return fmt.Errorf(messages.HookFailed, self.Hook, err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// MergeRebasedProgram prepends the opcodes to fast-forward the current branch to the given branch
+// that was rebased onto it, and to register the resulting new commits on the current branch as undoable.
+type MergeRebasedProgram struct {
+	Branch                  gitdomain.LocalBranchName
+	Parent                  gitdomain.LocalBranchName
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *MergeRebasedProgram) Run(args shared.RunArgs) error {
+	previousSHA, err := args.Git.SHAForBranch(args.Backend, self.Parent.BranchName())
+	if err != nil {
+		return err
+	}
+	args.PrependOpcodes(
+		&MergeFastForward{
+			Branch: self.Branch,
+		},
+		&RegisterUndoablePerennialCommits{
+			Parent:      self.Parent.BranchName(),
+			PreviousSHA: previousSHA,
+		},
+	)
+	return nil
+}
@@ -3,8 +3,10 @@ package opcodes
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/git-town/git-town/v17/internal/cli/dialog"
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/messages"
 	"github.com/git-town/git-town/v17/internal/vm/shared"
@@ -12,11 +14,13 @@ import (
 )
 
 // MergeSquashProgram prepends the opcodes to squash merge the branch with the given name into the current branch.
+// If TemplateData exists, prefills the commit message editor with the configured squash commit template.
 type MergeSquashProgram struct {
 	Authors       []gitdomain.Author
 	Branch        gitdomain.LocalBranchName
 	CommitMessage Option[gitdomain.CommitMessage]
 	Parent        gitdomain.LocalBranchName
+	TemplateData  Option[configdomain.ShipSquashCommitTemplateData]
 	undeclaredOpcodeMethods
 }
 
@@ -41,7 +45,20 @@ func (self *MergeSquashProgram) Run(args shared.RunArgs) error {
 		},
 	}
 	if !args.Config.Value.NormalConfig.DryRun {
-		program = append(program, &CommitMessageCommentOut{})
+		template, hasTemplate := args.Config.Value.NormalConfig.ShipSquashCommitTemplate.Get()
+		templateData, hasTemplateData := self.TemplateData.Get()
+		if hasTemplate && hasTemplateData {
+			templateData.CoAuthors = slices.DeleteFunc(slices.Clone(self.Authors), func(other gitdomain.Author) bool {
+				return other == author
+			})
+			message, err := template.Render(templateData)
+			if err != nil {
+				return err
+			}
+			program = append(program, &CommitMessagePrefill{Message: message})
+		} else {
+			program = append(program, &CommitMessageCommentOut{})
+		}
 	}
 	program = append(program,
 		&CommitAutoUndo{
@@ -0,0 +1,41 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// OutboxEntryAdd stores the given action at the code hosting platform in the outbox,
+// so that the next online sync performs it.
+type OutboxEntryAdd struct {
+	Entry                   outbox.Entry
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *OutboxEntryAdd) Run(args shared.RunArgs) error {
+	return updateOutbox(args, func(entries outbox.Outbox) outbox.Outbox {
+		return entries.Add(self.Entry)
+	})
+}
+
+func (self *OutboxEntryAdd) UndoExternalChangesProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&OutboxEntryRemove{Entry: self.Entry},
+	}
+}
+
+// updateOutbox applies the given change to the outbox of the current repository
+func updateOutbox(args shared.RunArgs, change func(outbox.Outbox) outbox.Outbox) error {
+	rootDir, hasRootDir := args.Git.RootDirectory(args.Backend).Get()
+	if !hasRootDir {
+		return errors.New(messages.RepoOutside)
+	}
+	entries, err := outbox.Load(rootDir)
+	if err != nil {
+		return err
+	}
+	return outbox.Save(change(entries), rootDir)
+}
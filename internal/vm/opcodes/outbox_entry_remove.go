@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// OutboxEntryRemove removes the given action from the outbox.
+type OutboxEntryRemove struct {
+	Entry                   outbox.Entry
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *OutboxEntryRemove) Run(args shared.RunArgs) error {
+	return updateOutbox(args, func(entries outbox.Outbox) outbox.Outbox {
+		return entries.Remove(self.Entry)
+	})
+}
@@ -0,0 +1,21 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// PatchApply applies the given patch to the workspace and stages the changes.
+type PatchApply struct {
+	Patch                   string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *PatchApply) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ChangesStage{},
+	}
+}
+
+func (self *PatchApply) Run(args shared.RunArgs) error {
+	return args.Git.ApplyPatch(args.Frontend, args.Backend, self.Patch, false)
+}
@@ -0,0 +1,15 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// PatchRemove removes the changes in the given patch from the workspace and the Git index.
+type PatchRemove struct {
+	Patch                   string
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *PatchRemove) Run(args shared.RunArgs) error {
+	return args.Git.ApplyPatch(args.Frontend, args.Backend, self.Patch, true)
+}
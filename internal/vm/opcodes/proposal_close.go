@@ -0,0 +1,24 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// ProposalClose closes the proposal with the given number at the code hosting platform.
+type ProposalClose struct {
+	ProposalNumber          int
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ProposalClose) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	closeProposal, canCloseProposal := connector.CloseProposalFn().Get()
+	if !canCloseProposal {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	return closeProposal(self.ProposalNumber)
+}
@@ -0,0 +1,68 @@
+package opcodes
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// ProposalCreateViaAPI creates a new proposal for the given branch through the API of the code hosting platform.
+type ProposalCreateViaAPI struct {
+	Assignees               []string
+	Branch                  gitdomain.LocalBranchName
+	Draft                   bool
+	Labels                  []string
+	ProposalBody            gitdomain.ProposalBody
+	ProposalTitle           gitdomain.ProposalTitle
+	Reviewers               []string
+	createdProposalNumber   int // number of the proposal that this opcode created, used to close it when undoing
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ProposalCreateViaAPI) Run(args shared.RunArgs) error {
+	parentBranch, hasParentBranch := args.Config.Value.NormalConfig.Lineage.Parent(self.Branch).Get()
+	if !hasParentBranch {
+		return fmt.Errorf(messages.ProposalNoParent, self.Branch)
+	}
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	createProposal, canCreateProposal := connector.CreateProposalFn().Get()
+	if !canCreateProposal {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	proposal, err := createProposal(hostingdomain.CreateProposalArgs{
+		Assignees: self.Assignees,
+		Body:      self.ProposalBody,
+		Draft:     self.Draft,
+		Labels:    self.Labels,
+		Reviewers: self.Reviewers,
+		Source:    self.Branch,
+		Target:    parentBranch,
+		Title:     self.ProposalTitle,
+	})
+	self.createdProposalNumber = proposal.Number
+	if err != nil && self.createdProposalNumber > 0 {
+		// The proposal exists, only adding reviewers, assignees, or labels to it failed.
+		// Stopping here would make "git town continue" create the proposal again,
+		// so Git Town only reports the problem and undo still closes the proposal.
+		args.FinalMessages.Add(fmt.Sprintf(messages.ProposalCreateIncomplete, self.createdProposalNumber, err))
+		return nil
+	}
+	return err
+}
+
+func (self *ProposalCreateViaAPI) UndoExternalChangesProgram() []shared.Opcode {
+	if self.createdProposalNumber <= 0 {
+		return []shared.Opcode{}
+	}
+	return []shared.Opcode{
+		&ProposalClose{
+			ProposalNumber: self.createdProposalNumber,
+		},
+	}
+}
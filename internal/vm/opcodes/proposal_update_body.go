@@ -0,0 +1,37 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// ProposalUpdateBody updates the body of the proposal with the given number at the code hosting platform.
+type ProposalUpdateBody struct {
+	NewBody                 gitdomain.ProposalBody
+	OldBody                 gitdomain.ProposalBody
+	ProposalNumber          int
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *ProposalUpdateBody) Run(args shared.RunArgs) error {
+	connector, hasConnector := args.Connector.Get()
+	if !hasConnector {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	updateProposalBody, canUpdateProposalBody := connector.UpdateProposalBodyFn().Get()
+	if !canUpdateProposalBody {
+		return hostingdomain.UnsupportedServiceError()
+	}
+	return updateProposalBody(self.ProposalNumber, self.NewBody)
+}
+
+func (self *ProposalUpdateBody) UndoExternalChangesProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&ProposalUpdateBody{
+			NewBody:        self.OldBody,
+			OldBody:        self.NewBody,
+			ProposalNumber: self.ProposalNumber,
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// RebaseAutosquash squashes the fixup commits in the current branch
+// into the commits they fix, starting at the commit with the given SHA.
+type RebaseAutosquash struct {
+	SHA                     gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RebaseAutosquash) AbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseAbort{},
+	}
+}
+
+func (self *RebaseAutosquash) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseContinueIfNeeded{},
+	}
+}
+
+func (self *RebaseAutosquash) Run(args shared.RunArgs) error {
+	return args.Git.RebaseAutosquash(args.Frontend, args.Backend, self.SHA, args.Config.Value.NormalConfig.GitVersion)
+}
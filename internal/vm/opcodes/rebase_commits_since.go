@@ -0,0 +1,30 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// RebaseCommitsSince rebases the commits that the current branch contains
+// on top of the commit with the given SHA onto the given branch.
+type RebaseCommitsSince struct {
+	Onto                    gitdomain.BranchName
+	Since                   gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RebaseCommitsSince) AbortProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseAbort{},
+	}
+}
+
+func (self *RebaseCommitsSince) ContinueProgram() []shared.Opcode {
+	return []shared.Opcode{
+		&RebaseContinueIfNeeded{},
+	}
+}
+
+func (self *RebaseCommitsSince) Run(args shared.RunArgs) error {
+	return args.Git.RebaseCommitsSince(args.Frontend, self.Since, self.Onto, args.Config.Value.NormalConfig.GitVersion)
+}
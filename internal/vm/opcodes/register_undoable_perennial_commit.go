@@ -16,6 +16,6 @@ func (self *RegisterUndoablePerennialCommit) Run(args shared.RunArgs) error {
 	if err != nil {
 		return err
 	}
-	args.RegisterUndoablePerennialCommit(squashedCommitSHA)
+	args.RegisterUndoablePerennialCommit(self.Parent.LocalName(), squashedCommitSHA)
 	return nil
 }
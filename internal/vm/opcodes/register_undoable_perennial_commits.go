@@ -0,0 +1,24 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+)
+
+// registers the commits that the given perennial branch received after the given commit as undoable
+type RegisterUndoablePerennialCommits struct {
+	Parent                  gitdomain.BranchName
+	PreviousSHA             gitdomain.SHA
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RegisterUndoablePerennialCommits) Run(args shared.RunArgs) error {
+	newCommits, err := args.Git.CommitSHAsSince(args.Backend, self.PreviousSHA, self.Parent)
+	if err != nil {
+		return err
+	}
+	for _, newCommit := range newCommits {
+		args.RegisterUndoablePerennialCommit(self.Parent.LocalName(), newCommit)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package opcodes
+
+import "github.com/git-town/git-town/v17/internal/vm/shared"
+
+// RevertAbort aborts the current revert conflict.
+type RevertAbort struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RevertAbort) Run(args shared.RunArgs) error {
+	if args.Git.HasRevertInProgress(args.Backend) {
+		return args.Git.AbortRevert(args.Frontend)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package opcodes
+
+import "github.com/git-town/git-town/v17/internal/vm/shared"
+
+// RevertContinue finishes an ongoing revert conflict
+// assuming all conflicts have been resolved by the user.
+type RevertContinue struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *RevertContinue) Run(args shared.RunArgs) error {
+	if args.Git.HasRevertInProgress(args.Backend) {
+		return args.Git.ContinueRevert(args.Frontend)
+	}
+	return nil
+}
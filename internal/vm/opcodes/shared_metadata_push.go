@@ -0,0 +1,39 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// SharedMetadataPush shares the branch lineage and branch types of the local repo
+// with other people through a Git ref at the development remote.
+type SharedMetadataPush struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *SharedMetadataPush) Run(args shared.RunArgs) error {
+	remote := args.Config.Value.NormalConfig.DevRemote
+	remoteSHA, _, err := args.Git.SharedMetadata(args.Backend, gitdomain.RemoteSharedMetadataRef(remote))
+	if err != nil {
+		return err
+	}
+	localMetadata := configdomain.NewSharedMetadata(args.Config.Value.NormalConfig.NormalConfigData)
+	if remoteSHA.IsNone() && localMetadata.IsEmpty() {
+		return nil
+	}
+	localSHA, err := args.Git.StoreSharedMetadata(args.Backend, localMetadata)
+	if err != nil {
+		return err
+	}
+	if !remoteSHA.Equal(Some(localSHA)) {
+		if err = args.Git.PushSharedMetadata(args.Frontend, remote, localSHA, remoteSHA); err != nil {
+			// somebody else has shared metadata since this sync fetched it, the next sync merges their changes
+			args.FinalMessages.Add(messages.SharedMetadataPushProblem)
+			return nil
+		}
+	}
+	return args.Git.UpdateSharedMetadataRefs(args.Backend, remote, localSHA)
+}
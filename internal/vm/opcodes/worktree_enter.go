@@ -0,0 +1,20 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// WorktreeEnter makes the opcodes after it run in the given worktree
+// until a WorktreeLeave opcode.
+type WorktreeEnter struct {
+	Worktree                gitdomain.Worktree
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *WorktreeEnter) Run(args shared.RunArgs) error {
+	args.Git.CurrentBranchCache.Invalidate()
+	args.SetWorktree(Some(self.Worktree))
+	return nil
+}
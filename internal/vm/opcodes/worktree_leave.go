@@ -0,0 +1,18 @@
+package opcodes
+
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/shared"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// WorktreeLeave makes the opcodes after it run in the current worktree again.
+type WorktreeLeave struct {
+	undeclaredOpcodeMethods `exhaustruct:"optional"`
+}
+
+func (self *WorktreeLeave) Run(args shared.RunArgs) error {
+	args.Git.CurrentBranchCache.Invalidate()
+	args.SetWorktree(None[gitdomain.Worktree]())
+	return nil
+}
@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+)
+
+func FilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(messages.OutboxPathProblem, err)
+	}
+	persistenceDir := filepath.Join(configDir, "git-town", "outbox")
+	filename := repoDir.Sanitized()
+	return filepath.Join(persistenceDir, filename+".json"), err
+}
+
+// Load loads the outbox of the given Git repo from disk.
+// Returns an empty outbox if there is no saved outbox.
+func Load(repoDir gitdomain.RepoRootDir) (Outbox, error) {
+	filename, err := FilePath(repoDir)
+	if err != nil {
+		return Outbox{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Outbox{}, nil
+		}
+		return Outbox{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var outbox Outbox
+	err = json.Unmarshal(content, &outbox)
+	if err != nil {
+		return Outbox{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return outbox, nil
+}
+
+// Save stores the given outbox for the given Git repo to disk.
+// Removes the stored outbox if the given outbox is empty.
+func Save(outbox Outbox, repoDir gitdomain.RepoRootDir) error {
+	persistencePath, err := FilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	if len(outbox) == 0 {
+		err = os.Remove(persistencePath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf(messages.FileDeleteProblem, persistencePath, err)
+		}
+		return nil
+	}
+	content, err := json.MarshalIndent(outbox, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.OutboxSerializeProblem, err)
+	}
+	err = os.MkdirAll(filepath.Dir(persistencePath), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(persistencePath, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, persistencePath, err)
+	}
+	return nil
+}
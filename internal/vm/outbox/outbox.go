@@ -0,0 +1,66 @@
+// Package outbox stores the actions at the code hosting platform
+// that Git Town could not perform because it was offline.
+package outbox
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// Outbox contains the pending actions at the code hosting platform, the oldest action first.
+// The next online sync performs them.
+type Outbox []Entry
+
+// Add provides a new Outbox that contains the given entry in addition to the entries in this Outbox.
+func (self Outbox) Add(entry Entry) Outbox {
+	return append(slices.Clone(self), entry)
+}
+
+// Remove provides a new Outbox without the given entry.
+func (self Outbox) Remove(entry Entry) Outbox {
+	return slices.DeleteFunc(slices.Clone(self), entry.Equal)
+}
+
+// Entry describes an action at the code hosting platform that Git Town performs during the next online sync.
+type Entry struct {
+	Action        Action
+	Branch        gitdomain.LocalBranchName         // the branch whose proposal or tracking branch to update
+	CommitMessage Option[gitdomain.CommitMessage]   // the commit message to use when merging the proposal
+	NewTarget     Option[gitdomain.LocalBranchName] // the branch that the proposal should target from now on
+	Target        Option[gitdomain.LocalBranchName] // the branch that the proposal currently targets
+}
+
+// Equal indicates whether this Entry describes the same action as the given Entry.
+func (self Entry) Equal(other Entry) bool {
+	return self.Action == other.Action &&
+		self.Branch == other.Branch &&
+		self.CommitMessage.Equal(other.CommitMessage) &&
+		self.NewTarget.Equal(other.NewTarget) &&
+		self.Target.Equal(other.Target)
+}
+
+// String provides a human-readable description of this Entry.
+func (self Entry) String() string {
+	switch self.Action {
+	case ActionProposalMerge:
+		return fmt.Sprintf(messages.OutboxProposalMerge, self.Branch, self.Target)
+	case ActionProposalRetarget:
+		return fmt.Sprintf(messages.OutboxProposalRetarget, self.Branch, self.Target, self.NewTarget)
+	case ActionTrackingBranchDelete:
+		return fmt.Sprintf(messages.OutboxTrackingBranchDelete, self.Branch)
+	}
+	return string(self.Action)
+}
+
+// Action describes the kind of change at the code hosting platform that an Entry performs.
+type Action string
+
+const (
+	ActionProposalMerge        Action = "merge-proposal"         // merge the proposal of Branch into Target
+	ActionProposalRetarget     Action = "retarget-proposal"      // change the target of the proposal of Branch from Target to NewTarget
+	ActionTrackingBranchDelete Action = "delete-tracking-branch" // delete the tracking branch of Branch
+)
@@ -0,0 +1,87 @@
+package outbox_test
+
+import (
+	"testing"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+	"github.com/shoenig/test/must"
+)
+
+func TestOutbox(t *testing.T) {
+	t.Parallel()
+
+	mergeEntry := outbox.Entry{
+		Action:        outbox.ActionProposalMerge,
+		Branch:        "feature",
+		CommitMessage: Some(gitdomain.CommitMessage("done")),
+		NewTarget:     None[gitdomain.LocalBranchName](),
+		Target:        Some(gitdomain.NewLocalBranchName("main")),
+	}
+	retargetEntry := outbox.Entry{
+		Action:        outbox.ActionProposalRetarget,
+		Branch:        "child",
+		CommitMessage: None[gitdomain.CommitMessage](),
+		NewTarget:     Some(gitdomain.NewLocalBranchName("main")),
+		Target:        Some(gitdomain.NewLocalBranchName("feature")),
+	}
+	deleteEntry := outbox.Entry{
+		Action:        outbox.ActionTrackingBranchDelete,
+		Branch:        "feature",
+		CommitMessage: None[gitdomain.CommitMessage](),
+		NewTarget:     None[gitdomain.LocalBranchName](),
+		Target:        None[gitdomain.LocalBranchName](),
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+		give := outbox.Outbox{retargetEntry}
+		have := give.Add(mergeEntry)
+		must.Len(t, 2, have)
+		must.True(t, have[1].Equal(mergeEntry))
+		must.Len(t, 1, give)
+	})
+
+	t.Run("Entry.Equal", func(t *testing.T) {
+		t.Parallel()
+		t.Run("same values", func(t *testing.T) {
+			t.Parallel()
+			other := mergeEntry
+			other.CommitMessage = Some(gitdomain.CommitMessage("done"))
+			must.True(t, mergeEntry.Equal(other))
+		})
+		t.Run("different commit message", func(t *testing.T) {
+			t.Parallel()
+			other := mergeEntry
+			other.CommitMessage = None[gitdomain.CommitMessage]()
+			must.False(t, mergeEntry.Equal(other))
+		})
+		t.Run("different action", func(t *testing.T) {
+			t.Parallel()
+			must.False(t, mergeEntry.Equal(deleteEntry))
+		})
+	})
+
+	t.Run("Entry.String", func(t *testing.T) {
+		t.Parallel()
+		tests := map[string]outbox.Entry{
+			`merge the proposal of branch "feature" into "main"`:                           mergeEntry,
+			`change the target of the proposal of branch "child" from "feature" to "main"`: retargetEntry,
+			`delete the tracking branch of "feature"`:                                      deleteEntry,
+		}
+		for want, give := range tests {
+			must.EqOp(t, want, give.String())
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		t.Parallel()
+		give := outbox.Outbox{retargetEntry, mergeEntry, deleteEntry}
+		have := give.Remove(mergeEntry)
+		must.Len(t, 2, have)
+		must.True(t, have[0].Equal(retargetEntry))
+		must.True(t, have[1].Equal(deleteEntry))
+		must.Len(t, 3, give)
+	})
+}
@@ -0,0 +1,42 @@
+package runstate
+
+import (
+	"slices"
+	"time"
+)
+
+// JournalSize is the maximum number of finished Git Town commands that the journal remembers.
+const JournalSize = 20
+
+// Journal contains the most recently finished Git Town commands, the oldest command first.
+type Journal []JournalEntry
+
+// JournalEntry describes a Git Town command that has finished.
+type JournalEntry struct {
+	RunState RunState  // the runstate of the finished command
+	Time     time.Time // when the command finished
+}
+
+// Add provides a new Journal that contains the given entry in addition to the entries in this Journal.
+// Forgets the oldest entries if the journal becomes larger than JournalSize.
+func (self Journal) Add(entry JournalEntry) Journal {
+	result := append(slices.Clone(self), entry)
+	if len(result) > JournalSize {
+		result = result[len(result)-JournalSize:]
+	}
+	return result
+}
+
+// MostRecent provides the given number of most recent entries, the most recent entry first.
+func (self Journal) MostRecent(count int) []JournalEntry {
+	count = min(count, len(self))
+	result := slices.Clone(self[len(self)-count:])
+	slices.Reverse(result)
+	return result
+}
+
+// RemoveMostRecent provides a new Journal without the given number of most recent entries.
+func (self Journal) RemoveMostRecent(count int) Journal {
+	count = min(count, len(self))
+	return slices.Clone(self[:len(self)-count])
+}
@@ -0,0 +1,64 @@
+package runstate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+	"github.com/shoenig/test/must"
+)
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+
+	newEntry := func(command string) runstate.JournalEntry {
+		runState := runstate.EmptyRunState()
+		runState.Command = command
+		return runstate.JournalEntry{RunState: runState, Time: time.Time{}}
+	}
+
+	commands := func(entries []runstate.JournalEntry) []string {
+		result := make([]string, len(entries))
+		for e, entry := range entries {
+			result[e] = entry.RunState.Command
+		}
+		return result
+	}
+
+	t.Run("Add", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("adds the entry at the end", func(t *testing.T) {
+			t.Parallel()
+			journal := runstate.Journal{newEntry("hack")}
+			have := journal.Add(newEntry("sync"))
+			must.Eq(t, []string{"hack", "sync"}, commands(have))
+			must.Eq(t, []string{"hack"}, commands(journal))
+		})
+
+		t.Run("forgets the oldest entries", func(t *testing.T) {
+			t.Parallel()
+			journal := runstate.Journal{}
+			for range runstate.JournalSize {
+				journal = journal.Add(newEntry("sync"))
+			}
+			have := journal.Add(newEntry("ship"))
+			must.Len(t, runstate.JournalSize, have)
+			must.EqOp(t, "ship", have[runstate.JournalSize-1].RunState.Command)
+		})
+	})
+
+	t.Run("MostRecent", func(t *testing.T) {
+		t.Parallel()
+		journal := runstate.Journal{newEntry("hack"), newEntry("sync"), newEntry("ship")}
+		must.Eq(t, []string{"ship", "sync"}, commands(journal.MostRecent(2)))
+		must.Eq(t, []string{"ship", "sync", "hack"}, commands(journal.MostRecent(5)))
+	})
+
+	t.Run("RemoveMostRecent", func(t *testing.T) {
+		t.Parallel()
+		journal := runstate.Journal{newEntry("hack"), newEntry("sync"), newEntry("ship")}
+		must.Eq(t, []string{"hack"}, commands(journal.RemoveMostRecent(2)))
+		must.Eq(t, []string{}, commands(journal.RemoveMostRecent(5)))
+	})
+}
@@ -31,8 +31,10 @@ type RunState struct {
 	RunProgram               program.Program                            // remaining opcodes of the Git Town command that this RunState is for
 	TouchedBranches          []gitdomain.BranchName                     // the branches that are touched by the Git Town command that this RunState is for
 	UndoAPIProgram           program.Program                            // opcodes to undo changes at external systems
-	UndoablePerennialCommits []gitdomain.SHA                            `exhaustruct:"optional"` // contains the SHAs of commits on perennial branches that can safely be undone
+	UndoablePerennialCommits gitdomain.UndoableCommits                  `exhaustruct:"optional"` // contains the commits on perennial branches that can safely be undone
 	UnfinishedDetails        OptionalMutable[UnfinishedRunStateDetails] `exhaustruct:"optional"`
+	Worktree                 Option[gitdomain.Worktree]                 `exhaustruct:"optional"` // the worktree in which the remaining opcodes run, if it isn't the current worktree
+	Worktrees                gitdomain.Worktrees                        `exhaustruct:"optional"` // the other worktrees in which opcodes ran
 }
 
 func EmptyRunState() RunState {
@@ -91,10 +93,19 @@ func (self *RunState) MarkAsUnfinished(gitCommands git.Commands, backend gitdoma
 	return nil
 }
 
-// RegisterUndoablePerennialCommit stores the given commit on a perennial branch as undoable.
+// RegisterUndoablePerennialCommit stores the given commit on the given perennial branch as undoable.
 // This method is used as a callback.
-func (self *RunState) RegisterUndoablePerennialCommit(commit gitdomain.SHA) {
-	self.UndoablePerennialCommits = append(self.UndoablePerennialCommits, commit)
+func (self *RunState) RegisterUndoablePerennialCommit(branch gitdomain.LocalBranchName, commit gitdomain.SHA) {
+	self.UndoablePerennialCommits = append(self.UndoablePerennialCommits, gitdomain.UndoableCommit{Branch: branch, SHA: commit})
+}
+
+// SetWorktree makes the remaining opcodes run in the given worktree.
+// This method is used as a callback.
+func (self *RunState) SetWorktree(worktree Option[gitdomain.Worktree]) {
+	self.Worktree = worktree
+	if worktree, hasWorktree := worktree.Get(); hasWorktree {
+		self.Worktrees.Add(worktree)
+	}
 }
 
 // SkipCurrentBranchProgram removes the opcodes for the current branch
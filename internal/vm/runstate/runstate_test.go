@@ -59,7 +59,7 @@ func TestRunState(t *testing.T) {
 			BeginBranchesSnapshot:    gitdomain.EmptyBranchesSnapshot(),
 			BeginConfigSnapshot:      undoconfig.EmptyConfigSnapshot(),
 			BeginStashSize:           0,
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
 			TouchedBranches:          []gitdomain.BranchName{"branch-1", "branch-2"},
 		}
 		encoded, err := json.MarshalIndent(runState, "", "  ")
@@ -125,7 +125,9 @@ func TestRunState(t *testing.T) {
   ],
   "UndoAPIProgram": [],
   "UndoablePerennialCommits": [],
-  "UnfinishedDetails": null
+  "UnfinishedDetails": null,
+  "Worktree": null,
+  "Worktrees": null
 }`[1:]
 		must.EqOp(t, want, string(encoded))
 		newRunState := runstate.EmptyRunState()
@@ -133,4 +135,17 @@ func TestRunState(t *testing.T) {
 		must.NoError(t, err)
 		must.Eq(t, runState, &newRunState)
 	})
+
+	t.Run("Unmarshal undoable perennial commits of older versions", func(t *testing.T) {
+		t.Parallel()
+		give := `{"UndoablePerennialCommits": ["111111", "222222"]}`
+		runState := runstate.EmptyRunState()
+		err := json.Unmarshal([]byte(give), &runState)
+		must.NoError(t, err)
+		want := gitdomain.UndoableCommits{
+			{Branch: "", SHA: "111111"},
+			{Branch: "", SHA: "222222"},
+		}
+		must.Eq(t, want, runState.UndoablePerennialCommits)
+	})
 }
@@ -20,6 +20,7 @@ type RunArgs struct {
 	Frontend                        gitdomain.Runner
 	Git                             git.Commands
 	PrependOpcodes                  func(...Opcode)
-	RegisterUndoablePerennialCommit func(gitdomain.SHA)
+	RegisterUndoablePerennialCommit func(gitdomain.LocalBranchName, gitdomain.SHA)
+	SetWorktree                     func(Option[gitdomain.Worktree])
 	UpdateInitialSnapshotLocalSHA   func(gitdomain.LocalBranchName, gitdomain.SHA) error
 }
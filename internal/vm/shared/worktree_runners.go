@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"fmt"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
+
+// RunnersInWorktree provides the runners that execute opcodes in the given worktree.
+// If no worktree is given, it provides the given runners.
+func RunnersInWorktree(backend gitdomain.RunnerQuerier, frontend gitdomain.Runner, worktreeOpt Option[gitdomain.Worktree]) (gitdomain.RunnerQuerier, gitdomain.Runner, error) { //nolint:ireturn
+	worktree, hasWorktree := worktreeOpt.Get()
+	if !hasWorktree {
+		return backend, frontend, nil
+	}
+	dirBackend, backendCanChangeDir := backend.(dirBackendRunner)
+	dirFrontend, frontendCanChangeDir := frontend.(dirFrontendRunner)
+	if !backendCanChangeDir || !frontendCanChangeDir {
+		return backend, frontend, fmt.Errorf(messages.WorktreeRunnerUnsupported, worktree.Dir)
+	}
+	worktreeBackend := dirBackend.InDir(worktree.Dir)
+	return worktreeBackend, dirFrontend.InDir(worktreeBackend, worktree.Dir), nil
+}
+
+// a backend runner that can execute commands in other directories
+type dirBackendRunner interface {
+	InDir(dir string) gitdomain.RunnerQuerier
+}
+
+// a frontend runner that can execute commands in other directories
+type dirFrontendRunner interface {
+	InDir(backend gitdomain.Querier, dir string) gitdomain.Runner
+}
@@ -10,11 +10,19 @@ import (
 )
 
 func FilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	persistenceDir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	filename := repoDir.Sanitized()
+	return filepath.Join(persistenceDir, filename+".json"), err
+}
+
+// Dir provides the directory that contains the runstate files of all repositories.
+func Dir() (string, error) {
 	configDir, err := os.UserConfigDir()
 	if err != nil {
 		return "", fmt.Errorf(messages.RunstatePathProblem, err)
 	}
-	persistenceDir := filepath.Join(configDir, "git-town", "runstate")
-	filename := SanitizePath(repoDir)
-	return filepath.Join(persistenceDir, filename+".json"), err
+	return filepath.Join(configDir, "git-town", "runstate"), nil
 }
@@ -0,0 +1,79 @@
+package statefile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	"github.com/git-town/git-town/v17/internal/messages"
+	"github.com/git-town/git-town/v17/internal/vm/runstate"
+)
+
+// AddToJournal adds the given finished run state to the journal of the given Git repo.
+func AddToJournal(runState runstate.RunState, repoDir gitdomain.RepoRootDir) error {
+	journal, err := LoadJournal(repoDir)
+	if err != nil {
+		return err
+	}
+	journal = journal.Add(runstate.JournalEntry{
+		RunState: runState,
+		Time:     time.Now(),
+	})
+	return SaveJournal(journal, repoDir)
+}
+
+func JournalFilePath(repoDir gitdomain.RepoRootDir) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(messages.RunstatePathProblem, err)
+	}
+	persistenceDir := filepath.Join(configDir, "git-town", "history")
+	filename := repoDir.Sanitized()
+	return filepath.Join(persistenceDir, filename+".json"), err
+}
+
+// LoadJournal loads the journal of the given Git repo from disk.
+// Returns an empty journal if there is no saved journal.
+func LoadJournal(repoDir gitdomain.RepoRootDir) (runstate.Journal, error) {
+	filename, err := JournalFilePath(repoDir)
+	if err != nil {
+		return runstate.Journal{}, err
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return runstate.Journal{}, nil
+		}
+		return runstate.Journal{}, fmt.Errorf(messages.FileReadProblem, filename, err)
+	}
+	var journal runstate.Journal
+	err = json.Unmarshal(content, &journal)
+	if err != nil {
+		return runstate.Journal{}, fmt.Errorf(messages.FileContentInvalidJSON, filename, err)
+	}
+	return journal, nil
+}
+
+// SaveJournal stores the given journal for the given Git repo to disk.
+func SaveJournal(journal runstate.Journal, repoDir gitdomain.RepoRootDir) error {
+	content, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		return fmt.Errorf(messages.RunstateSerializeProblem, err)
+	}
+	persistencePath, err := JournalFilePath(repoDir)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(persistencePath), 0o700)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(persistencePath, content, 0o600)
+	if err != nil {
+		return fmt.Errorf(messages.FileWriteProblem, persistencePath, err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/messages"
@@ -36,3 +37,35 @@ func Load(repoDir gitdomain.RepoRootDir) (Option[runstate.RunState], error) {
 	}
 	return Some(runState), nil
 }
+
+// UnloadableFiles provides the paths of the runstate files of all repositories that don't contain a valid runstate,
+// for example because an older Git Town version created them.
+func UnloadableFiles() ([]string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return []string{}, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return []string{}, fmt.Errorf(messages.DirReadProblem, dir, err)
+	}
+	result := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		filename := filepath.Join(dir, entry.Name())
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			return result, fmt.Errorf(messages.FileReadProblem, filename, err)
+		}
+		var runState runstate.RunState
+		if json.Unmarshal(content, &runState) != nil {
+			result = append(result, filename)
+		}
+	}
+	return result, nil
+}
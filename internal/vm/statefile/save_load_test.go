@@ -10,6 +10,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	"github.com/git-town/git-town/v17/internal/undo/undoconfig"
 	"github.com/git-town/git-town/v17/internal/vm/opcodes"
+	"github.com/git-town/git-town/v17/internal/vm/outbox"
 	"github.com/git-town/git-town/v17/internal/vm/program"
 	"github.com/git-town/git-town/v17/internal/vm/runstate"
 	"github.com/git-town/git-town/v17/internal/vm/statefile"
@@ -20,19 +21,6 @@ import (
 func TestLoadSave(t *testing.T) {
 	t.Parallel()
 
-	t.Run("SanitizePath", func(t *testing.T) {
-		t.Parallel()
-		tests := map[string]string{
-			"/home/user/development/git-town":        "home-user-development-git-town",
-			"c:\\Users\\user\\development\\git-town": "c-users-user-development-git-town",
-		}
-		for give, want := range tests {
-			rootDir := gitdomain.NewRepoRootDir(give)
-			have := statefile.SanitizePath(rootDir)
-			must.EqOp(t, want, have)
-		}
-	})
-
 	t.Run("Save and Load", func(t *testing.T) {
 		t.Parallel()
 		runState := runstate.RunState{
@@ -61,6 +49,7 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.BranchRemoteSetToSHA{Branch: "branch", SetToSHA: "222222"},
 				&opcodes.BranchRemoteSetToSHAIfNeeded{Branch: "branch", MustHaveSHA: "111111", SetToSHA: "222222"},
 				&opcodes.BranchReset{Target: "branch"},
+				&opcodes.BranchSyncStrategyRemove{Branch: "branch"},
 				&opcodes.BranchTrackingCreate{Branch: "branch"},
 				&opcodes.BranchTrackingDelete{Branch: "origin/branch"},
 				&opcodes.BranchesContributionAdd{Branch: "branch"},
@@ -79,19 +68,29 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.CheckoutHistoryPreserve{PreviousBranchCandidates: []Option[gitdomain.LocalBranchName]{Some(gitdomain.NewLocalBranchName("previous"))}},
 				&opcodes.CheckoutIfNeeded{Branch: "branch"},
 				&opcodes.CheckoutUncached{Branch: "branch"},
+				&opcodes.CherryPick{SHA: "123456"},
+				&opcodes.CherryPickAbort{},
+				&opcodes.CherryPickContinue{},
 				&opcodes.Commit{AuthorOverride: Some(gitdomain.Author("user@acme.com")), FallbackToDefaultCommitMessage: true, Message: Some(gitdomain.CommitMessage("my message"))},
 				&opcodes.CommitAutoUndo{AuthorOverride: Some(gitdomain.Author("user@acme.com")), FallbackToDefaultCommitMessage: true, Message: Some(gitdomain.CommitMessage("my message"))},
+				&opcodes.CommitFixup{SHA: "123456"},
 				&opcodes.CommitMessageCommentOut{},
+				&opcodes.CommitMessagePrefill{Message: "my message"},
+				&opcodes.CommitRemove{SHA: "123456"},
 				&opcodes.CommitRevert{SHA: "123456"},
 				&opcodes.CommitRevertIfNeeded{SHA: "123456"},
+				&opcodes.CommitRevertMoved{SHA: "123456"},
 				&opcodes.CommitWithMessage{AuthorOverride: Some(gitdomain.Author("user@acme.com")), Message: "my message"},
 				&opcodes.ConfigRemove{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal},
 				&opcodes.ConfigSet{Key: configdomain.KeyOffline, Scope: configdomain.ConfigScopeLocal, Value: "1"},
 				&opcodes.ConflictPhantomDetect{ParentBranch: Some(gitdomain.NewLocalBranchName("parent")), ParentSHA: Some(gitdomain.NewSHA("123456"))},
 				&opcodes.ConflictPhantomFinalize{},
 				&opcodes.ConflictPhantomResolve{FilePath: "file"},
+				&opcodes.ConnectorProposalAwaitMerge{Branch: "branch", ProposalNumber: 123},
+				&opcodes.ConnectorProposalEnqueue{Branch: "branch", ProposalNumber: 123},
 				&opcodes.ConnectorProposalMerge{Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), ProposalMessage: "proposal message", ProposalNumber: 123},
 				&opcodes.FetchUpstream{Branch: "branch"},
+				&opcodes.HookRun{Branch: "branch", Command: "make lint", Hook: configdomain.HookAfterCreateBranch},
 				&opcodes.LineageBranchRemove{Branch: "branch"},
 				&opcodes.LineageParentRemove{Branch: "branch"},
 				&opcodes.LineageParentSet{Branch: "branch", Parent: "parent"},
@@ -103,10 +102,18 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.MergeContinue{},
 				&opcodes.MergeParentIfNeeded{Branch: "branch", OriginalParentName: Some(gitdomain.NewLocalBranchName("original-parent")), OriginalParentSHA: Some(gitdomain.NewSHA("123456"))},
 				&opcodes.MergeParentResolvePhantomConflicts{CurrentParent: "parent", OriginalParentName: Some(gitdomain.NewLocalBranchName("original-parent")), OriginalParentSHA: Some(gitdomain.NewSHA("123456"))},
-				&opcodes.MergeSquashProgram{Authors: []gitdomain.Author{"author 1 <one@acme.com>", "author 2 <two@acme.com>"}, Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), Parent: "parent"},
+				&opcodes.MergeRebasedProgram{Branch: "branch", Parent: "parent"},
+				&opcodes.MergeSquashProgram{Authors: []gitdomain.Author{"author 1 <one@acme.com>", "author 2 <two@acme.com>"}, Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), Parent: "parent", TemplateData: Some(configdomain.ShipSquashCommitTemplateData{Authors: []gitdomain.Author{"author 1 <one@acme.com>"}, Body: "body", Branch: "branch", CoAuthors: []gitdomain.Author{}, Commits: []string{"commit 1"}, Number: 123, Title: "title", URL: "https://acme.com/pull/123"})},
 				&opcodes.MessageQueue{Message: "message"},
+				&opcodes.OutboxEntryAdd{Entry: outbox.Entry{Action: outbox.ActionProposalMerge, Branch: "branch", CommitMessage: Some(gitdomain.CommitMessage("commit message")), NewTarget: None[gitdomain.LocalBranchName](), Target: Some(gitdomain.NewLocalBranchName("main"))}},
+				&opcodes.OutboxEntryRemove{Entry: outbox.Entry{Action: outbox.ActionTrackingBranchDelete, Branch: "branch", CommitMessage: None[gitdomain.CommitMessage](), NewTarget: None[gitdomain.LocalBranchName](), Target: None[gitdomain.LocalBranchName]()}},
+				&opcodes.PatchApply{Patch: "patch"},
+				&opcodes.PatchRemove{Patch: "patch"},
 				&opcodes.ProgramEndOfBranch{},
+				&opcodes.ProposalClose{ProposalNumber: 123},
 				&opcodes.ProposalCreate{Branch: "branch", MainBranch: "main"},
+				&opcodes.ProposalCreateViaAPI{Assignees: []string{"alice"}, Branch: "branch", Draft: true, Labels: []string{"bug"}, ProposalBody: "body", ProposalTitle: "title", Reviewers: []string{"bob"}},
+				&opcodes.ProposalUpdateBody{ProposalNumber: 123, NewBody: "new body", OldBody: "old body"},
 				&opcodes.ProposalUpdateTarget{ProposalNumber: 123, NewBranch: "new-target", OldBranch: "old-target"},
 				&opcodes.ProposalUpdateTargetToGrandParent{Branch: "branch", ProposalNumber: 123, OldTarget: "old-target"},
 				&opcodes.ProposalUpdateSource{ProposalNumber: 123, NewBranch: "new-target", OldBranch: "old-target"},
@@ -118,19 +125,27 @@ func TestLoadSave(t *testing.T) {
 				&opcodes.PushCurrentBranchIfNeeded{CurrentBranch: "branch"},
 				&opcodes.PushTags{},
 				&opcodes.RebaseAbort{},
+				&opcodes.RebaseAutosquash{SHA: "123456"},
 				&opcodes.RebaseBranch{Branch: "branch"},
+				&opcodes.RebaseCommitsSince{Onto: "branch", Since: "123456"},
 				&opcodes.RebaseContinue{},
 				&opcodes.RebaseContinueIfNeeded{},
 				&opcodes.RebaseOnto{BranchToRebaseAgainst: "branch-1", BranchToRebaseOnto: "branch-2", Upstream: Some(gitdomain.NewLocalBranchName("upstream"))},
 				&opcodes.RebaseParentIfNeeded{Branch: "branch"},
 				&opcodes.RebaseTrackingBranch{RemoteBranch: "origin/branch"},
 				&opcodes.RegisterUndoablePerennialCommit{Parent: "parent"},
+				&opcodes.RegisterUndoablePerennialCommits{Parent: "parent", PreviousSHA: "123456"},
+				&opcodes.RevertAbort{},
+				&opcodes.RevertContinue{},
 				&opcodes.SnapshotInitialUpdateLocalSHA{Branch: "branch", SHA: "111111"},
 				&opcodes.SnapshotInitialUpdateLocalSHAIfNeeded{Branch: "branch"},
+				&opcodes.SharedMetadataPush{},
 				&opcodes.StashDrop{},
 				&opcodes.StashPop{},
 				&opcodes.StashPopIfNeeded{},
 				&opcodes.StashOpenChanges{},
+				&opcodes.WorktreeEnter{Worktree: gitdomain.Worktree{Branch: "branch", Dir: "/path/to/worktree"}},
+				&opcodes.WorktreeLeave{},
 			},
 			TouchedBranches: []gitdomain.BranchName{"branch-1", "branch-2"},
 			UnfinishedDetails: MutableSome(&runstate.UnfinishedRunStateDetails{
@@ -138,7 +153,9 @@ func TestLoadSave(t *testing.T) {
 				EndBranch: gitdomain.NewLocalBranchName("end-branch"),
 				EndTime:   time.Time{},
 			}),
-			UndoablePerennialCommits: []gitdomain.SHA{},
+			UndoablePerennialCommits: gitdomain.UndoableCommits{},
+			Worktree:                 Some(gitdomain.Worktree{Branch: "branch", Dir: "/path/to/worktree"}),
+			Worktrees:                gitdomain.Worktrees{{Branch: "branch", Dir: "/path/to/worktree"}},
 		}
 
 		wantJSON := `
@@ -266,6 +283,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "BranchReset"
     },
+    {
+      "data": {
+        "Branch": "branch"
+      },
+      "type": "BranchSyncStrategyRemove"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -372,6 +395,20 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CheckoutUncached"
     },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "CherryPick"
+    },
+    {
+      "data": {},
+      "type": "CherryPickAbort"
+    },
+    {
+      "data": {},
+      "type": "CherryPickContinue"
+    },
     {
       "data": {
         "AuthorOverride": "user@acme.com",
@@ -388,10 +425,28 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CommitAutoUndo"
     },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "CommitFixup"
+    },
     {
       "data": {},
       "type": "CommitMessageCommentOut"
     },
+    {
+      "data": {
+        "Message": "my message"
+      },
+      "type": "CommitMessagePrefill"
+    },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "CommitRemove"
+    },
     {
       "data": {
         "SHA": "123456"
@@ -404,6 +459,12 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "CommitRevertIfNeeded"
     },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "CommitRevertMoved"
+    },
     {
       "data": {
         "AuthorOverride": "user@acme.com",
@@ -443,6 +504,20 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ConflictPhantomResolve"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "ProposalNumber": 123
+      },
+      "type": "ConnectorProposalAwaitMerge"
+    },
+    {
+      "data": {
+        "Branch": "branch",
+        "ProposalNumber": 123
+      },
+      "type": "ConnectorProposalEnqueue"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -458,6 +533,14 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "FetchUpstream"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Command": "make lint",
+        "Hook": "after-create-branch"
+      },
+      "type": "HookRun"
+    },
     {
       "data": {
         "Branch": "branch"
@@ -530,6 +613,13 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "MergeParentResolvePhantomConflicts"
     },
+    {
+      "data": {
+        "Branch": "branch",
+        "Parent": "parent"
+      },
+      "type": "MergeRebasedProgram"
+    },
     {
       "data": {
         "Authors": [
@@ -538,7 +628,21 @@ func TestLoadSave(t *testing.T) {
         ],
         "Branch": "branch",
         "CommitMessage": "commit message",
-        "Parent": "parent"
+        "Parent": "parent",
+        "TemplateData": {
+          "Authors": [
+            "author 1 \u003cone@acme.com\u003e"
+          ],
+          "Body": "body",
+          "Branch": "branch",
+          "CoAuthors": [],
+          "Commits": [
+            "commit 1"
+          ],
+          "Number": 123,
+          "Title": "title",
+          "URL": "https://acme.com/pull/123"
+        }
       },
       "type": "MergeSquashProgram"
     },
@@ -548,10 +652,52 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "MessageQueue"
     },
+    {
+      "data": {
+        "Entry": {
+          "Action": "merge-proposal",
+          "Branch": "branch",
+          "CommitMessage": "commit message",
+          "NewTarget": null,
+          "Target": "main"
+        }
+      },
+      "type": "OutboxEntryAdd"
+    },
+    {
+      "data": {
+        "Entry": {
+          "Action": "delete-tracking-branch",
+          "Branch": "branch",
+          "CommitMessage": null,
+          "NewTarget": null,
+          "Target": null
+        }
+      },
+      "type": "OutboxEntryRemove"
+    },
+    {
+      "data": {
+        "Patch": "patch"
+      },
+      "type": "PatchApply"
+    },
+    {
+      "data": {
+        "Patch": "patch"
+      },
+      "type": "PatchRemove"
+    },
     {
       "data": {},
       "type": "ProgramEndOfBranch"
     },
+    {
+      "data": {
+        "ProposalNumber": 123
+      },
+      "type": "ProposalClose"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -561,6 +707,32 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "ProposalCreate"
     },
+    {
+      "data": {
+        "Assignees": [
+          "alice"
+        ],
+        "Branch": "branch",
+        "Draft": true,
+        "Labels": [
+          "bug"
+        ],
+        "ProposalBody": "body",
+        "ProposalTitle": "title",
+        "Reviewers": [
+          "bob"
+        ]
+      },
+      "type": "ProposalCreateViaAPI"
+    },
+    {
+      "data": {
+        "NewBody": "new body",
+        "OldBody": "old body",
+        "ProposalNumber": 123
+      },
+      "type": "ProposalUpdateBody"
+    },
     {
       "data": {
         "NewBranch": "new-target",
@@ -625,12 +797,25 @@ func TestLoadSave(t *testing.T) {
       "data": {},
       "type": "RebaseAbort"
     },
+    {
+      "data": {
+        "SHA": "123456"
+      },
+      "type": "RebaseAutosquash"
+    },
     {
       "data": {
         "Branch": "branch"
       },
       "type": "RebaseBranch"
     },
+    {
+      "data": {
+        "Onto": "branch",
+        "Since": "123456"
+      },
+      "type": "RebaseCommitsSince"
+    },
     {
       "data": {},
       "type": "RebaseContinue"
@@ -666,6 +851,21 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "RegisterUndoablePerennialCommit"
     },
+    {
+      "data": {
+        "Parent": "parent",
+        "PreviousSHA": "123456"
+      },
+      "type": "RegisterUndoablePerennialCommits"
+    },
+    {
+      "data": {},
+      "type": "RevertAbort"
+    },
+    {
+      "data": {},
+      "type": "RevertContinue"
+    },
     {
       "data": {
         "Branch": "branch",
@@ -679,6 +879,10 @@ func TestLoadSave(t *testing.T) {
       },
       "type": "SnapshotInitialUpdateLocalSHAIfNeeded"
     },
+    {
+      "data": {},
+      "type": "SharedMetadataPush"
+    },
     {
       "data": {},
       "type": "StashDrop"
@@ -694,6 +898,19 @@ func TestLoadSave(t *testing.T) {
     {
       "data": {},
       "type": "StashOpenChanges"
+    },
+    {
+      "data": {
+        "Worktree": {
+          "Branch": "branch",
+          "Dir": "/path/to/worktree"
+        }
+      },
+      "type": "WorktreeEnter"
+    },
+    {
+      "data": {},
+      "type": "WorktreeLeave"
     }
   ],
   "TouchedBranches": [
@@ -706,7 +923,17 @@ func TestLoadSave(t *testing.T) {
     "CanSkip": true,
     "EndBranch": "end-branch",
     "EndTime": "0001-01-01T00:00:00Z"
-  }
+  },
+  "Worktree": {
+    "Branch": "branch",
+    "Dir": "/path/to/worktree"
+  },
+  "Worktrees": [
+    {
+      "Branch": "branch",
+      "Dir": "/path/to/worktree"
+    }
+  ]
 }`[1:]
 
 		repoRoot := gitdomain.NewRepoRootDir("/path/to/git-town-unit-tests")
@@ -455,6 +455,18 @@ func (self *TestCommands) RenameRemote(oldName, newName string) {
 	self.MustRun("git", "remote", "rename", oldName, newName)
 }
 
+// SHAOnBranch provides the SHA of the commit with the given name in the history of the given branch.
+func (self *TestCommands) SHAOnBranch(branch, name string) Option[gitdomain.SHA] {
+	output := self.MustQuery("git", "log", "--format=%H %s", branch)
+	for _, text := range strings.Split(output, "\n") {
+		shaText, commitMessage, found := strings.Cut(text, " ")
+		if found && commitMessage == name {
+			return Some(gitdomain.NewSHA(shaText))
+		}
+	}
+	return None[gitdomain.SHA]()
+}
+
 // SHAForCommit provides the SHA for the commit with the given name.
 func (self *TestCommands) SHAsForCommit(name string) gitdomain.SHAs {
 	output := self.MustQuery("git", "reflog", "--format=%H %s")
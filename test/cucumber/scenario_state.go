@@ -9,6 +9,7 @@ import (
 	"github.com/git-town/git-town/v17/internal/git/gitdomain"
 	. "github.com/git-town/git-town/v17/pkg/prelude"
 	"github.com/git-town/git-town/v17/test/datatable"
+	"github.com/git-town/git-town/v17/test/fakegithub"
 	"github.com/git-town/git-town/v17/test/fixture"
 	"github.com/git-town/git-town/v17/test/helpers"
 )
@@ -18,6 +19,9 @@ type ScenarioState struct {
 	// the Fixture used in the current scenario
 	fixture fixture.Fixture
 
+	// the fake GitHub API that the current scenario points Git Town at
+	githubAPI Option[*fakegithub.Server]
+
 	// initialBranches contains the local and remote branches before the WHEN steps run
 	initialBranches Option[datatable.DataTable]
 
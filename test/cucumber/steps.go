@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"slices"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/git-town/git-town/v17/test/asserts"
 	"github.com/git-town/git-town/v17/test/commands"
 	"github.com/git-town/git-town/v17/test/datatable"
+	"github.com/git-town/git-town/v17/test/fakegithub"
 	"github.com/git-town/git-town/v17/test/filesystem"
 	"github.com/git-town/git-town/v17/test/fixture"
 	"github.com/git-town/git-town/v17/test/git"
@@ -61,6 +63,9 @@ func InitializeScenario(scenarioContext *godog.ScenarioContext) {
 			return ctx, errors.New("after-scenario hook has found no scenario state found to clean up")
 		}
 		state := ctxValue.(*ScenarioState)
+		if githubAPI, hasGitHubAPI := state.githubAPI.Get(); hasGitHubAPI {
+			githubAPI.Close()
+		}
 		if err != nil {
 			fmt.Printf("failed scenario %q in %s - investigate state in %s\n", scenario.Name, scenario.Uri, state.fixture.Dir)
 			return ctx, nil //nolint:nilerr
@@ -110,6 +115,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 		}
 		state := ScenarioState{
 			fixture:              fixture,
+			githubAPI:            None[*fakegithub.Server](),
 			initialBranches:      None[datatable.DataTable](),
 			initialCommits:       None[datatable.DataTable](),
 			initialCurrentBranch: None[gitdomain.LocalBranchName](),
@@ -150,6 +156,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 		fixture.OriginRepo = MutableNone[commands.TestCommands]()
 		state := ScenarioState{
 			fixture:              fixture,
+			githubAPI:            None[*fakegithub.Server](),
 			initialBranches:      None[datatable.DataTable](),
 			initialCommits:       None[datatable.DataTable](),
 			initialCurrentBranch: None[gitdomain.LocalBranchName](),
@@ -196,6 +203,12 @@ func defineSteps(sc *godog.ScenarioContext) {
 		)
 	})
 
+	sc.Step(`^an uncommitted file in the other worktree$`, func(ctx context.Context) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		secondWorktree := state.fixture.SecondWorktree.GetOrPanic()
+		secondWorktree.CreateFile("uncommitted file", "uncommitted content")
+	})
+
 	sc.Step(`^an uncommitted file with name "([^"]+)" and content "([^"]+)"$`, func(ctx context.Context, name, content string) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -242,6 +255,30 @@ func defineSteps(sc *godog.ScenarioContext) {
 		state.fixture.OriginRepo.GetOrPanic().CreateStandaloneTag(name)
 	})
 
+	sc.Step(`^a runstate file "([^"]+)" that Git Town cannot load$`, func(ctx context.Context, name string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		dir := runstateDir(devRepo.HomeDir)
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dir, name), []byte("invalid content"), 0o600)
+	})
+
+	sc.Step(`^a staged file with name "([^"]+)" and content "([^"]+)"$`, func(ctx context.Context, name, content string) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		devRepo.CreateFile(name, content)
+		devRepo.StageFiles(name)
+	})
+
+	sc.Step(`^branch "([^"]+)" has the sync strategy "([^"]+)"$`, func(ctx context.Context, branch, value string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		key := configdomain.NewSyncStrategyKey(gitdomain.NewLocalBranchName(branch))
+		return devRepo.Config.NormalConfig.GitConfigAccess.SetConfigValue(configdomain.ConfigScopeLocal, key, value)
+	})
+
 	sc.Step(`^branch "([^"]+)" is active in another worktree`, func(ctx context.Context, branch string) {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		state.fixture.AddSecondWorktree(gitdomain.NewLocalBranchName(branch))
@@ -328,6 +365,30 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^branch "([^"]+)" (?:now|still) has no sync strategy$`, func(ctx context.Context, branch string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		key := configdomain.NewSyncStrategyKey(gitdomain.NewLocalBranchName(branch))
+		if value, hasValue := devRepo.GitConfig(configdomain.ConfigScopeLocal, key).Get(); hasValue {
+			return fmt.Errorf("expected branch %q to have no sync strategy but it has %q", branch, value)
+		}
+		return nil
+	})
+
+	sc.Step(`^branch "([^"]+)" (?:now|still) has the sync strategy "([^"]+)"$`, func(ctx context.Context, branch, want string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		key := configdomain.NewSyncStrategyKey(gitdomain.NewLocalBranchName(branch))
+		have, has := devRepo.GitConfig(configdomain.ConfigScopeLocal, key).Get()
+		if !has {
+			return fmt.Errorf("expected branch %q to have the sync strategy %q but it has none", branch, want)
+		}
+		if have != want {
+			return fmt.Errorf("expected branch %q to have the sync strategy %q but it has %q", branch, want, have)
+		}
+		return nil
+	})
+
 	sc.Step(`^custom global Git setting "alias\.(.*?)" is "([^"]*)"$`, func(ctx context.Context, name, value string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -675,6 +736,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 		}
 		state := ScenarioState{
 			fixture:              fixture,
+			githubAPI:            None[*fakegithub.Server](),
 			initialBranches:      None[datatable.DataTable](),
 			initialCommits:       None[datatable.DataTable](),
 			initialCurrentBranch: None[gitdomain.LocalBranchName](),
@@ -853,7 +915,7 @@ func defineSteps(sc *godog.ScenarioContext) {
 		var exitCode int
 		var runOutput string
 		if hasDevRepo {
-			runOutput, exitCode = devRepo.MustQueryStringCode(command)
+			runOutput, exitCode = devRepo.MustQueryStringCode(expandSHAs(command, devRepo))
 			devRepo.Config.Reload()
 		} else {
 			parts := asserts.NoError1(shellquote.Split(command))
@@ -989,6 +1051,23 @@ func defineSteps(sc *godog.ScenarioContext) {
 		state.fixture.OriginRepo.GetOrPanic().RemoveBranch(gitdomain.NewLocalBranchName(branch))
 	})
 
+	sc.Step(`^origin ships the "([^"]*)" branch using the "rebase-merge" ship-strategy without deleting it$`, func(ctx context.Context, branchName string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		branchToShip := gitdomain.NewLocalBranchName(branchName)
+		originRepo := state.fixture.OriginRepo.GetOrPanic()
+		commits := asserts.NoError1(originRepo.CommitsInFeatureBranch(originRepo.TestRunner, branchToShip, "main"))
+		if len(commits) == 0 {
+			return errors.New("branch to ship contains no commits")
+		}
+		originRepo.CheckoutBranch("main")
+		for _, commit := range commits {
+			// commit as a different committer, otherwise the commits on main are identical to the commits on the shipped branch
+			originRepo.MustRun("git", "-c", "user.name=CI", "-c", "user.email=ci@acme.com", "cherry-pick", commit.SHA.String())
+		}
+		originRepo.CheckoutBranch("initial")
+		return nil
+	})
+
 	sc.Step(`^origin ships the "([^"]*)" branch using the "squash-merge" ship-strategy$`, func(ctx context.Context, branchName string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		branchToShip := gitdomain.NewLocalBranchName(branchName)
@@ -1036,6 +1115,22 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^origin ships the "([^"]*)" branch using the "squash-merge" ship-strategy without deleting it$`, func(ctx context.Context, branchName string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		branchToShip := gitdomain.NewLocalBranchName(branchName)
+		originRepo := state.fixture.OriginRepo.GetOrPanic()
+		commitMessage := asserts.NoError1(originRepo.FirstCommitMessageInBranch(originRepo.TestRunner, branchToShip.BranchName(), "main"))
+		if commitMessage.IsNone() {
+			return errors.New("branch to ship contains no commits")
+		}
+		originRepo.CheckoutBranch("main")
+		asserts.NoError(originRepo.SquashMerge(originRepo.TestRunner, branchToShip))
+		originRepo.StageFiles("-A")
+		asserts.NoError(originRepo.Commit(originRepo.TestRunner, commitMessage, false, gitdomain.NewAuthorOpt("CI <ci@acme.com>")))
+		originRepo.CheckoutBranch("initial")
+		return nil
+	})
+
 	sc.Step(`^the branches$`, func(ctx context.Context, table *godog.Table) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		for _, branchSetup := range datatable.ParseBranchSetupTable(table) {
@@ -1335,6 +1430,16 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the currently checked out commit has the message:$`, func(ctx context.Context, want *godog.DocString) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		have := devRepo.CurrentCommitMessage()
+		if have != want.Content {
+			return fmt.Errorf("expected commit message %q but got %q", want.Content, have)
+		}
+		return nil
+	})
+
 	sc.Step(`^the currently checked out commit is "([^"]+)"$`, func(ctx context.Context, want string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1345,6 +1450,14 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the GitHub API is available$`, func(ctx context.Context) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		githubAPI := fakegithub.NewServer()
+		state.githubAPI = Some(githubAPI)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		devRepo.TestRunner.GitHubAPIURL = Some(githubAPI.URL())
+	})
+
 	sc.Step(`^the home directory contains file "([^"]+)" with content$`, func(ctx context.Context, filename string, docString *godog.DocString) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1458,6 +1571,11 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^the merge queue removes the proposal without merging it$`, func(ctx context.Context) {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		state.githubAPI.GetOrPanic().RemoveFromMergeQueue()
+	})
+
 	sc.Step(`^the observed branches are (?:now|still) "([^"]+)"$`, func(ctx context.Context, name string) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		devRepo := state.fixture.DevRepo.GetOrPanic()
@@ -1595,6 +1713,31 @@ func defineSteps(sc *godog.ScenarioContext) {
 		return nil
 	})
 
+	sc.Step(`^there is (?:now|still) no configuration file$`, func(ctx context.Context) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		if _, err := devRepo.FileContentErr(configfile.FileName); err == nil {
+			return errors.New("found an unexpected configuration file")
+		}
+		return nil
+	})
+
+	sc.Step(`^the runstate file "([^"]+)" now doesn't exist$`, func(ctx context.Context, name string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		if _, err := os.Stat(filepath.Join(runstateDir(devRepo.HomeDir), name)); err == nil {
+			return fmt.Errorf("runstate file %q still exists", name)
+		}
+		return nil
+	})
+
+	sc.Step(`^the runstate file "([^"]+)" still exists$`, func(ctx context.Context, name string) error {
+		state := ctx.Value(keyScenarioState).(*ScenarioState)
+		devRepo := state.fixture.DevRepo.GetOrPanic()
+		_, err := os.Stat(filepath.Join(runstateDir(devRepo.HomeDir), name))
+		return err
+	})
+
 	sc.Step(`^these branches exist now$`, func(ctx context.Context, input *godog.Table) error {
 		state := ctx.Value(keyScenarioState).(*ScenarioState)
 		currentBranches := state.fixture.Branches()
@@ -1717,6 +1860,28 @@ func defineSteps(sc *godog.ScenarioContext) {
 	})
 }
 
+// expandSHAs replaces the "{{ sha 'commit message' }}" placeholders in the given command
+// with the SHA of the commit with the given message in the given repo.
+func expandSHAs(command string, repo *commands.TestCommands) string {
+	shaRE := regexp.MustCompile(`\{\{ sha '(.+?)' \}\}`)
+	return shaRE.ReplaceAllStringFunc(command, func(match string) string {
+		commitName := shaRE.FindStringSubmatch(match)[1]
+		shas := repo.SHAsForCommit(commitName)
+		if len(shas) == 0 {
+			panic(fmt.Sprintf("test workspace has no commit %q", commitName))
+		}
+		return shas.First().String()
+	})
+}
+
+// runstateDir provides the directory in which Git Town stores runstate files when running with the given home directory.
+func runstateDir(homeDir string) string {
+	if runtime.GOOS == "darwin" {
+		return filepath.Join(homeDir, "Library", "Application Support", "git-town", "runstate")
+	}
+	return filepath.Join(homeDir, ".config", "git-town", "runstate")
+}
+
 func updateInitialSHAs(state *ScenarioState) {
 	devRepo := state.fixture.DevRepo.GetOrPanic()
 	if state.initialDevSHAs.IsNone() && state.insideGitRepo {
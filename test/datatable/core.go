@@ -1,8 +1,12 @@
 // Package datatable supports comparing Gherkin tables in test code.
 package datatable
 
-import "github.com/git-town/git-town/v17/internal/git/gitdomain"
+import (
+	"github.com/git-town/git-town/v17/internal/git/gitdomain"
+	. "github.com/git-town/git-town/v17/pkg/prelude"
+)
 
 type runner interface {
+	SHAOnBranch(branch, name string) Option[gitdomain.SHA]
 	SHAsForCommit(name string) gitdomain.SHAs
 }
@@ -75,7 +75,7 @@ func (self *DataTable) Expand(localRepo runner, remoteRepo runner, worktreeRepo
 		var cells []string
 		for col := range self.Cells[row] {
 			cell := self.Cells[row][col]
-			if strings.Contains(cell, "{{") {
+			for strings.Contains(cell, "{{") {
 				templateOnce.Do(func() { templateRE = regexp.MustCompile(`\{\{.*?\}\}`) })
 				match := templateRE.FindString(cell)
 				switch {
@@ -87,6 +87,17 @@ func (self *DataTable) Expand(localRepo runner, remoteRepo runner, worktreeRepo
 					}
 					sha := shas.First()
 					cell = strings.Replace(cell, match, sha.String(), 1)
+				case strings.HasPrefix(match, "{{ sha-on-branch "):
+					// format: {{ sha-on-branch 'branch' 'commit' }}
+					branchName, commitName, found := strings.Cut(match[18:len(match)-4], "' '")
+					if !found {
+						panic(fmt.Sprintf("invalid template expression %q", match))
+					}
+					sha, hasSHA := localRepo.SHAOnBranch(branchName, commitName).Get()
+					if !hasSHA {
+						panic(fmt.Sprintf("branch %q has no commit %q", branchName, commitName))
+					}
+					cell = strings.Replace(cell, match, sha.String(), 1)
 				case strings.HasPrefix(match, "{{ sha-in-origin "):
 					commitName := match[18 : len(match)-4]
 					shas := remoteRepo.SHAsForCommit(commitName)
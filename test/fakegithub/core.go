@@ -0,0 +1,3 @@
+// Package fakegithub provides a fake GitHub API that end-to-end tests point Git Town at
+// to verify the changes that Git Town makes to proposals.
+package fakegithub
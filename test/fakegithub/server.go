@@ -0,0 +1,162 @@
+package fakegithub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server is a fake GitHub API that accepts all changes to proposals.
+// It creates all proposals with number 123.
+// Its merge queue merges the proposals added to it right away.
+type Server struct {
+	// the state that the GraphQL API reports for proposals in the merge queue
+	mergeQueueState string
+
+	// protects the fields of this instance against concurrent access by the HTTP handlers
+	mutex sync.Mutex
+
+	// the HTTP server serving the fake API
+	server *httptest.Server
+}
+
+// NewServer starts a new fake GitHub API.
+func NewServer() *Server {
+	result := Server{
+		mergeQueueState: "MERGED",
+		mutex:           sync.Mutex{},
+		server:          nil,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v3/repos/{owner}/{repo}/issues/{number}/assignees", handleIssue)
+	mux.HandleFunc("POST /api/v3/repos/{owner}/{repo}/issues/{number}/labels", handleLabels)
+	mux.HandleFunc("POST /api/v3/repos/{owner}/{repo}/pulls", handleCreatePullRequest)
+	mux.HandleFunc("GET /api/v3/repos/{owner}/{repo}/pulls/{number}", handlePullRequest)
+	mux.HandleFunc("PATCH /api/v3/repos/{owner}/{repo}/pulls/{number}", handlePullRequest)
+	mux.HandleFunc("PUT /api/v3/repos/{owner}/{repo}/pulls/{number}/merge", handleMerge)
+	mux.HandleFunc("POST /api/v3/repos/{owner}/{repo}/pulls/{number}/requested_reviewers", handlePullRequest)
+	mux.HandleFunc("POST /api/graphql", result.handleGraphQL)
+	result.server = httptest.NewServer(mux)
+	return &result
+}
+
+// Close shuts down this fake GitHub API.
+func (self *Server) Close() {
+	self.server.Close()
+}
+
+// RemoveFromMergeQueue makes the merge queue of this fake GitHub API remove proposals without merging them.
+func (self *Server) RemoveFromMergeQueue() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.mergeQueueState = "OPEN"
+}
+
+// URL provides the URL at which this fake GitHub API listens.
+func (self *Server) URL() string {
+	return self.server.URL
+}
+
+func (self *Server) handleGraphQL(writer http.ResponseWriter, request *http.Request) {
+	var body struct {
+		Query string `json:"query"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	switch {
+	case strings.Contains(body.Query, "enqueuePullRequest"):
+		writeJSON(writer, http.StatusOK, map[string]any{
+			"data": map[string]any{
+				"enqueuePullRequest": map[string]any{
+					"mergeQueueEntry": map[string]any{"position": 1},
+				},
+			},
+		})
+	case strings.Contains(body.Query, "isInMergeQueue"):
+		self.mutex.Lock()
+		state := self.mergeQueueState
+		self.mutex.Unlock()
+		writeJSON(writer, http.StatusOK, map[string]any{
+			"data": map[string]any{
+				"repository": map[string]any{
+					"pullRequest": map[string]any{
+						"isInMergeQueue": false,
+						"state":          state,
+					},
+				},
+			},
+		})
+	default:
+		http.Error(writer, "unsupported GraphQL query: "+body.Query, http.StatusBadRequest)
+	}
+}
+
+func handleCreatePullRequest(writer http.ResponseWriter, request *http.Request) {
+	var body struct {
+		Base  string `json:"base"`
+		Body  string `json:"body"`
+		Head  string `json:"head"`
+		Title string `json:"title"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result := pullRequest(request.PathValue("owner"), request.PathValue("repo"), 123)
+	result["base"] = map[string]any{"ref": body.Base}
+	result["body"] = body.Body
+	result["head"] = map[string]any{"ref": body.Head, "sha": "head-sha"}
+	result["title"] = body.Title
+	writeJSON(writer, http.StatusCreated, result)
+}
+
+func handleIssue(writer http.ResponseWriter, request *http.Request) {
+	number, err := strconv.Atoi(request.PathValue("number"))
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(writer, http.StatusCreated, map[string]any{"number": number})
+}
+
+func handleLabels(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, []any{})
+}
+
+func handleMerge(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, map[string]any{
+		"merged":  true,
+		"message": "Pull Request successfully merged",
+	})
+}
+
+func handlePullRequest(writer http.ResponseWriter, request *http.Request) {
+	number, err := strconv.Atoi(request.PathValue("number"))
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(writer, http.StatusOK, pullRequest(request.PathValue("owner"), request.PathValue("repo"), number))
+}
+
+// pullRequest provides the JSON representation of the pull request with the given number.
+func pullRequest(owner, repo string, number int) map[string]any {
+	return map[string]any{
+		"head":     map[string]any{"sha": "head-sha"},
+		"html_url": fmt.Sprintf("https://github.com/%s/%s/pull/%d", owner, repo, number),
+		"node_id":  fmt.Sprintf("PR_%d", number),
+		"number":   number,
+	}
+}
+
+func writeJSON(writer http.ResponseWriter, status int, data any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(data)
+}
@@ -0,0 +1,89 @@
+package fakegithub_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/git-town/git-town/v17/test/fakegithub"
+	"github.com/shoenig/test/must"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	mergeQueueState := func(t *testing.T, server *fakegithub.Server) string {
+		t.Helper()
+		query := `{"query": "query { repository { pullRequest(number: 123) { isInMergeQueue state } } }"}`
+		response, err := http.Post(server.URL()+"/api/graphql", "application/json", strings.NewReader(query)) //nolint:noctx
+		must.NoError(t, err)
+		defer response.Body.Close()
+		var body struct {
+			Data struct {
+				Repository struct {
+					PullRequest struct {
+						State string `json:"state"`
+					} `json:"pullRequest"`
+				} `json:"repository"`
+			} `json:"data"`
+		}
+		must.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+		return body.Data.Repository.PullRequest.State
+	}
+
+	t.Run("create pull request", func(t *testing.T) {
+		t.Parallel()
+		server := fakegithub.NewServer()
+		defer server.Close()
+		request := `{"base": "main", "head": "feature", "title": "my title"}`
+		response, err := http.Post(server.URL()+"/api/v3/repos/git-town/git-town/pulls", "application/json", strings.NewReader(request)) //nolint:noctx
+		must.NoError(t, err)
+		defer response.Body.Close()
+		must.EqOp(t, http.StatusCreated, response.StatusCode)
+		var body struct {
+			Base struct {
+				Ref string `json:"ref"`
+			} `json:"base"`
+			HTMLURL string `json:"html_url"`
+			Number  int    `json:"number"`
+			Title   string `json:"title"`
+		}
+		must.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+		must.EqOp(t, "main", body.Base.Ref)
+		must.EqOp(t, "https://github.com/git-town/git-town/pull/123", body.HTMLURL)
+		must.EqOp(t, 123, body.Number)
+		must.EqOp(t, "my title", body.Title)
+	})
+
+	t.Run("merge queue merges proposals", func(t *testing.T) {
+		t.Parallel()
+		server := fakegithub.NewServer()
+		defer server.Close()
+		must.EqOp(t, "MERGED", mergeQueueState(t, server))
+	})
+
+	t.Run("RemoveFromMergeQueue", func(t *testing.T) {
+		t.Parallel()
+		server := fakegithub.NewServer()
+		defer server.Close()
+		server.RemoveFromMergeQueue()
+		must.EqOp(t, "OPEN", mergeQueueState(t, server))
+	})
+
+	t.Run("pull request", func(t *testing.T) {
+		t.Parallel()
+		server := fakegithub.NewServer()
+		defer server.Close()
+		response, err := http.Get(server.URL() + "/api/v3/repos/git-town/git-town/pulls/123") //nolint:noctx
+		must.NoError(t, err)
+		defer response.Body.Close()
+		var body struct {
+			HTMLURL string `json:"html_url"`
+			Number  int    `json:"number"`
+		}
+		must.NoError(t, json.NewDecoder(response.Body).Decode(&body))
+		must.EqOp(t, 123, body.Number)
+		must.EqOp(t, "https://github.com/git-town/git-town/pull/123", body.HTMLURL)
+	})
+}
@@ -63,6 +63,7 @@ func (self *Fixture) AddSecondWorktree(branch gitdomain.LocalBranchName) {
 	devRepo.AddWorktree(workTreePath, branch)
 	runner := subshell.TestRunner{
 		BinDir:           devRepo.BinDir,
+		GitHubAPIURL:     None[string](),
 		HomeDir:          devRepo.HomeDir,
 		ProposalOverride: None[string](),
 		Verbose:          devRepo.Verbose,
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/git-town/git-town/v17/internal/config/configdomain"
+	"github.com/git-town/git-town/v17/internal/config/envconfig"
 	"github.com/git-town/git-town/v17/internal/gohacks/stringslice"
 	"github.com/git-town/git-town/v17/internal/hosting/hostingdomain"
 	"github.com/git-town/git-town/v17/internal/subshell"
@@ -31,6 +32,9 @@ type TestRunner struct {
 	// the directory that contains mock executables, ignored if empty
 	BinDir string
 
+	// URL of the fake GitHub API that Git Town should talk to, using a fake API token
+	GitHubAPIURL Option[string]
+
 	// the directory that contains the global Git configuration
 	HomeDir string
 
@@ -198,6 +202,10 @@ func (self *TestRunner) QueryWithCode(opts *Options, cmd string, args ...string)
 	if testOrigin, hasTestOrigin := self.testOrigin.Get(); hasTestOrigin {
 		opts.Env = envvars.Replace(opts.Env, "GIT_TOWN_REMOTE", testOrigin)
 	}
+	if gitHubAPIURL, hasGitHubAPIURL := self.GitHubAPIURL.Get(); hasGitHubAPIURL {
+		opts.Env = envvars.Replace(opts.Env, envconfig.GitHubAPIURLKey, gitHubAPIURL)
+		opts.Env = envvars.Replace(opts.Env, "GITHUB_TOKEN", "fake-token")
+	}
 	if proposalOverride, hasProposalOverride := self.ProposalOverride.Get(); hasProposalOverride {
 		opts.Env = envvars.Replace(opts.Env, hostingdomain.OverrideKey, proposalOverride)
 	}
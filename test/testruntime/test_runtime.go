@@ -74,6 +74,7 @@ func Initialize(workingDir, homeDir, binDir string) commands.TestCommands {
 func New(workingDir, homeDir, binDir string) commands.TestCommands {
 	testRunner := testshell.TestRunner{
 		BinDir:           binDir,
+		GitHubAPIURL:     None[string](),
 		HomeDir:          homeDir,
 		ProposalOverride: None[string](),
 		Verbose:          false,